@@ -57,6 +57,67 @@ type Interface interface {
 	UpdateHealthCheck(*fastly.UpdateHealthCheckInput) (*fastly.HealthCheck, error)
 	DeleteHealthCheck(*fastly.DeleteHealthCheckInput) error
 
+	CreateCondition(*fastly.CreateConditionInput) (*fastly.Condition, error)
+	ListConditions(*fastly.ListConditionsInput) ([]*fastly.Condition, error)
+	GetCondition(*fastly.GetConditionInput) (*fastly.Condition, error)
+	UpdateCondition(*fastly.UpdateConditionInput) (*fastly.Condition, error)
+	DeleteCondition(*fastly.DeleteConditionInput) error
+
+	CreateHeader(*fastly.CreateHeaderInput) (*fastly.Header, error)
+	ListHeaders(*fastly.ListHeadersInput) ([]*fastly.Header, error)
+	GetHeader(*fastly.GetHeaderInput) (*fastly.Header, error)
+	UpdateHeader(*fastly.UpdateHeaderInput) (*fastly.Header, error)
+	DeleteHeader(*fastly.DeleteHeaderInput) error
+
+	CreateCacheSetting(*fastly.CreateCacheSettingInput) (*fastly.CacheSetting, error)
+	ListCacheSettings(*fastly.ListCacheSettingsInput) ([]*fastly.CacheSetting, error)
+	GetCacheSetting(*fastly.GetCacheSettingInput) (*fastly.CacheSetting, error)
+	UpdateCacheSetting(*fastly.UpdateCacheSettingInput) (*fastly.CacheSetting, error)
+	DeleteCacheSetting(*fastly.DeleteCacheSettingInput) error
+
+	CreateRequestSetting(*fastly.CreateRequestSettingInput) (*fastly.RequestSetting, error)
+	ListRequestSettings(*fastly.ListRequestSettingsInput) ([]*fastly.RequestSetting, error)
+	GetRequestSetting(*fastly.GetRequestSettingInput) (*fastly.RequestSetting, error)
+	UpdateRequestSetting(*fastly.UpdateRequestSettingInput) (*fastly.RequestSetting, error)
+	DeleteRequestSetting(*fastly.DeleteRequestSettingInput) error
+
+	CreateResponseObject(*fastly.CreateResponseObjectInput) (*fastly.ResponseObject, error)
+	ListResponseObjects(*fastly.ListResponseObjectsInput) ([]*fastly.ResponseObject, error)
+	GetResponseObject(*fastly.GetResponseObjectInput) (*fastly.ResponseObject, error)
+	UpdateResponseObject(*fastly.UpdateResponseObjectInput) (*fastly.ResponseObject, error)
+	DeleteResponseObject(*fastly.DeleteResponseObjectInput) error
+
+	CreateGzip(*fastly.CreateGzipInput) (*fastly.Gzip, error)
+	ListGzips(*fastly.ListGzipsInput) ([]*fastly.Gzip, error)
+	GetGzip(*fastly.GetGzipInput) (*fastly.Gzip, error)
+	UpdateGzip(*fastly.UpdateGzipInput) (*fastly.Gzip, error)
+	DeleteGzip(*fastly.DeleteGzipInput) error
+
+	GetSettings(*fastly.GetSettingsInput) (*fastly.Settings, error)
+	UpdateSettings(*fastly.UpdateSettingsInput) (*fastly.Settings, error)
+
+	CreateDirector(*fastly.CreateDirectorInput) (*fastly.Director, error)
+	ListDirectors(*fastly.ListDirectorsInput) ([]*fastly.Director, error)
+	GetDirector(*fastly.GetDirectorInput) (*fastly.Director, error)
+	UpdateDirector(*fastly.UpdateDirectorInput) (*fastly.Director, error)
+	DeleteDirector(*fastly.DeleteDirectorInput) error
+
+	CreateDirectorBackend(*fastly.CreateDirectorBackendInput) (*fastly.DirectorBackend, error)
+	GetDirectorBackend(*fastly.GetDirectorBackendInput) (*fastly.DirectorBackend, error)
+	DeleteDirectorBackend(*fastly.DeleteDirectorBackendInput) error
+
+	CreatePool(*fastly.CreatePoolInput) (*fastly.Pool, error)
+	ListPools(*fastly.ListPoolsInput) ([]*fastly.Pool, error)
+	GetPool(*fastly.GetPoolInput) (*fastly.Pool, error)
+	UpdatePool(*fastly.UpdatePoolInput) (*fastly.Pool, error)
+	DeletePool(*fastly.DeletePoolInput) error
+
+	CreateServer(*fastly.CreateServerInput) (*fastly.Server, error)
+	ListServers(*fastly.ListServersInput) ([]*fastly.Server, error)
+	GetServer(*fastly.GetServerInput) (*fastly.Server, error)
+	UpdateServer(*fastly.UpdateServerInput) (*fastly.Server, error)
+	DeleteServer(*fastly.DeleteServerInput) error
+
 	GetPackage(*fastly.GetPackageInput) (*fastly.Package, error)
 	UpdatePackage(*fastly.UpdatePackageInput) (*fastly.Package, error)
 
@@ -75,6 +136,16 @@ type Interface interface {
 
 	GetDictionaryInfo(*fastly.GetDictionaryInfoInput) (*fastly.DictionaryInfo, error)
 
+	CreateObjectStore(*fastly.CreateObjectStoreInput) (*fastly.ObjectStore, error)
+	ListObjectStores(*fastly.ListObjectStoresInput) (*fastly.ListObjectStoresResponse, error)
+	GetObjectStore(*fastly.GetObjectStoreInput) (*fastly.ObjectStore, error)
+	DeleteObjectStore(*fastly.DeleteObjectStoreInput) error
+
+	ListObjectStoreKeys(*fastly.ListObjectStoreKeysInput) (*fastly.ListObjectStoreKeysResponse, error)
+	GetObjectStoreKey(*fastly.GetObjectStoreKeyInput) (string, error)
+	InsertObjectStoreKey(*fastly.InsertObjectStoreKeyInput) error
+	DeleteObjectStoreKey(*fastly.DeleteObjectStoreKeyInput) error
+
 	CreateBigQuery(*fastly.CreateBigQueryInput) (*fastly.BigQuery, error)
 	ListBigQueries(*fastly.ListBigQueriesInput) ([]*fastly.BigQuery, error)
 	GetBigQuery(*fastly.GetBigQueryInput) (*fastly.BigQuery, error)
@@ -236,6 +307,8 @@ type Interface interface {
 	UpdateVCL(*fastly.UpdateVCLInput) (*fastly.VCL, error)
 	DeleteVCL(*fastly.DeleteVCLInput) error
 
+	GetGeneratedVCL(*fastly.GetGeneratedVCLInput) (*fastly.VCL, error)
+
 	CreateSnippet(i *fastly.CreateSnippetInput) (*fastly.Snippet, error)
 	ListSnippets(i *fastly.ListSnippetsInput) ([]*fastly.Snippet, error)
 	GetSnippet(i *fastly.GetSnippetInput) (*fastly.Snippet, error)
@@ -18,6 +18,12 @@ import (
 // EdgeComputeTrial is the API endpoint for activating a compute trial.
 const EdgeComputeTrial = "/customer/%s/edge-compute-trial"
 
+// ServiceVersionValidate is the API endpoint for validating a service version.
+//
+// NOTE: This endpoint is documented, but go-fastly's ValidateVersion only
+// returns the `msg` field of the response and drops the errors and warnings.
+const ServiceVersionValidate = "/service/%s/version/%d/validate"
+
 // RequestTimeout is the timeout for the API network request.
 const RequestTimeout = 5 * time.Second
 
@@ -41,11 +47,19 @@ func NewError(err error, statusCode int) APIError {
 }
 
 // Get calls the given API endpoint and returns its response data.
+//
+// NOTE: The request is a POST, as required by the EdgeComputeTrial endpoint.
 func Get(host, path, token string, c api.HTTPClient) (data []byte, err error) {
+	return Call(http.MethodPost, host, path, token, c)
+}
+
+// Call makes a request with the given method to the API endpoint and returns
+// its response data.
+func Call(method, host, path, token string, c api.HTTPClient) (data []byte, err error) {
 	host = strings.TrimSuffix(host, "/")
 	endpoint := fmt.Sprintf("%s%s", host, path)
 
-	req, err := http.NewRequest(http.MethodPost, endpoint, nil)
+	req, err := http.NewRequest(method, endpoint, nil)
 	if err != nil {
 		return data, NewError(err, 0)
 	}
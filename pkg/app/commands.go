@@ -6,11 +6,16 @@ import (
 	"github.com/fastly/cli/pkg/commands/aclentry"
 	"github.com/fastly/cli/pkg/commands/authtoken"
 	"github.com/fastly/cli/pkg/commands/backend"
+	"github.com/fastly/cli/pkg/commands/cachesetting"
 	"github.com/fastly/cli/pkg/commands/compute"
+	"github.com/fastly/cli/pkg/commands/condition"
 	"github.com/fastly/cli/pkg/commands/config"
 	"github.com/fastly/cli/pkg/commands/dictionary"
 	"github.com/fastly/cli/pkg/commands/dictionaryitem"
+	"github.com/fastly/cli/pkg/commands/director"
 	"github.com/fastly/cli/pkg/commands/domain"
+	"github.com/fastly/cli/pkg/commands/gzip"
+	"github.com/fastly/cli/pkg/commands/header"
 	"github.com/fastly/cli/pkg/commands/healthcheck"
 	"github.com/fastly/cli/pkg/commands/ip"
 	"github.com/fastly/cli/pkg/commands/logging"
@@ -41,12 +46,19 @@ import (
 	"github.com/fastly/cli/pkg/commands/logging/sumologic"
 	"github.com/fastly/cli/pkg/commands/logging/syslog"
 	"github.com/fastly/cli/pkg/commands/logtail"
+	"github.com/fastly/cli/pkg/commands/objectstore"
+	"github.com/fastly/cli/pkg/commands/objectstore/key"
+	"github.com/fastly/cli/pkg/commands/pool"
+	"github.com/fastly/cli/pkg/commands/pool/server"
 	"github.com/fastly/cli/pkg/commands/pop"
 	"github.com/fastly/cli/pkg/commands/profile"
 	"github.com/fastly/cli/pkg/commands/purge"
+	"github.com/fastly/cli/pkg/commands/requestsetting"
+	"github.com/fastly/cli/pkg/commands/responseobject"
 	"github.com/fastly/cli/pkg/commands/service"
 	"github.com/fastly/cli/pkg/commands/serviceauth"
 	"github.com/fastly/cli/pkg/commands/serviceversion"
+	"github.com/fastly/cli/pkg/commands/serviceversion/settings"
 	"github.com/fastly/cli/pkg/commands/shellcomplete"
 	"github.com/fastly/cli/pkg/commands/stats"
 
@@ -100,16 +112,31 @@ func defineCommands(
 	backendDescribe := backend.NewDescribeCommand(backendCmdRoot.CmdClause, globals, data)
 	backendList := backend.NewListCommand(backendCmdRoot.CmdClause, globals, data)
 	backendUpdate := backend.NewUpdateCommand(backendCmdRoot.CmdClause, globals, data)
+	cacheSettingCmdRoot := cachesetting.NewRootCommand(app, globals)
+	cacheSettingCreate := cachesetting.NewCreateCommand(cacheSettingCmdRoot.CmdClause, globals, data)
+	cacheSettingDelete := cachesetting.NewDeleteCommand(cacheSettingCmdRoot.CmdClause, globals, data)
+	cacheSettingDescribe := cachesetting.NewDescribeCommand(cacheSettingCmdRoot.CmdClause, globals, data)
+	cacheSettingList := cachesetting.NewListCommand(cacheSettingCmdRoot.CmdClause, globals, data)
+	cacheSettingUpdate := cachesetting.NewUpdateCommand(cacheSettingCmdRoot.CmdClause, globals, data)
 	computeCmdRoot := compute.NewRootCommand(app, globals)
 	computeBuild := compute.NewBuildCommand(computeCmdRoot.CmdClause, globals, data)
 	computeDeploy := compute.NewDeployCommand(computeCmdRoot.CmdClause, globals, data)
 	computeHashsum := compute.NewHashsumCommand(computeCmdRoot.CmdClause, globals, computeBuild, data)
 	computeInit := compute.NewInitCommand(computeCmdRoot.CmdClause, globals, data)
+	computeInspect := compute.NewInspectCommand(computeCmdRoot.CmdClause, globals)
 	computePack := compute.NewPackCommand(computeCmdRoot.CmdClause, globals, data)
 	computePublish := compute.NewPublishCommand(computeCmdRoot.CmdClause, globals, computeBuild, computeDeploy, data)
+	computeRollback := compute.NewRollbackCommand(computeCmdRoot.CmdClause, globals, data)
 	computeServe := compute.NewServeCommand(computeCmdRoot.CmdClause, globals, computeBuild, opts.Versioners.Viceroy, data)
+	computeTest := compute.NewTestCommand(computeCmdRoot.CmdClause, globals, computeBuild, opts.Versioners.Viceroy, data)
 	computeUpdate := compute.NewUpdateCommand(computeCmdRoot.CmdClause, globals, data)
 	computeValidate := compute.NewValidateCommand(computeCmdRoot.CmdClause, globals)
+	conditionCmdRoot := condition.NewRootCommand(app, globals)
+	conditionCreate := condition.NewCreateCommand(conditionCmdRoot.CmdClause, globals, data)
+	conditionDelete := condition.NewDeleteCommand(conditionCmdRoot.CmdClause, globals, data)
+	conditionDescribe := condition.NewDescribeCommand(conditionCmdRoot.CmdClause, globals, data)
+	conditionList := condition.NewListCommand(conditionCmdRoot.CmdClause, globals, data)
+	conditionUpdate := condition.NewUpdateCommand(conditionCmdRoot.CmdClause, globals, data)
 	configCmdRoot := config.NewRootCommand(app, globals)
 	dictionaryCmdRoot := dictionary.NewRootCommand(app, globals)
 	dictionaryCreate := dictionary.NewCreateCommand(dictionaryCmdRoot.CmdClause, globals, data)
@@ -123,6 +150,14 @@ func defineCommands(
 	dictionaryItemUpdate := dictionaryitem.NewUpdateCommand(dictionaryItemCmdRoot.CmdClause, globals, data)
 	dictionaryList := dictionary.NewListCommand(dictionaryCmdRoot.CmdClause, globals, data)
 	dictionaryUpdate := dictionary.NewUpdateCommand(dictionaryCmdRoot.CmdClause, globals, data)
+	directorCmdRoot := director.NewRootCommand(app, globals)
+	directorAddBackend := director.NewAddBackendCommand(directorCmdRoot.CmdClause, globals, data)
+	directorCreate := director.NewCreateCommand(directorCmdRoot.CmdClause, globals, data)
+	directorDelete := director.NewDeleteCommand(directorCmdRoot.CmdClause, globals, data)
+	directorDescribe := director.NewDescribeCommand(directorCmdRoot.CmdClause, globals, data)
+	directorList := director.NewListCommand(directorCmdRoot.CmdClause, globals, data)
+	directorRemoveBackend := director.NewRemoveBackendCommand(directorCmdRoot.CmdClause, globals, data)
+	directorUpdate := director.NewUpdateCommand(directorCmdRoot.CmdClause, globals, data)
 	domainCmdRoot := domain.NewRootCommand(app, globals)
 	domainCreate := domain.NewCreateCommand(domainCmdRoot.CmdClause, globals, data)
 	domainDelete := domain.NewDeleteCommand(domainCmdRoot.CmdClause, globals, data)
@@ -130,6 +165,18 @@ func defineCommands(
 	domainList := domain.NewListCommand(domainCmdRoot.CmdClause, globals, data)
 	domainUpdate := domain.NewUpdateCommand(domainCmdRoot.CmdClause, globals, data)
 	domainValidate := domain.NewValidateCommand(domainCmdRoot.CmdClause, globals, data)
+	gzipCmdRoot := gzip.NewRootCommand(app, globals)
+	gzipCreate := gzip.NewCreateCommand(gzipCmdRoot.CmdClause, globals, data)
+	gzipDelete := gzip.NewDeleteCommand(gzipCmdRoot.CmdClause, globals, data)
+	gzipDescribe := gzip.NewDescribeCommand(gzipCmdRoot.CmdClause, globals, data)
+	gzipList := gzip.NewListCommand(gzipCmdRoot.CmdClause, globals, data)
+	gzipUpdate := gzip.NewUpdateCommand(gzipCmdRoot.CmdClause, globals, data)
+	headerCmdRoot := header.NewRootCommand(app, globals)
+	headerCreate := header.NewCreateCommand(headerCmdRoot.CmdClause, globals, data)
+	headerDelete := header.NewDeleteCommand(headerCmdRoot.CmdClause, globals, data)
+	headerDescribe := header.NewDescribeCommand(headerCmdRoot.CmdClause, globals, data)
+	headerList := header.NewListCommand(headerCmdRoot.CmdClause, globals, data)
+	headerUpdate := header.NewUpdateCommand(headerCmdRoot.CmdClause, globals, data)
 	healthcheckCmdRoot := healthcheck.NewRootCommand(app, globals)
 	healthcheckCreate := healthcheck.NewCreateCommand(healthcheckCmdRoot.CmdClause, globals, data)
 	healthcheckDelete := healthcheck.NewDeleteCommand(healthcheckCmdRoot.CmdClause, globals, data)
@@ -295,6 +342,28 @@ func defineCommands(
 	loggingSyslogDescribe := syslog.NewDescribeCommand(loggingSyslogCmdRoot.CmdClause, globals, data)
 	loggingSyslogList := syslog.NewListCommand(loggingSyslogCmdRoot.CmdClause, globals, data)
 	loggingSyslogUpdate := syslog.NewUpdateCommand(loggingSyslogCmdRoot.CmdClause, globals, data)
+	objectStoreCmdRoot := objectstore.NewRootCommand(app, globals)
+	objectStoreCreate := objectstore.NewCreateCommand(objectStoreCmdRoot.CmdClause, globals, data)
+	objectStoreDelete := objectstore.NewDeleteCommand(objectStoreCmdRoot.CmdClause, globals, data)
+	objectStoreDescribe := objectstore.NewDescribeCommand(objectStoreCmdRoot.CmdClause, globals, data)
+	objectStoreList := objectstore.NewListCommand(objectStoreCmdRoot.CmdClause, globals, data)
+	objectStoreKeyCmdRoot := key.NewRootCommand(objectStoreCmdRoot.CmdClause, globals)
+	objectStoreKeyDelete := key.NewDeleteCommand(objectStoreKeyCmdRoot.CmdClause, globals, data)
+	objectStoreKeyGet := key.NewGetCommand(objectStoreKeyCmdRoot.CmdClause, globals, data)
+	objectStoreKeyInsert := key.NewInsertCommand(objectStoreKeyCmdRoot.CmdClause, globals, data)
+	objectStoreKeyList := key.NewListCommand(objectStoreKeyCmdRoot.CmdClause, globals, data)
+	poolCmdRoot := pool.NewRootCommand(app, globals)
+	poolCreate := pool.NewCreateCommand(poolCmdRoot.CmdClause, globals, data)
+	poolDelete := pool.NewDeleteCommand(poolCmdRoot.CmdClause, globals, data)
+	poolDescribe := pool.NewDescribeCommand(poolCmdRoot.CmdClause, globals, data)
+	poolList := pool.NewListCommand(poolCmdRoot.CmdClause, globals, data)
+	poolUpdate := pool.NewUpdateCommand(poolCmdRoot.CmdClause, globals, data)
+	poolServerCmdRoot := server.NewRootCommand(poolCmdRoot.CmdClause, globals)
+	poolServerCreate := server.NewCreateCommand(poolServerCmdRoot.CmdClause, globals, data)
+	poolServerDelete := server.NewDeleteCommand(poolServerCmdRoot.CmdClause, globals, data)
+	poolServerDescribe := server.NewDescribeCommand(poolServerCmdRoot.CmdClause, globals, data)
+	poolServerList := server.NewListCommand(poolServerCmdRoot.CmdClause, globals, data)
+	poolServerUpdate := server.NewUpdateCommand(poolServerCmdRoot.CmdClause, globals, data)
 	popCmdRoot := pop.NewRootCommand(app, globals)
 	profileCmdRoot := profile.NewRootCommand(app, globals)
 	profileCreate := profile.NewCreateCommand(profileCmdRoot.CmdClause, profile.APIClientFactory(opts.APIClient), globals)
@@ -304,10 +373,24 @@ func defineCommands(
 	profileToken := profile.NewTokenCommand(profileCmdRoot.CmdClause, globals)
 	profileUpdate := profile.NewUpdateCommand(profileCmdRoot.CmdClause, profile.APIClientFactory(opts.APIClient), globals)
 	purgeCmdRoot := purge.NewRootCommand(app, globals, data)
+	requestSettingCmdRoot := requestsetting.NewRootCommand(app, globals)
+	requestSettingCreate := requestsetting.NewCreateCommand(requestSettingCmdRoot.CmdClause, globals, data)
+	requestSettingDelete := requestsetting.NewDeleteCommand(requestSettingCmdRoot.CmdClause, globals, data)
+	requestSettingDescribe := requestsetting.NewDescribeCommand(requestSettingCmdRoot.CmdClause, globals, data)
+	requestSettingList := requestsetting.NewListCommand(requestSettingCmdRoot.CmdClause, globals, data)
+	requestSettingUpdate := requestsetting.NewUpdateCommand(requestSettingCmdRoot.CmdClause, globals, data)
+	responseObjectCmdRoot := responseobject.NewRootCommand(app, globals)
+	responseObjectCreate := responseobject.NewCreateCommand(responseObjectCmdRoot.CmdClause, globals, data)
+	responseObjectDelete := responseobject.NewDeleteCommand(responseObjectCmdRoot.CmdClause, globals, data)
+	responseObjectDescribe := responseobject.NewDescribeCommand(responseObjectCmdRoot.CmdClause, globals, data)
+	responseObjectList := responseobject.NewListCommand(responseObjectCmdRoot.CmdClause, globals, data)
+	responseObjectUpdate := responseobject.NewUpdateCommand(responseObjectCmdRoot.CmdClause, globals, data)
 	serviceCmdRoot := service.NewRootCommand(app, globals)
+	serviceApply := service.NewApplyCommand(serviceCmdRoot.CmdClause, globals, data)
 	serviceCreate := service.NewCreateCommand(serviceCmdRoot.CmdClause, globals)
 	serviceDelete := service.NewDeleteCommand(serviceCmdRoot.CmdClause, globals, data)
 	serviceDescribe := service.NewDescribeCommand(serviceCmdRoot.CmdClause, globals, data)
+	serviceExport := service.NewExportCommand(serviceCmdRoot.CmdClause, globals, data)
 	serviceList := service.NewListCommand(serviceCmdRoot.CmdClause, globals)
 	serviceSearch := service.NewSearchCommand(serviceCmdRoot.CmdClause, globals, data)
 	serviceUpdate := service.NewUpdateCommand(serviceCmdRoot.CmdClause, globals, data)
@@ -321,9 +404,14 @@ func defineCommands(
 	serviceVersionActivate := serviceversion.NewActivateCommand(serviceVersionCmdRoot.CmdClause, globals, data)
 	serviceVersionClone := serviceversion.NewCloneCommand(serviceVersionCmdRoot.CmdClause, globals, data)
 	serviceVersionDeactivate := serviceversion.NewDeactivateCommand(serviceVersionCmdRoot.CmdClause, globals, data)
+	serviceVersionDiff := serviceversion.NewDiffCommand(serviceVersionCmdRoot.CmdClause, globals, data)
 	serviceVersionList := serviceversion.NewListCommand(serviceVersionCmdRoot.CmdClause, globals, data)
 	serviceVersionLock := serviceversion.NewLockCommand(serviceVersionCmdRoot.CmdClause, globals, data)
+	serviceVersionSettingsCmdRoot := settings.NewRootCommand(serviceVersionCmdRoot.CmdClause, globals)
+	serviceVersionSettingsDescribe := settings.NewDescribeCommand(serviceVersionSettingsCmdRoot.CmdClause, globals, data)
+	serviceVersionSettingsUpdate := settings.NewUpdateCommand(serviceVersionSettingsCmdRoot.CmdClause, globals, data)
 	serviceVersionUpdate := serviceversion.NewUpdateCommand(serviceVersionCmdRoot.CmdClause, globals, data)
+	serviceVersionValidate := serviceversion.NewValidateCommand(serviceVersionCmdRoot.CmdClause, globals, data)
 	statsCmdRoot := stats.NewRootCommand(app, globals)
 	statsHistorical := stats.NewHistoricalCommand(statsCmdRoot.CmdClause, globals, data)
 	statsRealtime := stats.NewRealtimeCommand(statsCmdRoot.CmdClause, globals, data)
@@ -378,6 +466,7 @@ func defineCommands(
 	vclCustomDescribe := custom.NewDescribeCommand(vclCustomCmdRoot.CmdClause, globals, data)
 	vclCustomList := custom.NewListCommand(vclCustomCmdRoot.CmdClause, globals, data)
 	vclCustomUpdate := custom.NewUpdateCommand(vclCustomCmdRoot.CmdClause, globals, data)
+	vclGenerated := vcl.NewGeneratedCommand(vclCmdRoot.CmdClause, globals, data)
 	vclSnippetCmdRoot := snippet.NewRootCommand(vclCmdRoot.CmdClause, globals)
 	vclSnippetCreate := snippet.NewCreateCommand(vclSnippetCmdRoot.CmdClause, globals, data)
 	vclSnippetDelete := snippet.NewDeleteCommand(vclSnippetCmdRoot.CmdClause, globals, data)
@@ -412,16 +501,31 @@ func defineCommands(
 		backendDescribe,
 		backendList,
 		backendUpdate,
+		cacheSettingCmdRoot,
+		cacheSettingCreate,
+		cacheSettingDelete,
+		cacheSettingDescribe,
+		cacheSettingList,
+		cacheSettingUpdate,
 		computeBuild,
 		computeCmdRoot,
 		computeDeploy,
 		computeHashsum,
 		computeInit,
+		computeInspect,
 		computePack,
 		computePublish,
+		computeRollback,
 		computeServe,
+		computeTest,
 		computeUpdate,
 		computeValidate,
+		conditionCmdRoot,
+		conditionCreate,
+		conditionDelete,
+		conditionDescribe,
+		conditionList,
+		conditionUpdate,
 		configCmdRoot,
 		dictionaryCmdRoot,
 		dictionaryCreate,
@@ -435,6 +539,14 @@ func defineCommands(
 		dictionaryItemUpdate,
 		dictionaryList,
 		dictionaryUpdate,
+		directorAddBackend,
+		directorCmdRoot,
+		directorCreate,
+		directorDelete,
+		directorDescribe,
+		directorList,
+		directorRemoveBackend,
+		directorUpdate,
 		domainCmdRoot,
 		domainCreate,
 		domainDelete,
@@ -442,6 +554,18 @@ func defineCommands(
 		domainList,
 		domainUpdate,
 		domainValidate,
+		gzipCmdRoot,
+		gzipCreate,
+		gzipDelete,
+		gzipDescribe,
+		gzipList,
+		gzipUpdate,
+		headerCmdRoot,
+		headerCreate,
+		headerDelete,
+		headerDescribe,
+		headerList,
+		headerUpdate,
 		healthcheckCmdRoot,
 		healthcheckCreate,
 		healthcheckDelete,
@@ -607,6 +731,28 @@ func defineCommands(
 		loggingSyslogDescribe,
 		loggingSyslogList,
 		loggingSyslogUpdate,
+		objectStoreCmdRoot,
+		objectStoreCreate,
+		objectStoreDelete,
+		objectStoreDescribe,
+		objectStoreList,
+		objectStoreKeyCmdRoot,
+		objectStoreKeyDelete,
+		objectStoreKeyGet,
+		objectStoreKeyInsert,
+		objectStoreKeyList,
+		poolCmdRoot,
+		poolCreate,
+		poolDelete,
+		poolDescribe,
+		poolList,
+		poolUpdate,
+		poolServerCmdRoot,
+		poolServerCreate,
+		poolServerDelete,
+		poolServerDescribe,
+		poolServerList,
+		poolServerUpdate,
 		popCmdRoot,
 		profileCmdRoot,
 		profileCreate,
@@ -616,10 +762,24 @@ func defineCommands(
 		profileToken,
 		profileUpdate,
 		purgeCmdRoot,
+		requestSettingCmdRoot,
+		requestSettingCreate,
+		requestSettingDelete,
+		requestSettingDescribe,
+		requestSettingList,
+		requestSettingUpdate,
+		responseObjectCmdRoot,
+		responseObjectCreate,
+		responseObjectDelete,
+		responseObjectDescribe,
+		responseObjectList,
+		responseObjectUpdate,
 		serviceCmdRoot,
+		serviceApply,
 		serviceCreate,
 		serviceDelete,
 		serviceDescribe,
+		serviceExport,
 		serviceList,
 		serviceSearch,
 		serviceUpdate,
@@ -633,9 +793,14 @@ func defineCommands(
 		serviceVersionClone,
 		serviceVersionCmdRoot,
 		serviceVersionDeactivate,
+		serviceVersionDiff,
 		serviceVersionList,
 		serviceVersionLock,
+		serviceVersionSettingsCmdRoot,
+		serviceVersionSettingsDescribe,
+		serviceVersionSettingsUpdate,
 		serviceVersionUpdate,
+		serviceVersionValidate,
 		statsCmdRoot,
 		statsHistorical,
 		statsRealtime,
@@ -690,6 +855,7 @@ func defineCommands(
 		vclCustomDescribe,
 		vclCustomList,
 		vclCustomUpdate,
+		vclGenerated,
 		vclSnippetCmdRoot,
 		vclSnippetCreate,
 		vclSnippetDelete,
@@ -61,18 +61,27 @@ acl
 acl-entry
 auth-token
 backend
+cache-setting
 compute
+condition
 config
 dictionary
 dictionary-item
+director
 domain
+gzip
+header
 healthcheck
 ip-list
 log-tail
 logging
+object-store
+pool
 pops
 profile
 purge
+request-setting
+response-object
 service
 service-auth
 service-version
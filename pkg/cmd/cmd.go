@@ -53,6 +53,8 @@ type Globals struct {
 type Base struct {
 	CmdClause *kingpin.CmdClause
 	Globals   *config.Data
+
+	output outputFlags
 }
 
 // Name implements the Command interface, and returns the FullCommand from the
@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+
+	fsterr "github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+)
+
+var (
+	// FlagFormatName is the flag name.
+	FlagFormatName = "format"
+	// FlagFormatDesc is the flag description.
+	FlagFormatDesc = "Output format: text, json, yaml, csv or template"
+	// FlagTemplateName is the flag name.
+	FlagTemplateName = "template"
+	// FlagTemplateDesc is the flag description.
+	FlagTemplateDesc = "Go template used to render each result with --format=template, e.g. '{{.Name}}'"
+)
+
+// outputFlags are the flags that control how a command renders its results.
+type outputFlags struct {
+	format   OptionalString
+	json     bool
+	template string
+}
+
+// RegisterOutputFlags defines the --format, --template and --json flags, which
+// control how a command renders its results.
+func (b *Base) RegisterOutputFlags() {
+	b.RegisterFlagBool(BoolFlagOpts{
+		Name:        FlagJSONName,
+		Description: FlagJSONDesc,
+		Dst:         &b.output.json,
+		Short:       'j',
+	})
+	b.CmdClause.Flag(FlagFormatName, FlagFormatDesc).Default(text.FormatText).Action(b.output.format.Set).HintOptions(text.Formats...).EnumVar(&b.output.format.Value, text.Formats...)
+	b.CmdClause.Flag(FlagTemplateName, FlagTemplateDesc).StringVar(&b.output.template)
+}
+
+// CopyOutputFlags copies the output flag values from src. It's used by
+// composite commands that forward their flags to a nested command.
+func (b *Base) CopyOutputFlags(src *Base) {
+	b.output = src.output
+}
+
+// OutputFormat returns the output format requested by the user. The --json
+// flag is shorthand for --format=json.
+func (b *Base) OutputFormat() string {
+	if b.output.json {
+		return text.FormatJSON
+	}
+	if b.output.format.Value == "" {
+		return text.FormatText
+	}
+	return b.output.format.Value
+}
+
+// StructuredOutput reports whether the user requested a structured output
+// format rather than the default text output.
+func (b *Base) StructuredOutput() bool {
+	return b.OutputFormat() != text.FormatText
+}
+
+// CheckOutputFlags validates the combination of output flags. It should be
+// called before any API requests are made.
+//
+// NOTE: --format defaults to text, so it only conflicts with --json when it
+// was set explicitly.
+func (b *Base) CheckOutputFlags() error {
+	format := b.OutputFormat()
+	if b.output.json && b.output.format.WasSet && b.output.format.Value != text.FormatJSON {
+		return fsterr.RemediationError{
+			Inner:       fmt.Errorf("invalid flag combination, --json and --format=%s", b.output.format.Value),
+			Remediation: "Use either --json or --format, not both.",
+		}
+	}
+	if b.Globals.Verbose() && format != text.FormatText {
+		if format == text.FormatJSON {
+			return fsterr.ErrInvalidVerboseJSONCombo
+		}
+		return fsterr.ErrInvalidVerboseFormatCombo
+	}
+	if format == text.FormatTemplate && b.output.template == "" {
+		return fsterr.RemediationError{
+			Inner:       fmt.Errorf("--format=template requires a --template"),
+			Remediation: "Provide a Go template, e.g. --template '{{.Name}}'.",
+		}
+	}
+	if format != text.FormatTemplate && b.output.template != "" {
+		return fsterr.RemediationError{
+			Inner:       fmt.Errorf("--template can only be used with --format=template"),
+			Remediation: "Add --format=template, or remove --template.",
+		}
+	}
+	return nil
+}
+
+// WriteOutput renders v to out using the structured output format requested
+// by the user. It reports false without writing anything when the text format
+// was requested, in which case the caller renders its own text output.
+func (b *Base) WriteOutput(out io.Writer, v any) (bool, error) {
+	if !b.StructuredOutput() {
+		return false, nil
+	}
+	if err := text.Render(out, b.OutputFormat(), b.output.template, v); err != nil {
+		b.Globals.ErrLog.Add(err)
+		return true, fmt.Errorf("error: unable to write data to stdout: %w", err)
+	}
+	return true, nil
+}
@@ -1,7 +1,6 @@
 package acl
 
 import (
-	"encoding/json"
 	"fmt"
 	"io"
 
@@ -29,12 +28,7 @@ func NewDescribeCommand(parent cmd.Registerer, globals *config.Data, data manife
 	})
 
 	// Optional Flags
-	c.RegisterFlagBool(cmd.BoolFlagOpts{
-		Name:        cmd.FlagJSONName,
-		Description: cmd.FlagJSONDesc,
-		Dst:         &c.json,
-		Short:       'j',
-	})
+	c.RegisterOutputFlags()
 	c.RegisterFlag(cmd.StringFlagOpts{
 		Name:        cmd.FlagServiceIDName,
 		Description: cmd.FlagServiceIDDesc,
@@ -55,7 +49,6 @@ func NewDescribeCommand(parent cmd.Registerer, globals *config.Data, data manife
 type DescribeCommand struct {
 	cmd.Base
 
-	json           bool
 	manifest       manifest.Data
 	name           string
 	serviceName    cmd.OptionalServiceNameID
@@ -64,8 +57,8 @@ type DescribeCommand struct {
 
 // Exec invokes the application logic for the command.
 func (c *DescribeCommand) Exec(_ io.Reader, out io.Writer) error {
-	if c.Globals.Verbose() && c.json {
-		return fsterr.ErrInvalidVerboseJSONCombo
+	if err := c.CheckOutputFlags(); err != nil {
+		return err
 	}
 
 	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
@@ -116,17 +109,8 @@ func (c *DescribeCommand) constructInput(serviceID string, serviceVersion int) *
 
 // print displays the information returned from the API.
 func (c *DescribeCommand) print(out io.Writer, a *fastly.ACL) error {
-	if c.json {
-		data, err := json.Marshal(a)
-		if err != nil {
-			return err
-		}
-		_, err = out.Write(data)
-		if err != nil {
-			c.Globals.ErrLog.Add(err)
-			return fmt.Errorf("error: unable to write data to stdout: %w", err)
-		}
-		return nil
+	if ok, err := c.WriteOutput(out, a); ok {
+		return err
 	}
 
 	if !c.Globals.Verbose() {
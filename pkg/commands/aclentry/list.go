@@ -1,13 +1,11 @@
 package aclentry
 
 import (
-	"encoding/json"
 	"fmt"
 	"io"
 
 	"github.com/fastly/cli/pkg/cmd"
 	"github.com/fastly/cli/pkg/config"
-	fsterr "github.com/fastly/cli/pkg/errors"
 	"github.com/fastly/cli/pkg/manifest"
 	"github.com/fastly/cli/pkg/text"
 	"github.com/fastly/go-fastly/v6/fastly"
@@ -25,12 +23,7 @@ func NewListCommand(parent cmd.Registerer, globals *config.Data, data manifest.D
 
 	// Optional Flags
 	c.CmdClause.Flag("direction", "Direction in which to sort results").Default(cmd.PaginationDirection[0]).HintOptions(cmd.PaginationDirection...).EnumVar(&c.direction, cmd.PaginationDirection...)
-	c.RegisterFlagBool(cmd.BoolFlagOpts{
-		Name:        cmd.FlagJSONName,
-		Description: cmd.FlagJSONDesc,
-		Dst:         &c.json,
-		Short:       'j',
-	})
+	c.RegisterOutputFlags()
 	c.CmdClause.Flag("page", "Page number of data set to fetch").IntVar(&c.page)
 	c.CmdClause.Flag("per-page", "Number of records per page").IntVar(&c.perPage)
 	c.RegisterFlag(cmd.StringFlagOpts{
@@ -56,7 +49,6 @@ type ListCommand struct {
 
 	aclID       string
 	direction   string
-	json        bool
 	manifest    manifest.Data
 	page        int
 	perPage     int
@@ -66,8 +58,8 @@ type ListCommand struct {
 
 // Exec invokes the application logic for the command.
 func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
-	if c.Globals.Verbose() && c.json {
-		return fsterr.ErrInvalidVerboseJSONCombo
+	if err := c.CheckOutputFlags(); err != nil {
+		return err
 	}
 
 	serviceID, source, flag, err := cmd.ServiceID(c.serviceName, c.manifest, c.Globals.APIClient, c.Globals.ErrLog)
@@ -150,17 +142,8 @@ func (c *ListCommand) printVerbose(out io.Writer, as []*fastly.ACLEntry) {
 // printSummary displays the information returned from the API in a summarised
 // format.
 func (c *ListCommand) printSummary(out io.Writer, as []*fastly.ACLEntry) error {
-	if c.json {
-		data, err := json.Marshal(as)
-		if err != nil {
-			return err
-		}
-		_, err = out.Write(data)
-		if err != nil {
-			c.Globals.ErrLog.Add(err)
-			return fmt.Errorf("error: unable to write data to stdout: %w", err)
-		}
-		return nil
+	if ok, err := c.WriteOutput(out, as); ok {
+		return err
 	}
 
 	t := text.NewTable(out)
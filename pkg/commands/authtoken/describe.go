@@ -1,7 +1,6 @@
 package authtoken
 
 import (
-	"encoding/json"
 	"fmt"
 	"io"
 	"strings"
@@ -19,12 +18,7 @@ func NewDescribeCommand(parent cmd.Registerer, globals *config.Data, data manife
 	c.CmdClause = parent.Command("describe", "Get the current API token").Alias("get")
 	c.Globals = globals
 	c.manifest = data
-	c.RegisterFlagBool(cmd.BoolFlagOpts{
-		Name:        cmd.FlagJSONName,
-		Description: cmd.FlagJSONDesc,
-		Dst:         &c.json,
-		Short:       'j',
-	})
+	c.RegisterOutputFlags()
 	return &c
 }
 
@@ -32,7 +26,6 @@ func NewDescribeCommand(parent cmd.Registerer, globals *config.Data, data manife
 type DescribeCommand struct {
 	cmd.Base
 
-	json     bool
 	manifest manifest.Data
 }
 
@@ -42,8 +35,8 @@ func (c *DescribeCommand) Exec(_ io.Reader, out io.Writer) error {
 	if s == config.SourceUndefined {
 		return fsterr.ErrNoToken
 	}
-	if c.Globals.Verbose() && c.json {
-		return fsterr.ErrInvalidVerboseJSONCombo
+	if err := c.CheckOutputFlags(); err != nil {
+		return err
 	}
 
 	r, err := c.Globals.APIClient.GetTokenSelf()
@@ -61,17 +54,8 @@ func (c *DescribeCommand) Exec(_ io.Reader, out io.Writer) error {
 
 // print displays the information returned from the API.
 func (c *DescribeCommand) print(out io.Writer, r *fastly.Token) error {
-	if c.json {
-		data, err := json.Marshal(r)
-		if err != nil {
-			return err
-		}
-		_, err = out.Write(data)
-		if err != nil {
-			c.Globals.ErrLog.Add(err)
-			return fmt.Errorf("error: unable to write data to stdout: %w", err)
-		}
-		return nil
+	if ok, err := c.WriteOutput(out, r); ok {
+		return err
 	}
 
 	fmt.Fprintf(out, "\nID: %s\n", r.ID)
@@ -0,0 +1,353 @@
+package cachesetting_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v6/fastly"
+)
+
+func TestCacheSettingCreate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name:      "validate missing --name flag",
+			Args:      args("cache-setting create --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Name:      "validate invalid --action flag",
+			Args:      args("cache-setting create --service-id 123 --version 1 --name static --action foo"),
+			WantError: "enum value must be one of cache,pass,restart",
+		},
+		{
+			Name: "validate CreateCacheSetting API error",
+			API: mock.API{
+				ListVersionsFn:       testutil.ListVersions,
+				CloneVersionFn:       testutil.CloneVersionResult(4),
+				CreateCacheSettingFn: createCacheSettingError,
+			},
+			Args:      args("cache-setting create --service-id 123 --version 1 --name static --autoclone"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate CreateCacheSetting API success",
+			API: mock.API{
+				ListVersionsFn:       testutil.ListVersions,
+				CloneVersionFn:       testutil.CloneVersionResult(4),
+				CreateCacheSettingFn: createCacheSettingOK,
+			},
+			Args:       args("cache-setting create --service-id 123 --version 1 --name static --action cache --ttl 3600 --stale-ttl 60 --autoclone"),
+			WantOutput: "Created cache setting static (service 123 version 4)",
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestCacheSettingList(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name: "validate ListCacheSettings API error",
+			API: mock.API{
+				ListVersionsFn:      testutil.ListVersions,
+				ListCacheSettingsFn: listCacheSettingsError,
+			},
+			Args:      args("cache-setting list --service-id 123 --version 1"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate ListCacheSettings API success",
+			API: mock.API{
+				ListVersionsFn:      testutil.ListVersions,
+				ListCacheSettingsFn: listCacheSettingsOK,
+			},
+			Args:       args("cache-setting list --service-id 123 --version 1"),
+			WantOutput: listCacheSettingsShortOutput,
+		},
+		{
+			Name: "validate --verbose flag",
+			API: mock.API{
+				ListVersionsFn:      testutil.ListVersions,
+				ListCacheSettingsFn: listCacheSettingsOK,
+			},
+			Args:       args("cache-setting list --service-id 123 --version 1 --verbose"),
+			WantOutput: listCacheSettingsVerboseOutput,
+		},
+		{
+			Name: "validate --json flag",
+			API: mock.API{
+				ListVersionsFn:      testutil.ListVersions,
+				ListCacheSettingsFn: listCacheSettingsOK,
+			},
+			Args:       args("cache-setting list --service-id 123 --version 1 --json"),
+			WantOutput: `"Name":"static","Action":"cache","TTL":3600,"StaleTTL":60,"CacheCondition":"is_static"`,
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestCacheSettingDescribe(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name:      "validate missing --name flag",
+			Args:      args("cache-setting describe --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Name: "validate GetCacheSetting API error",
+			API: mock.API{
+				ListVersionsFn:    testutil.ListVersions,
+				GetCacheSettingFn: getCacheSettingError,
+			},
+			Args:      args("cache-setting describe --service-id 123 --version 1 --name static"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate GetCacheSetting API success",
+			API: mock.API{
+				ListVersionsFn:    testutil.ListVersions,
+				GetCacheSettingFn: getCacheSettingOK,
+			},
+			Args:       args("cache-setting describe --service-id 123 --version 1 --name static"),
+			WantOutput: describeCacheSettingOutput,
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestCacheSettingUpdate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name:      "validate missing --name flag",
+			Args:      args("cache-setting update --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Name: "validate UpdateCacheSetting API error",
+			API: mock.API{
+				ListVersionsFn:       testutil.ListVersions,
+				CloneVersionFn:       testutil.CloneVersionResult(4),
+				UpdateCacheSettingFn: updateCacheSettingError,
+			},
+			Args:      args("cache-setting update --service-id 123 --version 1 --name static --ttl 60 --autoclone"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate UpdateCacheSetting API success",
+			API: mock.API{
+				ListVersionsFn:       testutil.ListVersions,
+				CloneVersionFn:       testutil.CloneVersionResult(4),
+				UpdateCacheSettingFn: updateCacheSettingOK,
+			},
+			Args:       args("cache-setting update --service-id 123 --version 1 --name static --new-name assets --action pass --autoclone"),
+			WantOutput: "Updated cache setting assets (service 123 version 4)",
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestCacheSettingDelete(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name:      "validate missing --name flag",
+			Args:      args("cache-setting delete --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Name: "validate DeleteCacheSetting API error",
+			API: mock.API{
+				ListVersionsFn:       testutil.ListVersions,
+				CloneVersionFn:       testutil.CloneVersionResult(4),
+				DeleteCacheSettingFn: deleteCacheSettingError,
+			},
+			Args:      args("cache-setting delete --service-id 123 --version 1 --name static --autoclone"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate DeleteCacheSetting API success",
+			API: mock.API{
+				ListVersionsFn:       testutil.ListVersions,
+				CloneVersionFn:       testutil.CloneVersionResult(4),
+				DeleteCacheSettingFn: deleteCacheSettingOK,
+			},
+			Args:       args("cache-setting delete --service-id 123 --version 1 --name static --autoclone"),
+			WantOutput: "Deleted cache setting static (service 123 version 4)",
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func createCacheSettingOK(i *fastly.CreateCacheSettingInput) (*fastly.CacheSetting, error) {
+	return &fastly.CacheSetting{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           i.Name,
+		Action:         i.Action,
+		TTL:            i.TTL,
+		StaleTTL:       i.StaleTTL,
+	}, nil
+}
+
+func createCacheSettingError(i *fastly.CreateCacheSettingInput) (*fastly.CacheSetting, error) {
+	return nil, testutil.Err
+}
+
+func listCacheSettingsOK(i *fastly.ListCacheSettingsInput) ([]*fastly.CacheSetting, error) {
+	return []*fastly.CacheSetting{
+		{
+			ServiceID:      i.ServiceID,
+			ServiceVersion: i.ServiceVersion,
+			Name:           "static",
+			Action:         fastly.CacheSettingActionCache,
+			TTL:            3600,
+			StaleTTL:       60,
+			CacheCondition: "is_static",
+		},
+		{
+			ServiceID:      i.ServiceID,
+			ServiceVersion: i.ServiceVersion,
+			Name:           "no_cache",
+			Action:         fastly.CacheSettingActionPass,
+			CacheCondition: "is_api",
+		},
+	}, nil
+}
+
+func listCacheSettingsError(i *fastly.ListCacheSettingsInput) ([]*fastly.CacheSetting, error) {
+	return nil, testutil.Err
+}
+
+var listCacheSettingsShortOutput = strings.TrimSpace(`
+SERVICE  VERSION  NAME      ACTION  TTL   STALE TTL  CACHE CONDITION
+123      1        static    cache   3600  60         is_static
+123      1        no_cache  pass    0     0          is_api
+`) + "\n"
+
+var listCacheSettingsVerboseOutput = strings.Join([]string{
+	"Fastly API token not provided",
+	"Fastly API endpoint: https://api.fastly.com",
+	"Service ID (via --service-id): 123",
+	"",
+	"Version: 1",
+	"	Cache setting 1/2",
+	"		Name: static",
+	"		Action: cache",
+	"		TTL: 3600",
+	"		Stale TTL: 60",
+	"		Cache condition: is_static",
+	"	Cache setting 2/2",
+	"		Name: no_cache",
+	"		Action: pass",
+	"		TTL: 0",
+	"		Stale TTL: 0",
+	"		Cache condition: is_api",
+}, "\n") + "\n\n"
+
+func getCacheSettingOK(i *fastly.GetCacheSettingInput) (*fastly.CacheSetting, error) {
+	return &fastly.CacheSetting{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           "static",
+		Action:         fastly.CacheSettingActionCache,
+		TTL:            3600,
+		StaleTTL:       60,
+	}, nil
+}
+
+func getCacheSettingError(i *fastly.GetCacheSettingInput) (*fastly.CacheSetting, error) {
+	return nil, testutil.Err
+}
+
+var describeCacheSettingOutput = "\n" + strings.Join([]string{
+	"Service ID: 123",
+	"Version: 1",
+	"Name: static",
+	"Action: cache",
+	"TTL: 3600",
+	"Stale TTL: 60",
+	"Cache condition: ",
+}, "\n") + "\n"
+
+func updateCacheSettingOK(i *fastly.UpdateCacheSettingInput) (*fastly.CacheSetting, error) {
+	return &fastly.CacheSetting{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           *i.NewName,
+		Action:         i.Action,
+	}, nil
+}
+
+func updateCacheSettingError(i *fastly.UpdateCacheSettingInput) (*fastly.CacheSetting, error) {
+	return nil, testutil.Err
+}
+
+func deleteCacheSettingOK(i *fastly.DeleteCacheSettingInput) error {
+	return nil
+}
+
+func deleteCacheSettingError(i *fastly.DeleteCacheSettingInput) error {
+	return testutil.Err
+}
@@ -0,0 +1,102 @@
+package cachesetting
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v6/fastly"
+)
+
+// cacheSettingActions is the list of supported cache setting actions.
+var cacheSettingActions = []string{
+	string(fastly.CacheSettingActionCache),
+	string(fastly.CacheSettingActionPass),
+	string(fastly.CacheSettingActionRestart),
+}
+
+// CreateCommand calls the Fastly API to create cache settings.
+type CreateCommand struct {
+	cmd.Base
+	input          fastly.CreateCacheSettingInput
+	action         string
+	autoClone      cmd.OptionalAutoClone
+	manifest       manifest.Data
+	serviceName    cmd.OptionalServiceNameID
+	serviceVersion cmd.OptionalServiceVersion
+}
+
+// NewCreateCommand returns a usable command registered under the parent.
+func NewCreateCommand(parent cmd.Registerer, globals *config.Data, data manifest.Data) *CreateCommand {
+	var c CreateCommand
+	c.Globals = globals
+	c.manifest = data
+	c.CmdClause = parent.Command("create", "Create a cache setting on a Fastly service version").Alias("add")
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Name:        cmd.FlagServiceIDName,
+		Description: cmd.FlagServiceIDDesc,
+		Dst:         &c.manifest.Flag.ServiceID,
+		Short:       's',
+	})
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Action:      c.serviceName.Set,
+		Name:        cmd.FlagServiceName,
+		Description: cmd.FlagServiceDesc,
+		Dst:         &c.serviceName.Value,
+	})
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Name:        cmd.FlagVersionName,
+		Description: cmd.FlagVersionDesc,
+		Dst:         &c.serviceVersion.Value,
+		Required:    true,
+	})
+	c.RegisterAutoCloneFlag(cmd.AutoCloneFlagOpts{
+		Action: c.autoClone.Set,
+		Dst:    &c.autoClone.Value,
+	})
+	c.CmdClause.Flag("name", "Name of cache setting").Short('n').Required().StringVar(&c.input.Name)
+	c.CmdClause.Flag("action", "Accepts a string value: cache, pass, restart").EnumVar(&c.action, cacheSettingActions...)
+	c.CmdClause.Flag("ttl", "Maximum time in seconds to consider the object fresh in the cache").UintVar(&c.input.TTL)
+	c.CmdClause.Flag("stale-ttl", "Maximum time in seconds to continue to use a stale version of the object if future requests to the backend are failing").UintVar(&c.input.StaleTTL)
+	c.CmdClause.Flag("cache-condition", "Name of a cache condition controlling when this configuration applies").StringVar(&c.input.CacheCondition)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *CreateCommand) Exec(_ io.Reader, out io.Writer) error {
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AutoCloneFlag:      c.autoClone,
+		APIClient:          c.Globals.APIClient,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceNameFlag:    c.serviceName,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	c.input.ServiceID = serviceID
+	c.input.ServiceVersion = serviceVersion.Number
+	c.input.Action = fastly.CacheSettingAction(c.action)
+
+	cs, err := c.Globals.APIClient.CreateCacheSetting(&c.input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	text.Success(out, "Created cache setting %s (service %s version %d)", cs.Name, cs.ServiceID, cs.ServiceVersion)
+	return nil
+}
@@ -0,0 +1,3 @@
+// Package cachesetting contains commands to inspect and manipulate Fastly service
+// cache settings.
+package cachesetting
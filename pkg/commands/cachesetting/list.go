@@ -0,0 +1,109 @@
+package cachesetting
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	fsterr "github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v6/fastly"
+)
+
+// ListCommand calls the Fastly API to list cache settings.
+type ListCommand struct {
+	cmd.Base
+	manifest       manifest.Data
+	Input          fastly.ListCacheSettingsInput
+	serviceName    cmd.OptionalServiceNameID
+	serviceVersion cmd.OptionalServiceVersion
+}
+
+// NewListCommand returns a usable command registered under the parent.
+func NewListCommand(parent cmd.Registerer, globals *config.Data, data manifest.Data) *ListCommand {
+	var c ListCommand
+	c.Globals = globals
+	c.manifest = data
+	c.CmdClause = parent.Command("list", "List cache settings on a Fastly service version")
+	c.RegisterOutputFlags()
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Name:        cmd.FlagServiceIDName,
+		Description: cmd.FlagServiceIDDesc,
+		Dst:         &c.manifest.Flag.ServiceID,
+		Short:       's',
+	})
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Action:      c.serviceName.Set,
+		Name:        cmd.FlagServiceName,
+		Description: cmd.FlagServiceDesc,
+		Dst:         &c.serviceName.Value,
+	})
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Name:        cmd.FlagVersionName,
+		Description: cmd.FlagVersionDesc,
+		Dst:         &c.serviceVersion.Value,
+		Required:    true,
+	})
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
+	if err := c.CheckOutputFlags(); err != nil {
+		return err
+	}
+
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AllowActiveLocked:  true,
+		APIClient:          c.Globals.APIClient,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceNameFlag:    c.serviceName,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": fsterr.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	c.Input.ServiceID = serviceID
+	c.Input.ServiceVersion = serviceVersion.Number
+
+	cacheSettings, err := c.Globals.APIClient.ListCacheSettings(&c.Input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	if !c.Globals.Verbose() {
+		if ok, err := c.WriteOutput(out, cacheSettings); ok {
+			return err
+		}
+
+		tw := text.NewTable(out)
+		tw.AddHeader("SERVICE", "VERSION", "NAME", "ACTION", "TTL", "STALE TTL", "CACHE CONDITION")
+		for _, cs := range cacheSettings {
+			tw.AddLine(cs.ServiceID, cs.ServiceVersion, cs.Name, cs.Action, cs.TTL, cs.StaleTTL, cs.CacheCondition)
+		}
+		tw.Print()
+		return nil
+	}
+
+	fmt.Fprintf(out, "Version: %d\n", c.Input.ServiceVersion)
+	for i, cs := range cacheSettings {
+		fmt.Fprintf(out, "\tCache setting %d/%d\n", i+1, len(cacheSettings))
+		text.PrintCacheSetting(out, "\t\t", cs)
+	}
+	fmt.Fprintln(out)
+
+	return nil
+}
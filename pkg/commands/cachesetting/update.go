@@ -0,0 +1,120 @@
+package cachesetting
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v6/fastly"
+)
+
+// UpdateCommand calls the Fastly API to update cache settings.
+type UpdateCommand struct {
+	cmd.Base
+	manifest       manifest.Data
+	input          fastly.UpdateCacheSettingInput
+	serviceName    cmd.OptionalServiceNameID
+	serviceVersion cmd.OptionalServiceVersion
+	autoClone      cmd.OptionalAutoClone
+
+	NewName        cmd.OptionalString
+	Action         cmd.OptionalString
+	TTL            cmd.OptionalUint
+	StaleTTL       cmd.OptionalUint
+	CacheCondition cmd.OptionalString
+}
+
+// NewUpdateCommand returns a usable command registered under the parent.
+func NewUpdateCommand(parent cmd.Registerer, globals *config.Data, data manifest.Data) *UpdateCommand {
+	var c UpdateCommand
+	c.Globals = globals
+	c.manifest = data
+	c.CmdClause = parent.Command("update", "Update a cache setting on a Fastly service version")
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Name:        cmd.FlagServiceIDName,
+		Description: cmd.FlagServiceIDDesc,
+		Dst:         &c.manifest.Flag.ServiceID,
+		Short:       's',
+	})
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Action:      c.serviceName.Set,
+		Name:        cmd.FlagServiceName,
+		Description: cmd.FlagServiceDesc,
+		Dst:         &c.serviceName.Value,
+	})
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Name:        cmd.FlagVersionName,
+		Description: cmd.FlagVersionDesc,
+		Dst:         &c.serviceVersion.Value,
+		Required:    true,
+	})
+	c.RegisterAutoCloneFlag(cmd.AutoCloneFlagOpts{
+		Action: c.autoClone.Set,
+		Dst:    &c.autoClone.Value,
+	})
+	c.CmdClause.Flag("name", "Name of cache setting").Short('n').Required().StringVar(&c.input.Name)
+	c.CmdClause.Flag("new-name", "New name of cache setting").Action(c.NewName.Set).StringVar(&c.NewName.Value)
+	c.CmdClause.Flag("action", "Accepts a string value: cache, pass, restart").Action(c.Action.Set).EnumVar(&c.Action.Value, cacheSettingActions...)
+	c.CmdClause.Flag("ttl", "Maximum time in seconds to consider the object fresh in the cache").Action(c.TTL.Set).UintVar(&c.TTL.Value)
+	c.CmdClause.Flag("stale-ttl", "Maximum time in seconds to continue to use a stale version of the object if future requests to the backend are failing").Action(c.StaleTTL.Set).UintVar(&c.StaleTTL.Value)
+	c.CmdClause.Flag("cache-condition", "Name of a cache condition controlling when this configuration applies").Action(c.CacheCondition.Set).StringVar(&c.CacheCondition.Value)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *UpdateCommand) Exec(_ io.Reader, out io.Writer) error {
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AutoCloneFlag:      c.autoClone,
+		APIClient:          c.Globals.APIClient,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceNameFlag:    c.serviceName,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	c.input.ServiceID = serviceID
+	c.input.ServiceVersion = serviceVersion.Number
+
+	if c.NewName.WasSet {
+		c.input.NewName = fastly.String(c.NewName.Value)
+	}
+
+	if c.Action.WasSet {
+		c.input.Action = fastly.CacheSettingAction(c.Action.Value)
+	}
+
+	if c.TTL.WasSet {
+		c.input.TTL = fastly.Uint(c.TTL.Value)
+	}
+
+	if c.StaleTTL.WasSet {
+		c.input.StaleTTL = fastly.Uint(c.StaleTTL.Value)
+	}
+
+	if c.CacheCondition.WasSet {
+		c.input.CacheCondition = fastly.String(c.CacheCondition.Value)
+	}
+
+	cs, err := c.Globals.APIClient.UpdateCacheSetting(&c.input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	text.Success(out, "Updated cache setting %s (service %s version %d)", cs.Name, cs.ServiceID, cs.ServiceVersion)
+	return nil
+}
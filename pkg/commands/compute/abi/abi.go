@@ -0,0 +1,141 @@
+package abi
+
+import (
+	_ "embed"
+	"fmt"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/fastly/cli/pkg/commands/compute/wasm"
+	toml "github.com/pelletier/go-toml"
+)
+
+// hostcalls is the list of host functions bundled with the CLI.
+//
+//go:embed hostcalls.toml
+var hostcalls []byte
+
+// EntryPoint is the function a Wasm binary must export to be run.
+const EntryPoint = "_start"
+
+// Spec describes the host functions available to a Wasm binary.
+type Spec struct {
+	// Versions are the ABI versions and the host functions they introduced.
+	Versions []Version `toml:"abi"`
+	// SDKs are the ABI versions supported by each language SDK release.
+	SDKs []SDK `toml:"sdks"`
+
+	// functions maps "module.name" to the ABI version that introduced it.
+	functions map[string]int
+}
+
+// Version describes the host functions introduced by an ABI version.
+type Version struct {
+	Version int                 `toml:"version"`
+	Modules map[string][]string `toml:"modules"`
+}
+
+// SDK describes the ABI version supported by releases of a language SDK.
+type SDK struct {
+	Language   string `toml:"language"`
+	Constraint string `toml:"constraint"`
+	ABI        int    `toml:"abi"`
+}
+
+// Load returns the list of host functions bundled with the CLI.
+func Load() (*Spec, error) {
+	return Parse(hostcalls)
+}
+
+// Parse decodes a list of host functions.
+func Parse(data []byte) (*Spec, error) {
+	var s Spec
+	if err := toml.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("error parsing host functions: %w", err)
+	}
+	s.functions = make(map[string]int)
+	for _, v := range s.Versions {
+		for module, names := range v.Modules {
+			for _, name := range names {
+				key := module + "." + name
+				if _, ok := s.functions[key]; ok {
+					return nil, fmt.Errorf("error parsing host functions: %s is defined more than once", key)
+				}
+				s.functions[key] = v.Version
+			}
+		}
+	}
+	return &s, nil
+}
+
+// Latest returns the most recent ABI version.
+func (s *Spec) Latest() int {
+	var latest int
+	for _, v := range s.Versions {
+		if v.Version > latest {
+			latest = v.Version
+		}
+	}
+	return latest
+}
+
+// Lookup returns the ABI version that introduced the host function.
+func (s *Spec) Lookup(module, name string) (version int, ok bool) {
+	version, ok = s.functions[module+"."+name]
+	return version, ok
+}
+
+// SDKVersion returns the ABI version supported by the given release of the
+// language SDK.
+func (s *Spec) SDKVersion(language, version string) (int, error) {
+	v, err := semver.NewVersion(version)
+	if err != nil {
+		return 0, fmt.Errorf("error parsing SDK version '%s': %w", version, err)
+	}
+	for _, sdk := range s.SDKs {
+		if sdk.Language != language {
+			continue
+		}
+		c, err := semver.NewConstraint(sdk.Constraint)
+		if err != nil {
+			return 0, fmt.Errorf("error parsing SDK constraint '%s': %w", sdk.Constraint, err)
+		}
+		if c.Check(v) {
+			return sdk.ABI, nil
+		}
+	}
+	return 0, fmt.Errorf("no known ABI version for the %s SDK version %s", language, version)
+}
+
+// CheckImports returns the reasons the module's imports are incompatible with
+// the host.
+//
+// Each function import must be a known host function and, when version is
+// non-zero, must have been introduced at or before that ABI version. Other
+// imports (e.g. an imported memory) aren't host calls and so aren't checked.
+func (s *Spec) CheckImports(m *wasm.Module, version int) []string {
+	var problems []string
+	for _, imp := range m.Imports {
+		if imp.Kind != "func" {
+			continue
+		}
+		introduced, ok := s.Lookup(imp.Module, imp.Name)
+		switch {
+		case !ok:
+			problems = append(problems, fmt.Sprintf("unknown import %s.%s", imp.Module, imp.Name))
+		case version > 0 && introduced > version:
+			problems = append(problems, fmt.Sprintf("import %s.%s requires ABI version %d, but the SDK supports ABI version %d", imp.Module, imp.Name, introduced, version))
+		}
+	}
+	return problems
+}
+
+// HasEntryPoint indicates if the module exports its entry point, without which
+// it can't be run by the host.
+func HasEntryPoint(m *wasm.Module) bool {
+	for _, e := range m.Exports {
+		if e.Name == EntryPoint && e.Kind == "func" {
+			return true
+		}
+	}
+	return false
+}
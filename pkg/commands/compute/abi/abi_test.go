@@ -0,0 +1,76 @@
+package abi_test
+
+import (
+	"testing"
+
+	"github.com/fastly/cli/pkg/commands/compute/abi"
+	"github.com/fastly/cli/pkg/testutil"
+)
+
+func TestLoad(t *testing.T) {
+	spec, err := abi.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	version, ok := spec.Lookup("fastly_http_req", "send")
+	testutil.AssertBool(t, true, ok)
+	testutil.AssertEqual(t, 1, version)
+
+	version, ok = spec.Lookup("fastly_secret_store", "get")
+	testutil.AssertBool(t, true, ok)
+	testutil.AssertEqual(t, spec.Latest(), version)
+
+	_, ok = spec.Lookup("fastly_http_req", "teleport")
+	testutil.AssertBool(t, false, ok)
+
+	// Every ABI version must be supported by the latest release of each SDK.
+	for _, language := range []string{"rust", "javascript", "go"} {
+		version, err := spec.SDKVersion(language, "99.0.0")
+		testutil.AssertNoError(t, err)
+		testutil.AssertEqual(t, spec.Latest(), version)
+	}
+}
+
+func TestSDKVersion(t *testing.T) {
+	spec, err := abi.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	scenarios := []struct {
+		language  string
+		version   string
+		wantABI   int
+		wantError string
+	}{
+		{language: "rust", version: "0.8.0", wantABI: 1},
+		{language: "rust", version: "0.8.9", wantABI: 2},
+		{language: "rust", version: "0.9.1", wantABI: 3},
+		{language: "javascript", version: "0.5.3", wantABI: 2},
+		{language: "rust", version: "latest", wantError: "error parsing SDK version 'latest'"},
+		{language: "assemblyscript", version: "0.1.0", wantError: "no known ABI version for the assemblyscript SDK version 0.1.0"},
+	}
+	for _, s := range scenarios {
+		t.Run(s.language+"@"+s.version, func(t *testing.T) {
+			version, err := spec.SDKVersion(s.language, s.version)
+			testutil.AssertErrorContains(t, err, s.wantError)
+			testutil.AssertEqual(t, s.wantABI, version)
+		})
+	}
+}
+
+func TestParseDuplicate(t *testing.T) {
+	_, err := abi.Parse([]byte(`
+[[abi]]
+version = 1
+  [abi.modules]
+  fastly_log = ["write"]
+
+[[abi]]
+version = 2
+  [abi.modules]
+  fastly_log = ["write"]
+`))
+	testutil.AssertErrorContains(t, err, "fastly_log.write is defined more than once")
+}
@@ -0,0 +1,4 @@
+// Package abi describes the Compute@Edge host functions (the ABI) that a Wasm
+// binary may import, as a versioned list bundled with the CLI, and checks a
+// module's imports and exports against it.
+package abi
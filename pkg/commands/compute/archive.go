@@ -0,0 +1,245 @@
+package compute
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// archiveModTime is the modification time recorded for every archive entry.
+//
+// NOTE: Using a fixed time (rather than the on-disk mtime) is what allows the
+// same inputs to produce a byte-identical package archive.
+var archiveModTime = time.Unix(0, 0)
+
+// archiveFile is a file to be written to a package archive.
+type archiveFile struct {
+	// name is the slash separated path of the file within the archive.
+	name string
+	// src is the path of the file on disk.
+	src string
+	// data, when set, is written in place of the contents of src.
+	data []byte
+}
+
+// writePackageArchive writes a reproducible tar.gz archive to destination.
+//
+// Entries are written in lexical order, with any parent directories added
+// implicitly, and with normalised ownership, permissions and modification
+// times. The gzip header omits the name and modification time.
+func writePackageArchive(files []archiveFile, destination string) (err error) {
+	dirs := make(map[string]bool)
+	for _, f := range files {
+		for d := path.Dir(f.name); d != "." && d != "/"; d = path.Dir(d) {
+			dirs[d] = true
+		}
+	}
+	entries := make([]archiveFile, 0, len(files)+len(dirs))
+	entries = append(entries, files...)
+	for d := range dirs {
+		entries = append(entries, archiveFile{name: d + "/"})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].name < entries[j].name
+	})
+
+	if err := os.MkdirAll(filepath.Dir(destination), 0o750); err != nil {
+		return err
+	}
+	// gosec flagged this:
+	// G304 (CWE-22): Potential file inclusion via variable
+	//
+	// Disabling as the destination is determined by the CLI.
+	/* #nosec */
+	out, err := os.Create(destination)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := out.Close(); err == nil {
+			err = cerr
+		}
+	}()
+
+	gz, err := gzip.NewWriterLevel(out, gzip.DefaultCompression)
+	if err != nil {
+		return err
+	}
+	tw := tar.NewWriter(gz)
+
+	for _, e := range entries {
+		if e.src == "" {
+			if err := tw.WriteHeader(archiveHeader(e.name, tar.TypeDir, 0o755, 0)); err != nil {
+				return fmt.Errorf("error writing directory '%s': %w", e.name, err)
+			}
+			continue
+		}
+		if err := writeArchiveFile(tw, e); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// writeArchiveFile writes the file's header and contents to the archive.
+func writeArchiveFile(tw *tar.Writer, f archiveFile) error {
+	// gosec flagged this:
+	// G304 (CWE-22): Potential file inclusion via variable
+	//
+	// Disabling as the files are those produced by the build.
+	/* #nosec */
+	in, err := os.Open(f.src)
+	if err != nil {
+		return fmt.Errorf("error reading '%s': %w", f.src, err)
+	}
+	defer in.Close() // #nosec G307
+
+	fi, err := in.Stat()
+	if err != nil {
+		return fmt.Errorf("error reading '%s': %w", f.src, err)
+	}
+
+	// NOTE: Only the executable bit is preserved as the remaining permissions
+	// vary with the user's umask.
+	var mode int64 = 0o644
+	if fi.Mode()&0o111 != 0 {
+		mode = 0o755
+	}
+
+	var r io.Reader = in
+	size := fi.Size()
+	if f.data != nil {
+		r = bytes.NewReader(f.data)
+		size = int64(len(f.data))
+	}
+
+	if err := tw.WriteHeader(archiveHeader(f.name, tar.TypeReg, mode, size)); err != nil {
+		return fmt.Errorf("error writing '%s': %w", f.name, err)
+	}
+	if _, err := io.Copy(tw, r); err != nil {
+		return fmt.Errorf("error writing '%s': %w", f.name, err)
+	}
+	return nil
+}
+
+// archiveHeader returns a tar header with normalised metadata.
+func archiveHeader(name string, typeflag byte, mode, size int64) *tar.Header {
+	return &tar.Header{
+		Typeflag: typeflag,
+		Name:     name,
+		Mode:     mode,
+		Size:     size,
+		ModTime:  archiveModTime,
+		Format:   tar.FormatPAX,
+	}
+}
+
+// archiveDigests returns the SHA256 digest of each file within the tar.gz
+// archive, keyed by the file's path within the archive.
+func archiveDigests(src string) (map[string]string, error) {
+	// gosec flagged this:
+	// G304 (CWE-22): Potential file inclusion via variable
+	//
+	// Disabling as the package is chosen by the user.
+	/* #nosec */
+	f, err := os.Open(src)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close() // #nosec G307
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, fmt.Errorf("error reading '%s': %w", src, err)
+	}
+	tr := tar.NewReader(gz)
+
+	digests := make(map[string]string)
+	for {
+		h, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error reading '%s': %w", src, err)
+		}
+		if h.Typeflag != tar.TypeReg {
+			continue
+		}
+		hash := sha256.New()
+		// gosec flagged this:
+		// G110 (CWE-409): Potential DoS vulnerability via decompression bomb
+		//
+		// Disabling as the package is chosen by the user.
+		/* #nosec */
+		if _, err := io.Copy(hash, tr); err != nil {
+			return nil, fmt.Errorf("error reading '%s': %w", src, err)
+		}
+		digests[h.Name] = fmt.Sprintf("%x", hash.Sum(nil))
+	}
+	return digests, nil
+}
+
+// readArchiveFiles returns the contents of the files within the tar.gz archive
+// whose base name is one of names, keyed by that base name.
+func readArchiveFiles(src string, names ...string) (map[string][]byte, error) {
+	// gosec flagged this:
+	// G304 (CWE-22): Potential file inclusion via variable
+	//
+	// Disabling as the package is chosen by the user.
+	/* #nosec */
+	f, err := os.Open(src)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close() // #nosec G307
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, fmt.Errorf("error reading '%s': %w", src, err)
+	}
+	tr := tar.NewReader(gz)
+
+	want := make(map[string]bool, len(names))
+	for _, name := range names {
+		want[name] = true
+	}
+
+	files := make(map[string][]byte)
+	for {
+		h, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error reading '%s': %w", src, err)
+		}
+		base := path.Base(h.Name)
+		if h.Typeflag != tar.TypeReg || !want[base] {
+			continue
+		}
+		// gosec flagged this:
+		// G110 (CWE-409): Potential DoS vulnerability via decompression bomb
+		//
+		// Disabling as the package is chosen by the user.
+		/* #nosec */
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, fmt.Errorf("error reading '%s': %w", src, err)
+		}
+		files[base] = data
+	}
+	return files, nil
+}
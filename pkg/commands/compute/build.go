@@ -2,12 +2,14 @@ package compute
 
 import (
 	"bufio"
-	"crypto/rand"
+	"bytes"
 	"errors"
 	"fmt"
 	"io"
 	"os"
+	"path"
 	"path/filepath"
+	"regexp"
 	"strings"
 
 	"github.com/fastly/cli/pkg/cmd"
@@ -16,7 +18,7 @@ import (
 	"github.com/fastly/cli/pkg/filesystem"
 	"github.com/fastly/cli/pkg/manifest"
 	"github.com/fastly/cli/pkg/text"
-	"github.com/mholt/archiver/v3"
+	toml "github.com/pelletier/go-toml"
 )
 
 // IgnoreFilePath is the filepath name of the Fastly ignore file.
@@ -28,8 +30,10 @@ const CustomPostBuildScriptMessage = "This project has a custom post build scrip
 
 // Flags represents the flags defined for the command.
 type Flags struct {
+	Env              string
 	IncludeSrc       bool
 	Lang             string
+	NoCache          bool
 	SkipVerification bool
 	Timeout          int
 }
@@ -42,6 +46,18 @@ type BuildCommand struct {
 	// commands can set the values appropriately before calling Exec().
 	Flags    Flags
 	Manifest manifest.Data
+
+	// NOTE: kingpin reserves the "no-" prefix for negating boolean flags, so
+	// --no-cache is implemented as the inverse of --cache.
+	cache cmd.OptionalBool
+}
+
+// sdkVersioner is implemented by toolchains that can report the version of
+// the Compute@Edge SDK the package is compiled with, so that it's recorded in
+// the fastly.toml `sdk_version` and the package's imports can be checked
+// against the ABI supported by that release.
+type sdkVersioner interface {
+	SDKVersion() (string, error)
 }
 
 // NewBuildCommand returns a usable command registered under the parent.
@@ -53,6 +69,8 @@ func NewBuildCommand(parent cmd.Registerer, globals *config.Data, data manifest.
 
 	// NOTE: when updating these flags, be sure to update the composite commands:
 	// `compute publish` and `compute serve`.
+	c.CmdClause.Flag("cache", "Reuse the previous build when no changes were detected (use --no-cache to force a rebuild)").Default("true").Action(c.cache.Set).NegatableBoolVar(&c.cache.Value)
+	c.CmdClause.Flag("env", flagEnvDesc).StringVar(&c.Flags.Env)
 	c.CmdClause.Flag("include-source", "Include source code in built package").BoolVar(&c.Flags.IncludeSrc)
 	c.CmdClause.Flag("language", "Language type").StringVar(&c.Flags.Lang)
 	c.CmdClause.Flag("skip-verification", "Skip verification steps and force build").BoolVar(&c.Flags.SkipVerification)
@@ -72,6 +90,10 @@ func (c *BuildCommand) Exec(in io.Reader, out io.Writer) (err error) {
 		}
 	}(c.Globals.ErrLog)
 
+	if c.cache.WasSet {
+		c.Flags.NoCache = !c.cache.Value
+	}
+
 	progress.Step("Verifying package manifest...")
 
 	err = c.Manifest.File.ReadError()
@@ -83,6 +105,12 @@ func (c *BuildCommand) Exec(in io.Reader, out io.Writer) (err error) {
 		return err
 	}
 
+	err = applyEnvironment(&c.Manifest, c.Flags.Env)
+	if err != nil {
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+
 	// Language from flag takes priority, otherwise infer from manifest and
 	// error if neither are provided. Sanitize by trim and lowercase.
 	var toolchain string
@@ -168,7 +196,30 @@ func (c *BuildCommand) Exec(in io.Reader, out io.Writer) (err error) {
 			),
 		})
 	default:
-		return fmt.Errorf("unsupported language %s", toolchain)
+		declaration, ok := customToolchain(toolchain, c.Manifest.File.Toolchains, c.Globals.File.Toolchains)
+		if !ok {
+			return fsterr.RemediationError{
+				Inner:       fmt.Errorf("unsupported language %s", toolchain),
+				Remediation: fmt.Sprintf("Use a supported language (rust, javascript, go, assemblyscript or other), or declare the language's toolchain in a [toolchains.%s] section of the fastly.toml manifest.", toolchain),
+			}
+		}
+		custom, err := NewCustom(
+			toolchain,
+			declaration,
+			&c.Manifest.File,
+			c.Globals.ErrLog,
+			c.Flags.Timeout,
+			progress,
+		)
+		if err != nil {
+			return err
+		}
+		language = NewLanguage(&LanguageOptions{
+			Name:            toolchain,
+			SourceDirectory: declaration.SourceDirectory,
+			IncludeFiles:    declaration.IncludeFiles,
+			Toolchain:       custom,
+		})
 	}
 
 	// NOTE: A ./bin directory is required for the main.wasm to be placed inside.
@@ -183,6 +234,29 @@ func (c *BuildCommand) Exec(in io.Reader, out io.Writer) (err error) {
 		return fmt.Errorf("failed to create bin directory: %w", err)
 	}
 
+	ignoreFiles, err := GetIgnoredFiles(IgnoreFilePath)
+	if err != nil {
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+
+	dest := filepath.Join("pkg", "package.tar.gz")
+	cachePath := filepath.Join("pkg", BuildCacheFilename)
+
+	// NOTE: A failure to compute the cache key isn't fatal, it just means the
+	// package is rebuilt.
+	if !c.Flags.NoCache {
+		key, err := buildCacheKey(language, c.Manifest.File.Scripts, c.Flags, ignoreFiles)
+		if err == nil && filesystem.FileExists(filepath.Join(binDir, "main.wasm")) {
+			if cache, err := readBuildCache(cachePath); err == nil && cache.fresh(key, dest) {
+				progress.Done()
+				text.Info(out, "No changes detected since the last build, skipping compilation. Use --no-cache to force a rebuild.")
+				text.Success(out, "Built package (%s)", dest)
+				return nil
+			}
+		}
+	}
+
 	if !c.Flags.SkipVerification {
 		progress.Step(fmt.Sprintf("Verifying local %s toolchain...", toolchain))
 
@@ -223,6 +297,20 @@ func (c *BuildCommand) Exec(in io.Reader, out io.Writer) (err error) {
 		return err
 	}
 
+	// NOTE: The SDK version is only recorded in the fastly.toml within the
+	// package, as the user's fastly.toml mustn't be rewritten by a build. A
+	// failure to record it isn't fatal, it just means the Wasm binary is checked
+	// against the latest ABI version when validated.
+	var overrides map[string][]byte
+	if v, ok := language.Toolchain.(sdkVersioner); ok {
+		data, err := packagedManifest(v)
+		if err != nil {
+			c.Globals.ErrLog.Add(err)
+		} else {
+			overrides = map[string][]byte{manifest.Filename: data}
+		}
+	}
+
 	if c.Globals.Verbose() {
 		text.Break(out)
 	}
@@ -230,19 +318,11 @@ func (c *BuildCommand) Exec(in io.Reader, out io.Writer) (err error) {
 	progress = text.ResetProgress(out, c.Globals.Verbose())
 	progress.Step("Creating package archive...")
 
-	dest := filepath.Join("pkg", "package.tar.gz")
-
 	files := []string{
 		manifest.Filename,
 	}
 	files = append(files, language.IncludeFiles...)
 
-	ignoreFiles, err := GetIgnoredFiles(IgnoreFilePath)
-	if err != nil {
-		c.Globals.ErrLog.Add(err)
-		return err
-	}
-
 	binFiles, err := GetNonIgnoredFiles("bin", ignoreFiles)
 	if err != nil {
 		c.Globals.ErrLog.AddWithContext(err, map[string]any{
@@ -264,7 +344,7 @@ func (c *BuildCommand) Exec(in io.Reader, out io.Writer) (err error) {
 		files = append(files, srcFiles...)
 	}
 
-	err = CreatePackageArchive(files, dest)
+	err = createPackageArchive(files, overrides, dest)
 	if err != nil {
 		c.Globals.ErrLog.AddWithContext(err, map[string]any{
 			"Files":       files,
@@ -273,6 +353,16 @@ func (c *BuildCommand) Exec(in io.Reader, out io.Writer) (err error) {
 		return fmt.Errorf("error creating package archive: %w", err)
 	}
 
+	// NOTE: The key is computed after the build as the build itself may update
+	// the inputs (e.g. a lockfile updated by installing dependencies).
+	key, err := buildCacheKey(language, c.Manifest.File.Scripts, c.Flags, ignoreFiles)
+	if err == nil && key != "" {
+		err = writeBuildCache(cachePath, key, dest)
+	}
+	if err != nil {
+		c.Globals.ErrLog.Add(err)
+	}
+
 	progress.Done()
 
 	// When patching fastly.toml with a default build command, in --verbose mode
@@ -325,50 +415,78 @@ func promptForBuildContinue(msg, script string, out io.Writer, in io.Reader, ver
 // CreatePackageArchive packages build artifacts as a Fastly package.
 // The package must be a GZipped Tar archive.
 //
-// Due to a behavior of archiver.Archive() which recursively writes all files in
-// a provided directory to the archive we first copy our input files to a
-// temporary directory to ensure only the specified files are included and not
-// any in the directory which may be ignored.
+// The files are written beneath an implicit top-level directory named after
+// the destination, and the archive is reproducible: the same files always
+// produce a byte-identical package (see writePackageArchive).
 func CreatePackageArchive(files []string, destination string) error {
-	// Create temporary directory to copy files into.
-	p := make([]byte, 8)
-	n, err := rand.Read(p)
-	if err != nil {
-		return fmt.Errorf("error creating temporary directory: %w", err)
-	}
+	return createPackageArchive(files, nil, destination)
+}
 
-	tmpDir := filepath.Join(
-		os.TempDir(),
-		fmt.Sprintf("fastly-build-%x", p[:n]),
-	)
+// createPackageArchive packages build artifacts as a Fastly package, writing
+// the data in overrides in place of the contents of the file it's keyed by.
+func createPackageArchive(files []string, overrides map[string][]byte, destination string) error {
+	root := FileNameWithoutExtension(destination)
 
-	if err := os.MkdirAll(tmpDir, 0o700); err != nil {
-		return fmt.Errorf("error creating temporary directory: %w", err)
+	entries := make([]archiveFile, 0, len(files))
+	for _, src := range files {
+		entries = append(entries, archiveFile{
+			name: path.Join(root, filepath.ToSlash(src)),
+			src:  src,
+			data: overrides[src],
+		})
 	}
-	defer os.RemoveAll(tmpDir)
 
-	// Create implicit top-level directory within temp which will become the
-	// root of the archive. This replaces the `tar.ImplicitTopLevelFolder`
-	// behavior.
-	dir := filepath.Join(tmpDir, FileNameWithoutExtension(destination))
-	if err := os.Mkdir(dir, 0o700); err != nil {
-		return fmt.Errorf("error creating temporary directory: %w", err)
-	}
+	return writePackageArchive(entries, destination)
+}
 
-	for _, src := range files {
-		dst := filepath.Join(dir, src)
-		if err = filesystem.CopyFile(src, dst); err != nil {
-			return fmt.Errorf("error copying file: %w", err)
-		}
+// packagedManifest returns the content of the fastly.toml with the version of
+// the SDK the package is compiled with recorded in its sdk_version.
+func packagedManifest(v sdkVersioner) ([]byte, error) {
+	version, err := v.SDKVersion()
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(manifest.Filename)
+	if err != nil {
+		return nil, err
 	}
+	return withSDKVersion(data, version)
+}
+
+// withSDKVersion sets the top-level sdk_version of the manifest data, leaving
+// the rest of its content (e.g. comments and the order of keys) untouched.
+func withSDKVersion(data []byte, version string) ([]byte, error) {
+	line := fmt.Sprintf("sdk_version = %q", version)
 
-	tar := archiver.NewTarGz()
-	tar.OverwriteExisting = true //
-	tar.MkdirAll = true          // make destination directory if it doesn't exist
+	var result []byte
+	switch {
+	case sdkVersionLine.Match(data):
+		result = sdkVersionLine.ReplaceAllLiteral(data, []byte(line))
+	case tableHeader.Match(data):
+		// NOTE: Top-level keys must precede the first table.
+		i := tableHeader.FindIndex(data)[0]
+		result = append(append(append(result, data[:i]...), line+"\n\n"...), data[i:]...)
+	default:
+		result = append(result, bytes.TrimRight(data, "\n")...)
+		result = append(result, "\n"+line+"\n"...)
+	}
 
-	return tar.Archive([]string{dir}, destination)
+	// NOTE: The manifest is parsed to verify the value was set at the top-level
+	// (e.g. the matched line wasn't within a multi-line string).
+	tree, err := toml.LoadBytes(result)
+	if err != nil || tree.Get("sdk_version") != version {
+		return nil, fmt.Errorf("error recording the sdk_version in the packaged %s", manifest.Filename)
+	}
+	return result, nil
 }
 
+var (
+	// sdkVersionLine matches an sdk_version key.
+	sdkVersionLine = regexp.MustCompile(`(?m)^sdk_version\s*=.*$`)
+	// tableHeader matches the header of a table or array of tables.
+	tableHeader = regexp.MustCompile(`(?m)^\s*\[`)
+)
+
 // FileNameWithoutExtension returns a filename with its extension stripped.
 func FileNameWithoutExtension(filename string) string {
 	base := filepath.Base(filename)
@@ -0,0 +1,188 @@
+package compute
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/fastly/cli/pkg/filesystem"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/revision"
+)
+
+// BuildCacheFilename is the file, within the pkg directory, that records the
+// inputs of the most recent build.
+const BuildCacheFilename = ".build-cache.json"
+
+// toolchainVersioner is implemented by toolchains that can report their
+// version, so that a toolchain upgrade invalidates the build cache.
+type toolchainVersioner interface {
+	ToolchainVersion() (string, error)
+}
+
+// buildCache records the inputs of a build and the package it produced.
+type buildCache struct {
+	// Key is a digest of the build inputs (see buildCacheKey).
+	Key string `json:"key"`
+	// Package is a digest of the package archive produced by the build.
+	Package string `json:"package"`
+}
+
+// buildCacheKey returns a digest of the inputs to a build.
+//
+// The inputs are the non-ignored source files, the files at the root of the
+// project (e.g. fastly.toml, Cargo.lock, package.json, go.mod), the manifest
+// [scripts], the build flags and the toolchain and CLI versions.
+//
+// NOTE: An empty key, which never matches a cache, is returned when the
+// language has no known source directory (i.e. 'other') or the toolchain
+// doesn't report its version, as the cache can't then be trusted.
+func buildCacheKey(language *Language, scripts manifest.Scripts, flags Flags, ignoreFiles map[string]bool) (string, error) {
+	if language.SourceDirectory == "" {
+		return "", nil
+	}
+
+	v, ok := language.Toolchain.(toolchainVersioner)
+	if !ok {
+		return "", nil
+	}
+	toolchainVersion, err := v.ToolchainVersion()
+	if err != nil {
+		return "", nil
+	}
+
+	files, err := buildCacheFiles(language, ignoreFiles)
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.New()
+	fmt.Fprintf(h, "cli %s\n", revision.AppVersion)
+	fmt.Fprintf(h, "language %s\n", language.Name)
+	fmt.Fprintf(h, "toolchain %q\n", toolchainVersion)
+	fmt.Fprintf(h, "env %q\n", flags.Env)
+	fmt.Fprintf(h, "include-source %t\n", flags.IncludeSrc)
+	fmt.Fprintf(h, "scripts.build %q\n", scripts.Build)
+	fmt.Fprintf(h, "scripts.post_build %q\n", scripts.PostBuild)
+	for _, f := range files {
+		digest, err := fileDigest(f)
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(h, "file %q %s\n", filepath.ToSlash(f), digest)
+	}
+	return fmt.Sprintf("%x", h.Sum(nil)), nil
+}
+
+// buildCacheFiles returns the sorted, de-duplicated list of files that are
+// inputs to the build.
+func buildCacheFiles(language *Language, ignoreFiles map[string]bool) ([]string, error) {
+	seen := make(map[string]bool)
+	add := func(f string) {
+		if !ignoreFiles[f] {
+			seen[filepath.Clean(f)] = true
+		}
+	}
+
+	entries, err := os.ReadDir(".")
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range entries {
+		if e.Type().IsRegular() {
+			add(e.Name())
+		}
+	}
+
+	for _, f := range language.IncludeFiles {
+		add(f)
+	}
+
+	if filesystem.FileExists(language.SourceDirectory) {
+		srcFiles, err := GetNonIgnoredFiles(language.SourceDirectory, ignoreFiles)
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range srcFiles {
+			// NOTE: The build outputs are excluded as they change with every build,
+			// which is relevant for languages whose source directory is the project
+			// root (e.g. Go).
+			switch strings.SplitN(filepath.ToSlash(filepath.Clean(f)), "/", 2)[0] {
+			case ".git", "bin", "pkg":
+				continue
+			}
+			add(f)
+		}
+	}
+
+	files := make([]string, 0, len(seen))
+	for f := range seen {
+		files = append(files, f)
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// readBuildCache returns the cache recorded by the most recent build.
+func readBuildCache(path string) (buildCache, error) {
+	var c buildCache
+	// gosec flagged this:
+	// G304 (CWE-22): Potential file inclusion via variable
+	//
+	// Disabling as the path is determined by the CLI.
+	/* #nosec */
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return c, err
+	}
+	err = json.Unmarshal(data, &c)
+	return c, err
+}
+
+// fresh indicates whether the cache matches the build inputs and the package
+// archive is still the one the cached build produced.
+func (c buildCache) fresh(key, pkgPath string) bool {
+	if key == "" || c.Key != key {
+		return false
+	}
+	digest, err := fileDigest(pkgPath)
+	return err == nil && c.Package == digest
+}
+
+// writeBuildCache records the build inputs and the package they produced.
+func writeBuildCache(path, key, pkgPath string) error {
+	digest, err := fileDigest(pkgPath)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(buildCache{Key: key, Package: digest})
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o600)
+}
+
+// fileDigest returns the SHA256 digest of the file contents.
+func fileDigest(path string) (string, error) {
+	// gosec flagged this:
+	// G304 (CWE-22): Potential file inclusion via variable
+	//
+	// Disabling as the files are those within the project.
+	/* #nosec */
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close() // #nosec G307
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%x", h.Sum(nil)), nil
+}
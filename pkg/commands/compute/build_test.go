@@ -3,6 +3,7 @@ package compute_test
 import (
 	"bytes"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -15,6 +16,7 @@ import (
 	fstruntime "github.com/fastly/cli/pkg/runtime"
 	"github.com/fastly/cli/pkg/testutil"
 	"github.com/fastly/cli/pkg/threadsafe"
+	"github.com/mholt/archiver/v3"
 )
 
 // TestBuildRust validates that the rust ecosystem is in place and accurate.
@@ -724,6 +726,224 @@ func TestOtherBuild(t *testing.T) {
 	}
 }
 
+// TestBuildCustomToolchain validates that a language declared in [toolchains]
+// is verified and built.
+//
+// NOTE: The declared toolchain is the `go` executable, as it's guaranteed to be
+// installed wherever the tests are run.
+func TestBuildCustomToolchain(t *testing.T) {
+	args := testutil.Args
+	if os.Getenv("TEST_COMPUTE_BUILD") == "" {
+		t.Log("skipping test")
+		t.Skip("Set TEST_COMPUTE_BUILD to run this test")
+	}
+
+	// We're going to chdir to a build environment,
+	// so save the PWD to return to, afterwards.
+	pwd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Create test environment
+	rootdir := testutil.NewEnv(testutil.EnvOpts{
+		T: t,
+		Write: []testutil.FileIO{
+			{Src: "mock content", Dst: "bin/testfile"},
+			{Src: "module example.com/custom\n", Dst: "go.mod"},
+		},
+	})
+	defer os.RemoveAll(rootdir)
+
+	// Before running the test, chdir into the build environment.
+	// When we're done, chdir back to our original location.
+	// This is so we can reliably copy the testdata/ fixtures.
+	if err := os.Chdir(rootdir); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(pwd)
+
+	for _, testcase := range []struct {
+		name                 string
+		args                 []string
+		applicationConfig    config.File
+		fastlyManifest       string
+		wantError            string
+		wantOutput           []string
+		wantRemediationError string
+	}{
+		{
+			name: "declared in fastly.toml",
+			args: args("compute build --verbose --no-cache"),
+			fastlyManifest: `
+			manifest_version = 2
+			name = "test"
+			language = "gopher"
+			[toolchains.gopher]
+			toolchain = "go"
+			toolchain_version_command = "go version"
+			toolchain_version_pattern = 'go(\d+\.\d+(?:\.\d+)?)'
+			toolchain_constraint = ">= 1.18"
+			manifest = "go.mod"
+			build = "echo custom toolchain build"`,
+			wantOutput: []string{
+				"Checking if 'go' is installed...",
+				"Checking if manifest 'go.mod' exists...",
+				"custom toolchain build",
+				"Built package",
+			},
+		},
+		{
+			name: "declared in config.toml",
+			args: args("compute build --verbose --no-cache"),
+			applicationConfig: config.File{
+				Toolchains: map[string]manifest.Toolchain{
+					"gopher": {
+						Toolchain: "go",
+						Build:     "echo config toolchain build",
+					},
+				},
+			},
+			fastlyManifest: `
+			manifest_version = 2
+			name = "test"
+			language = "gopher"`,
+			wantOutput: []string{
+				"Checking if 'go' is installed...",
+				"config toolchain build",
+				"Built package",
+			},
+		},
+		{
+			name: "fastly.toml takes precedence over config.toml",
+			args: args("compute build --verbose --no-cache"),
+			applicationConfig: config.File{
+				Toolchains: map[string]manifest.Toolchain{
+					"gopher": {
+						Toolchain: "go-not-installed",
+					},
+				},
+			},
+			fastlyManifest: `
+			manifest_version = 2
+			name = "test"
+			language = "gopher"
+			[scripts]
+			build = "echo scripts build"
+			[toolchains.gopher]
+			toolchain = "go"`,
+			wantOutput: []string{
+				"scripts build",
+				"Built package",
+			},
+		},
+		{
+			name: "toolchain not installed",
+			args: args("compute build --no-cache"),
+			fastlyManifest: `
+			manifest_version = 2
+			name = "test"
+			language = "zig"
+			[toolchains.zig]
+			toolchain = "zig-not-installed"
+			toolchain_url = "https://ziglang.org"
+			build = "zig build"`,
+			wantError:            "'zig-not-installed' not found in $PATH",
+			wantRemediationError: "https://ziglang.org",
+		},
+		{
+			name: "toolchain version constraint not met",
+			args: args("compute build --no-cache"),
+			fastlyManifest: `
+			manifest_version = 2
+			name = "test"
+			language = "gopher"
+			[toolchains.gopher]
+			toolchain = "go"
+			toolchain_version_command = "go version"
+			toolchain_version_pattern = 'go(\d+\.\d+(?:\.\d+)?)'
+			toolchain_constraint = "< 1.0.0"
+			toolchain_remediation = "go install golang.org/dl/go1.0@latest"
+			build = "echo build"`,
+			wantError:            "didn't meet the constraint < 1.0.0",
+			wantRemediationError: "go install golang.org/dl/go1.0@latest",
+		},
+		{
+			name: "missing manifest",
+			args: args("compute build --no-cache"),
+			fastlyManifest: `
+			manifest_version = 2
+			name = "test"
+			language = "gopher"
+			[toolchains.gopher]
+			toolchain = "go"
+			manifest = "build.zig"
+			manifest_remediation = "zig init-exe"
+			build = "echo build"`,
+			wantError:            "build.zig not found",
+			wantRemediationError: "zig init-exe",
+		},
+		{
+			name: "missing compiler",
+			args: args("compute build --no-cache"),
+			fastlyManifest: `
+			manifest_version = 2
+			name = "test"
+			language = "c"
+			[toolchains.c]
+			toolchain = "go"
+			compilation = "wasi-sdk-clang-not-installed"
+			compilation_url = "https://github.com/WebAssembly/wasi-sdk"
+			build = "echo build"`,
+			wantError:            "'wasi-sdk-clang-not-installed' not found",
+			wantRemediationError: "https://github.com/WebAssembly/wasi-sdk",
+		},
+		{
+			name: "no build command",
+			args: args("compute build --no-cache"),
+			fastlyManifest: `
+			manifest_version = 2
+			name = "test"
+			language = "gopher"
+			[toolchains.gopher]
+			toolchain = "go"`,
+			wantError:            "no build command found for gopher",
+			wantRemediationError: "[toolchains.gopher]",
+		},
+		{
+			name: "invalid declaration",
+			args: args("compute build --no-cache"),
+			fastlyManifest: `
+			manifest_version = 2
+			name = "test"
+			language = "gopher"
+			[toolchains.gopher]
+			build = "echo build"`,
+			wantError:            "invalid custom toolchain 'gopher': the 'toolchain' executable is required",
+			wantRemediationError: "[toolchains.gopher]",
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			if err := os.WriteFile(filepath.Join(rootdir, manifest.Filename), []byte(testcase.fastlyManifest), 0o777); err != nil {
+				t.Fatal(err)
+			}
+
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.ConfigFile = testcase.applicationConfig
+			err = app.Run(opts)
+
+			t.Log(stdout.String())
+
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertRemediationErrorContains(t, err, testcase.wantRemediationError)
+			for _, s := range testcase.wantOutput {
+				testutil.AssertStringContains(t, stdout.String(), s)
+			}
+		})
+	}
+}
+
 func TestCustomPostBuild(t *testing.T) {
 	args := testutil.Args
 	if os.Getenv("TEST_COMPUTE_BUILD") == "" {
@@ -1016,3 +1236,259 @@ func TestBuildBinDirectory(t *testing.T) {
 		})
 	}
 }
+
+// TestBuildCache validates that a build is skipped when none of its inputs
+// have changed since the previous build.
+//
+// NOTE: The scenarios run in order against the same project, each building
+// on the state left by the previous one.
+func TestBuildCache(t *testing.T) {
+	args := testutil.Args
+	if os.Getenv("TEST_COMPUTE_BUILD") == "" {
+		t.Log("skipping test")
+		t.Skip("Set TEST_COMPUTE_BUILD to run this test")
+	}
+
+	// We're going to chdir to a build environment,
+	// so save the PWD to return to, afterwards.
+	pwd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Create test environment
+	//
+	// NOTE: The build script doesn't compile anything, so a Wasm binary is
+	// provided for the package.
+	rootdir := testutil.NewEnv(testutil.EnvOpts{
+		T: t,
+		Copy: []testutil.FileIO{
+			{Src: filepath.Join("testdata", "build", "go", "go.mod"), Dst: "go.mod"},
+			{Src: filepath.Join("testdata", "build", "go", "main.go"), Dst: "main.go"},
+			{Src: filepath.Join("testdata", "pack", "main.wasm"), Dst: filepath.Join("bin", "main.wasm")},
+		},
+		Write: []testutil.FileIO{
+			{Src: `
+			# The test project.
+			manifest_version = 2
+			name = "test"
+			language = "go"
+
+			[scripts]
+			build = "echo custom build"`, Dst: manifest.Filename},
+		},
+	})
+	defer os.RemoveAll(rootdir)
+
+	// Before running the test, chdir into the build environment.
+	// When we're done, chdir back to our original location.
+	if err := os.Chdir(rootdir); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(pwd)
+
+	cached := "No changes detected since the last build"
+
+	for _, testcase := range []struct {
+		name           string
+		args           []string
+		setup          func() error
+		wantOutput     []string
+		dontWantOutput []string
+	}{
+		{
+			name:           "initial build",
+			args:           args("compute build --skip-verification"),
+			wantOutput:     []string{"Running [scripts.build]", "Built package"},
+			dontWantOutput: []string{cached},
+		},
+		{
+			name:       "unchanged",
+			args:       args("compute build --skip-verification"),
+			wantOutput: []string{cached, "Built package"},
+		},
+		{
+			name: "source changed",
+			args: args("compute build --skip-verification"),
+			setup: func() error {
+				return os.WriteFile("extra.go", []byte("package main\n"), 0o600)
+			},
+			wantOutput:     []string{"Running [scripts.build]", "Built package"},
+			dontWantOutput: []string{cached},
+		},
+		{
+			name:           "no cache",
+			args:           args("compute build --skip-verification --no-cache"),
+			wantOutput:     []string{"Running [scripts.build]", "Built package"},
+			dontWantOutput: []string{cached},
+		},
+		{
+			name: "ignore file created",
+			args: args("compute build --skip-verification"),
+			setup: func() error {
+				return os.WriteFile(compute.IgnoreFilePath, []byte("notes.txt\n"), 0o600)
+			},
+			wantOutput:     []string{"Running [scripts.build]"},
+			dontWantOutput: []string{cached},
+		},
+		{
+			name: "ignored file created",
+			args: args("compute build --skip-verification"),
+			setup: func() error {
+				return os.WriteFile("notes.txt", []byte("notes"), 0o600)
+			},
+			wantOutput: []string{cached},
+		},
+		{
+			name: "package removed",
+			args: args("compute build --skip-verification"),
+			setup: func() error {
+				return os.Remove(filepath.Join("pkg", "package.tar.gz"))
+			},
+			wantOutput:     []string{"Running [scripts.build]", "Built package"},
+			dontWantOutput: []string{cached},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			if testcase.setup != nil {
+				if err := testcase.setup(); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			err = app.Run(opts)
+
+			t.Log(stdout.String())
+
+			testutil.AssertNoError(t, err)
+			for _, s := range testcase.wantOutput {
+				testutil.AssertStringContains(t, stdout.String(), s)
+			}
+			for _, s := range testcase.dontWantOutput {
+				testutil.AssertStringDoesntContain(t, stdout.String(), s)
+			}
+		})
+	}
+
+	// The SDK version required by the go.mod is recorded in the packaged
+	// fastly.toml, leaving the user's fastly.toml (and its comments) untouched.
+	b, err := os.ReadFile(manifest.Filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testutil.AssertStringDoesntContain(t, string(b), "sdk_version")
+
+	var packaged string
+	if err := archiver.Walk(filepath.Join("pkg", "package.tar.gz"), func(f archiver.File) error {
+		if f.Name() != manifest.Filename {
+			return nil
+		}
+		b, err := io.ReadAll(f)
+		packaged = string(b)
+		return err
+	}); err != nil {
+		t.Fatal(err)
+	}
+	testutil.AssertStringContains(t, packaged, "# The test project.")
+	testutil.AssertStringContains(t, packaged, `sdk_version = "0.1.1"`)
+}
+
+// TestBuildCacheToolchainUpgrade validates that upgrading a compiler installed
+// per project by npm invalidates the build cache.
+func TestBuildCacheToolchainUpgrade(t *testing.T) {
+	args := testutil.Args
+	if os.Getenv("TEST_COMPUTE_BUILD") == "" {
+		t.Log("skipping test")
+		t.Skip("Set TEST_COMPUTE_BUILD to run this test")
+	}
+
+	// We're going to chdir to a build environment,
+	// so save the PWD to return to, afterwards.
+	pwd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	compiler := filepath.Join("node_modules", compute.AsPackage, compute.JsManifest)
+
+	// Create test environment
+	//
+	// NOTE: The build script doesn't compile anything, so a Wasm binary is
+	// provided for the package.
+	rootdir := testutil.NewEnv(testutil.EnvOpts{
+		T: t,
+		Copy: []testutil.FileIO{
+			{Src: filepath.Join("testdata", "pack", "main.wasm"), Dst: filepath.Join("bin", "main.wasm")},
+		},
+		Write: []testutil.FileIO{
+			{Src: `
+			manifest_version = 2
+			name = "test"
+			language = "assemblyscript"
+
+			[scripts]
+			build = "echo custom build"`, Dst: manifest.Filename},
+			{Src: `export function main(): void {}`, Dst: filepath.Join("assembly", "index.ts")},
+			{Src: `{"version": "0.19.0"}`, Dst: compiler},
+		},
+	})
+	defer os.RemoveAll(rootdir)
+
+	// Before running the test, chdir into the build environment.
+	// When we're done, chdir back to our original location.
+	if err := os.Chdir(rootdir); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(pwd)
+
+	cached := "No changes detected since the last build"
+
+	for _, testcase := range []struct {
+		name           string
+		setup          func() error
+		wantOutput     []string
+		dontWantOutput []string
+	}{
+		{
+			name:           "initial build",
+			wantOutput:     []string{"Running [scripts.build]", "Built package"},
+			dontWantOutput: []string{cached},
+		},
+		{
+			name:       "unchanged",
+			wantOutput: []string{cached},
+		},
+		{
+			name: "compiler upgraded",
+			setup: func() error {
+				return os.WriteFile(compiler, []byte(`{"version": "0.20.0"}`), 0o600)
+			},
+			wantOutput:     []string{"Running [scripts.build]", "Built package"},
+			dontWantOutput: []string{cached},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			if testcase.setup != nil {
+				if err := testcase.setup(); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(args("compute build --skip-verification"), &stdout)
+			err = app.Run(opts)
+
+			t.Log(stdout.String())
+
+			testutil.AssertNoError(t, err)
+			for _, s := range testcase.wantOutput {
+				testutil.AssertStringContains(t, stdout.String(), s)
+			}
+			for _, s := range testcase.dontWantOutput {
+				testutil.AssertStringDoesntContain(t, stdout.String(), s)
+			}
+		})
+	}
+}
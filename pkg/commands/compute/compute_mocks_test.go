@@ -49,6 +49,54 @@ func createDictionaryItemOK(i *fastly.CreateDictionaryItemInput) (*fastly.Dictio
 	}, nil
 }
 
+func createACLOK(i *fastly.CreateACLInput) (*fastly.ACL, error) {
+	return &fastly.ACL{
+		ID:             "456",
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           i.Name,
+	}, nil
+}
+
+func createACLEntryOK(i *fastly.CreateACLEntryInput) (*fastly.ACLEntry, error) {
+	return &fastly.ACLEntry{
+		ServiceID: i.ServiceID,
+		ACLID:     i.ACLID,
+		IP:        i.IP,
+	}, nil
+}
+
+func createHealthCheckOK(i *fastly.CreateHealthCheckInput) (*fastly.HealthCheck, error) {
+	return &fastly.HealthCheck{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           i.Name,
+	}, nil
+}
+
+func createObjectStoreOK(i *fastly.CreateObjectStoreInput) (*fastly.ObjectStore, error) {
+	return &fastly.ObjectStore{
+		ID:   "789",
+		Name: i.Name,
+	}, nil
+}
+
+func listObjectStoresOK(_ *fastly.ListObjectStoresInput) (*fastly.ListObjectStoresResponse, error) {
+	return &fastly.ListObjectStoresResponse{
+		Data: []fastly.ObjectStore{
+			{ID: "123", Name: "existing_store"},
+		},
+	}, nil
+}
+
+func createS3OK(i *fastly.CreateS3Input) (*fastly.S3, error) {
+	return &fastly.S3{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           i.Name,
+	}, nil
+}
+
 func getPackageOk(i *fastly.GetPackageInput) (*fastly.Package, error) {
 	return &fastly.Package{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion}, nil
 }
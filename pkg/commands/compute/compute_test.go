@@ -1,10 +1,12 @@
 package compute_test
 
 import (
+	"bytes"
 	"os"
 	"path/filepath"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/fastly/cli/pkg/commands/compute"
 	"github.com/fastly/cli/pkg/commands/update"
@@ -95,8 +97,10 @@ func TestServeFlagDivergence(t *testing.T) {
 	ignoreServeFlags := []string{
 		"addr",
 		"debug",
-		"env",
 		"file",
+		"record",
+		"recordings-dir",
+		"replay",
 		"skip-build",
 		"watch",
 	}
@@ -114,6 +118,61 @@ func TestServeFlagDivergence(t *testing.T) {
 	}
 }
 
+// TestTestFlagDivergence validates that the manually curated list of flags
+// within the `compute test` command doesn't fall out of sync with the
+// `compute build` command as `compute test` delegates to build.
+func TestTestFlagDivergence(t *testing.T) {
+	var (
+		cfg  config.Data
+		data manifest.Data
+	)
+	versioner := update.NewGitHub(update.GitHubOpts{
+		Org:    "fastly",
+		Repo:   "viceroy",
+		Binary: "viceroy",
+	})
+	acmd := kingpin.New("foo", "bar")
+
+	rcmd := compute.NewRootCommand(acmd, &cfg)
+	bcmd := compute.NewBuildCommand(rcmd.CmdClause, &cfg, data)
+	tcmd := compute.NewTestCommand(rcmd.CmdClause, &cfg, bcmd, versioner, data)
+
+	buildFlags := getFlags(bcmd.CmdClause)
+	testFlags := getFlags(tcmd.CmdClause)
+
+	var (
+		expect = make(map[string]int)
+		have   = make(map[string]int)
+	)
+
+	iter := buildFlags.MapRange()
+	for iter.Next() {
+		expect[iter.Key().String()] = 1
+	}
+
+	// Some flags on `compute test` are unique to it.
+	// We only want to be sure test contains all build flags.
+	ignoreTestFlags := []string{
+		"addr",
+		"file",
+		"fixtures",
+		"junit",
+		"skip-build",
+	}
+
+	iter = testFlags.MapRange()
+	for iter.Next() {
+		flag := iter.Key().String()
+		if !ignoreFlag(ignoreTestFlags, flag) {
+			have[flag] = 1
+		}
+	}
+
+	if !reflect.DeepEqual(expect, have) {
+		t.Fatalf("the flags between build and test don't match\n\nexpect: %+v\nhave:   %+v\n\n", expect, have)
+	}
+}
+
 // ignoreFlag indicates if needle should be omitted from comparison.
 func ignoreFlag(ignore []string, flag string) bool {
 	for _, i := range ignore {
@@ -177,6 +236,28 @@ func TestCreatePackageArchive(t *testing.T) {
 
 	wantFiles := []string{"Cargo.lock", "Cargo.toml", "main.rs"}
 	testutil.AssertEqual(t, wantFiles, files)
+
+	// The archive should be reproducible regardless of modification times and
+	// the order the files are provided in.
+	later := time.Now().Add(time.Hour)
+	if err := os.Chtimes("Cargo.toml", later, later); err != nil {
+		t.Fatal(err)
+	}
+	again := filepath.Join("again", destination)
+	err = compute.CreatePackageArchive([]string{"src/main.rs", "Cargo.lock", "Cargo.toml"}, again)
+	testutil.AssertNoError(t, err)
+
+	want, err := os.ReadFile(destination)
+	if err != nil {
+		t.Fatal(err)
+	}
+	have, err := os.ReadFile(again)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(want, have) {
+		t.Fatal("want identical package archives")
+	}
 }
 
 func TestFileNameWithoutExtension(t *testing.T) {
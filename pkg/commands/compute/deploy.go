@@ -43,10 +43,14 @@ type DeployCommand struct {
 	// values appropriately before calling the Exec() function.
 	Comment        cmd.OptionalString
 	Domain         string
+	DryRun         bool
+	Env            string
 	Manifest       manifest.Data
 	Package        string
 	ServiceName    cmd.OptionalServiceNameID
 	ServiceVersion cmd.OptionalServiceVersion
+	SkipABI        bool
+	SyncSetup      bool
 }
 
 // NewDeployCommand returns a usable command registered under the parent.
@@ -78,17 +82,32 @@ func NewDeployCommand(parent cmd.Registerer, globals *config.Data, data manifest
 	})
 	c.CmdClause.Flag("comment", "Human-readable comment").Action(c.Comment.Set).StringVar(&c.Comment.Value)
 	c.CmdClause.Flag("domain", "The name of the domain associated to the package").StringVar(&c.Domain)
+	c.CmdClause.Flag("dry-run", "Display the deployment plan without making any changes").BoolVar(&c.DryRun)
+	c.CmdClause.Flag("env", flagEnvDesc).StringVar(&c.Env)
 	c.CmdClause.Flag("package", "Path to a package tar.gz").Short('p').StringVar(&c.Package)
+	c.CmdClause.Flag("skip-abi", flagSkipABIDesc).BoolVar(&c.SkipABI)
+	c.CmdClause.Flag("sync-setup", "Create [setup] resources missing from an existing service, and report those that differ or are no longer defined").BoolVar(&c.SyncSetup)
+	c.RegisterOutputFlags()
 	return &c
 }
 
 // Exec implements the command interface.
 func (c *DeployCommand) Exec(in io.Reader, out io.Writer) (err error) {
+	if err := checkOutputFlags(&c.Base, c.DryRun); err != nil {
+		return err
+	}
+
 	token, s := c.Globals.Token()
 	if s == config.SourceUndefined {
 		return fsterr.ErrNoToken
 	}
 
+	err = applyEnvironment(&c.Manifest, c.Env)
+	if err != nil {
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+
 	serviceID, source, flag, err := cmd.ServiceID(c.ServiceName, c.Manifest, c.Globals.APIClient, c.Globals.ErrLog)
 	if err == nil && c.Globals.Verbose() {
 		cmd.DisplayServiceID(serviceID, flag, source, out)
@@ -101,11 +120,23 @@ func (c *DeployCommand) Exec(in io.Reader, out io.Writer) (err error) {
 
 	// VALIDATE PACKAGE...
 
-	pkgPath, hashSum, err := validatePackage(c.Manifest, c.Package, verbose, errLog, out)
+	pkgPath, hashSum, err := validatePackage(c.Manifest, c.Package, c.SkipABI, verbose, errLog, out)
 	if err != nil {
 		return err
 	}
 
+	if c.DryRun {
+		p, err := c.plan(serviceID, source, pkgPath, hashSum)
+		if err != nil {
+			return err
+		}
+		if ok, err := c.WriteOutput(out, p); ok {
+			return err
+		}
+		printPlan(out, p)
+		return nil
+	}
+
 	// FREE TRIAL ACTIVATION
 
 	endpoint, _ := c.Globals.Endpoint()
@@ -120,7 +151,7 @@ func (c *DeployCommand) Exec(in io.Reader, out io.Writer) (err error) {
 
 	if source == manifest.SourceUndefined {
 		newService = true
-		serviceID, serviceVersion, err = manageNoServiceIDFlow(c.Globals.Flag, in, out, verbose, apiClient, c.Package, errLog, &c.Manifest.File, activateTrial)
+		serviceID, serviceVersion, err = manageNoServiceIDFlow(c.Globals.Flag, in, out, verbose, apiClient, c.Package, c.Env, errLog, &c.Manifest.File, activateTrial)
 		if err != nil {
 			return err
 		}
@@ -168,12 +199,22 @@ func (c *DeployCommand) Exec(in io.Reader, out io.Writer) (err error) {
 	}
 
 	var (
+		acls         *setup.ACLs
 		backends     *setup.Backends
 		dictionaries *setup.Dictionaries
+		healthchecks *setup.Healthchecks
 		loggers      *setup.Loggers
+		objectStores *setup.ObjectStores
 	)
 
-	if newService {
+	if newService || c.SyncSetup {
+		acls = &setup.ACLs{
+			APIClient:      apiClient,
+			ServiceID:      serviceID,
+			ServiceVersion: serviceVersion.Number,
+			Setup:          c.Manifest.File.Setup.ACLs,
+		}
+
 		backends = &setup.Backends{
 			APIClient:      apiClient,
 			AcceptDefaults: c.Globals.Flag.AcceptDefaults,
@@ -196,9 +237,40 @@ func (c *DeployCommand) Exec(in io.Reader, out io.Writer) (err error) {
 			Stdout:         out,
 		}
 
+		healthchecks = &setup.Healthchecks{
+			APIClient:      apiClient,
+			ServiceID:      serviceID,
+			ServiceVersion: serviceVersion.Number,
+			Setup:          c.Manifest.File.Setup.Healthchecks,
+		}
+
 		loggers = &setup.Loggers{
-			Setup:  c.Manifest.File.Setup.Loggers,
-			Stdout: out,
+			APIClient:      apiClient,
+			AcceptDefaults: c.Globals.Flag.AcceptDefaults,
+			NonInteractive: c.Globals.Flag.NonInteractive,
+			ServiceID:      serviceID,
+			ServiceVersion: serviceVersion.Number,
+			Setup:          c.Manifest.File.Setup.Loggers,
+			Stdin:          in,
+			Stdout:         out,
+		}
+
+		objectStores = &setup.ObjectStores{
+			APIClient: apiClient,
+			Setup:     c.Manifest.File.Setup.ObjectStores,
+		}
+	}
+
+	var (
+		deletions []syncDeletion
+		synced    bool
+	)
+
+	if !newService && c.SyncSetup {
+		deletions, synced, err = c.syncSetup(in, out, serviceVersion.Number, acls, backends, dictionaries, healthchecks, loggers, objectStores)
+		if err != nil {
+			errLogService(errLog, err, serviceID, serviceVersion.Number)
+			return fmt.Errorf("error syncing [setup] configuration: %w", err)
 		}
 	}
 
@@ -212,16 +284,18 @@ func (c *DeployCommand) Exec(in io.Reader, out io.Writer) (err error) {
 		}
 	}
 
-	if newService {
+	if newService || c.SyncSetup {
 		// NOTE: A service can't be activated without at least one backend defined.
 		// This explains why the following block of code isn't wrapped in a call to
-		// the .Predefined() method, as the call to .Configure() will ensure the
-		// user is prompted regardless of whether there is a [setup.backends]
-		// defined in the fastly.toml configuration.
-		err = backends.Configure()
-		if err != nil {
-			errLogService(errLog, err, serviceID, serviceVersion.Number)
-			return fmt.Errorf("error configuring service backends: %w", err)
+		// the .Predefined() method for a new service, as the call to .Configure()
+		// will ensure the user is prompted regardless of whether there is a
+		// [setup.backends] defined in the fastly.toml configuration.
+		if newService || backends.Predefined() {
+			err = backends.Configure()
+			if err != nil {
+				errLogService(errLog, err, serviceID, serviceVersion.Number)
+				return fmt.Errorf("error configuring service backends: %w", err)
+			}
 		}
 
 		if dictionaries.Predefined() {
@@ -233,13 +307,41 @@ func (c *DeployCommand) Exec(in io.Reader, out io.Writer) (err error) {
 		}
 
 		if loggers.Predefined() {
-			// NOTE: We don't handle errors from the Configure() method because we
-			// don't actually do anything other than display a message to the user
-			// informing them that they need to create a log endpoint and which
-			// provider type they should be. The reason we don't implement logic for
-			// creating logging objects is because the API input fields vary
-			// significantly between providers.
-			_ = loggers.Configure()
+			err = loggers.Configure()
+			if err != nil {
+				errLogService(errLog, err, serviceID, serviceVersion.Number)
+				return fsterr.RemediationError{
+					Inner:       fmt.Errorf("error configuring service log endpoints: %w", err),
+					Remediation: "Check the [setup.log_endpoints] configuration in the fastly.toml. Settings use the snake_case equivalent of the `fastly logging <provider> create` flags (e.g. --secret-key is secret_key).",
+				}
+			}
+		}
+
+		if acls.Predefined() {
+			err = acls.Configure()
+			if err != nil {
+				errLogService(errLog, err, serviceID, serviceVersion.Number)
+				return fmt.Errorf("error configuring service ACLs: %w", err)
+			}
+		}
+
+		if healthchecks.Predefined() {
+			err = healthchecks.Configure()
+			if err != nil {
+				errLogService(errLog, err, serviceID, serviceVersion.Number)
+				return fmt.Errorf("error configuring service healthchecks: %w", err)
+			}
+		}
+
+		if objectStores.Predefined() {
+			err = objectStores.Validate()
+			if err == nil {
+				err = objectStores.Configure()
+			}
+			if err != nil {
+				errLogService(errLog, err, serviceID, serviceVersion.Number)
+				return fmt.Errorf("error configuring object stores: %w", err)
+			}
 		}
 	}
 
@@ -276,12 +378,28 @@ func (c *DeployCommand) Exec(in io.Reader, out io.Writer) (err error) {
 		}
 	}
 
-	if newService {
-		// NOTE: We can't pass a text.Progress instance to setup.Backends or
-		// setup.Dictionaries at the point of constructing the setup objects,
-		// as the text.Progress instance prevents other stdout from being read.
+	if newService || c.SyncSetup {
+		// NOTE: We can't pass a text.Progress instance to the setup objects at
+		// the point of constructing them, as the text.Progress instance prevents
+		// other stdout from being read.
+		acls.Progress = progress
 		backends.Progress = progress
 		dictionaries.Progress = progress
+		healthchecks.Progress = progress
+		loggers.Progress = progress
+		objectStores.Progress = progress
+
+		// NOTE: Healthchecks are created first as backends may reference them.
+		if err := healthchecks.Create(); err != nil {
+			errLog.AddWithContext(err, map[string]any{
+				"Accept defaults": c.Globals.Flag.AcceptDefaults,
+				"Auto-yes":        c.Globals.Flag.AutoYes,
+				"Non-interactive": c.Globals.Flag.NonInteractive,
+				"Service ID":      serviceID,
+				"Service Version": serviceVersion.Number,
+			})
+			return err
+		}
 
 		if err := backends.Create(); err != nil {
 			errLog.AddWithContext(err, map[string]any{
@@ -304,6 +422,46 @@ func (c *DeployCommand) Exec(in io.Reader, out io.Writer) (err error) {
 			})
 			return err
 		}
+
+		if err := loggers.Create(); err != nil {
+			errLog.AddWithContext(err, map[string]any{
+				"Accept defaults": c.Globals.Flag.AcceptDefaults,
+				"Auto-yes":        c.Globals.Flag.AutoYes,
+				"Non-interactive": c.Globals.Flag.NonInteractive,
+				"Service ID":      serviceID,
+				"Service Version": serviceVersion.Number,
+			})
+			return err
+		}
+
+		if err := acls.Create(); err != nil {
+			errLog.AddWithContext(err, map[string]any{
+				"Accept defaults": c.Globals.Flag.AcceptDefaults,
+				"Auto-yes":        c.Globals.Flag.AutoYes,
+				"Non-interactive": c.Globals.Flag.NonInteractive,
+				"Service ID":      serviceID,
+				"Service Version": serviceVersion.Number,
+			})
+			return err
+		}
+
+		if err := objectStores.Create(); err != nil {
+			errLog.AddWithContext(err, map[string]any{
+				"Accept defaults": c.Globals.Flag.AcceptDefaults,
+				"Auto-yes":        c.Globals.Flag.AutoYes,
+				"Non-interactive": c.Globals.Flag.NonInteractive,
+				"Service ID":      serviceID,
+				"Service Version": serviceVersion.Number,
+			})
+			return err
+		}
+
+		for _, d := range deletions {
+			if err := d.resource.reconciler.Delete(d.names); err != nil {
+				errLogService(errLog, err, serviceID, serviceVersion.Number)
+				return err
+			}
+		}
 	}
 
 	// PACKAGE PROCESSING...
@@ -317,18 +475,25 @@ func (c *DeployCommand) Exec(in io.Reader, out io.Writer) (err error) {
 		})
 		return err
 	}
-	if !cont {
+	if !cont && !synced {
 		return nil
 	}
 
-	err = pkgUpload(progress, apiClient, serviceID, serviceVersion.Number, pkgPath)
-	if err != nil {
-		errLog.AddWithContext(err, map[string]any{
-			"Package path":    pkgPath,
-			"Service ID":      serviceID,
-			"Service Version": serviceVersion.Number,
-		})
-		return err
+	if cont {
+		err = pkgUpload(progress, apiClient, serviceID, serviceVersion.Number, pkgPath)
+		if err != nil {
+			errLog.AddWithContext(err, map[string]any{
+				"Package path":    pkgPath,
+				"Service ID":      serviceID,
+				"Service Version": serviceVersion.Number,
+			})
+			return err
+		}
+	} else {
+		// NOTE: The package is unchanged but the service version still needs to
+		// be activated for the synced [setup] resources to take effect. The
+		// progress was marked as done by pkgCompare and so it needs resetting.
+		progress = text.ResetProgress(out, verbose)
 	}
 
 	// SERVICE PROCESSING...
@@ -371,14 +536,34 @@ func (c *DeployCommand) Exec(in io.Reader, out io.Writer) (err error) {
 	return nil
 }
 
+// checkOutputFlags validates the output flags, which are only supported when
+// displaying the deployment plan.
+func checkOutputFlags(b *cmd.Base, dryRun bool) error {
+	if err := b.CheckOutputFlags(); err != nil {
+		return err
+	}
+	if b.StructuredOutput() && !dryRun {
+		return fsterr.RemediationError{
+			Inner:       fmt.Errorf("--json and --format require --dry-run"),
+			Remediation: "Add --dry-run to display the deployment plan as structured output.",
+		}
+	}
+	return nil
+}
+
 // validatePackage short-circuits the deploy command if the user hasn't first
 // built a package to be deployed.
 //
 // NOTE: It also validates if the package size exceeds limit:
 // https://docs.fastly.com/products/compute-at-edge-billing-and-resource-limits#resource-limits
+//
+// Unless skipABI is set, the Wasm binary is also checked against the
+// Compute@Edge host functions. A missing entry point fails before upload, while
+// unknown imports are only reported as warnings (see validateHostABI).
 func validatePackage(
 	data manifest.Data,
 	packageFlag string,
+	skipABI bool,
 	verbose bool,
 	errLog fsterr.LogInterface,
 	out io.Writer,
@@ -427,15 +612,7 @@ func validatePackage(
 		"fastly.toml": {},
 		"main.wasm":   {},
 	}
-	if err := validate(pkgPath, func(f archiver.File) error {
-		switch fname := f.Name(); fname {
-		case "fastly.toml", "main.wasm":
-			if _, err := io.Copy(contents[fname], f); err != nil {
-				return fmt.Errorf("error reading %s: %w", fname, err)
-			}
-		}
-		return nil
-	}); err != nil {
+	if err := validate(pkgPath, collectFiles(contents)); err != nil {
 		errLog.AddWithContext(err, map[string]any{
 			"Package path": pkgPath,
 			"Package size": pkgSize,
@@ -443,6 +620,15 @@ func validatePackage(
 		return pkgPath, hashSum, err
 	}
 
+	if !skipABI {
+		if err := validateHostABI(contents["fastly.toml"].Bytes(), contents["main.wasm"].Bytes(), false, verbose, out); err != nil {
+			errLog.AddWithContext(err, map[string]any{
+				"Package path": pkgPath,
+			})
+			return pkgPath, hashSum, err
+		}
+	}
+
 	hashSum, err = getHashSum(contents)
 	if err != nil {
 		return pkgPath, hashSum, err
@@ -577,6 +763,7 @@ func manageNoServiceIDFlow(
 	verbose bool,
 	apiClient api.Interface,
 	packageFlag string,
+	env string,
 	errLog fsterr.LogInterface,
 	manifestFile *manifest.File,
 	activateTrial activator,
@@ -631,8 +818,16 @@ func manageNoServiceIDFlow(
 	// the --package flag, as this suggests they are not inside a project
 	// directory and subsequently we're reading the manifest content from within
 	// a given .tar.gz package archive file.
+	//
+	// When an environment is set, the Service ID is persisted to the environment
+	// manifest (e.g. fastly.stage.toml) so the base fastly.toml is unaffected.
 	if packageFlag == "" {
-		err = updateManifestServiceID(manifestFile, manifest.Filename, serviceID)
+		if env != "" {
+			err = manifest.WriteServiceID(manifest.EnvironmentFilename(env), serviceID)
+			manifestFile.ServiceID = serviceID
+		} else {
+			err = updateManifestServiceID(manifestFile, manifest.Filename, serviceID)
+		}
 		if err != nil {
 			errLog.AddWithContext(err, map[string]any{
 				"Service ID": serviceID,
@@ -719,6 +914,44 @@ func manageExistingServiceFlow(
 	verbose bool,
 	out io.Writer,
 	errLog fsterr.LogInterface,
+) (serviceVersion *fastly.Version, err error) {
+	serviceVersion, err = resolveServiceVersion(serviceID, serviceVersionFlag, apiClient, errLog)
+	if err != nil {
+		return serviceVersion, err
+	}
+
+	// Unlike other CLI commands that are a direct mapping to an API endpoint,
+	// the compute deploy command is a composite of behaviours, and so as we
+	// already automatically activate a version we should autoclone without
+	// requiring the user to explicitly provide an --autoclone flag.
+	if serviceVersion.Active || serviceVersion.Locked {
+		clonedVersion, err := apiClient.CloneVersion(&fastly.CloneVersionInput{
+			ServiceID:      serviceID,
+			ServiceVersion: serviceVersion.Number,
+		})
+		if err != nil {
+			errLogService(errLog, err, serviceID, serviceVersion.Number)
+			return serviceVersion, fmt.Errorf("error cloning service version: %w", err)
+		}
+		if verbose {
+			msg := fmt.Sprintf("Service version %d is not editable, so it was automatically cloned. Now operating on version %d.", serviceVersion.Number, clonedVersion.Number)
+			text.Break(out)
+			text.Output(out, msg)
+			text.Break(out)
+		}
+		serviceVersion = clonedVersion
+	}
+
+	return serviceVersion, nil
+}
+
+// resolveServiceVersion parses the --version flag for an existing service and
+// validates that the service is a Compute@Edge service.
+func resolveServiceVersion(
+	serviceID string,
+	serviceVersionFlag cmd.OptionalServiceVersion,
+	apiClient api.Interface,
+	errLog fsterr.LogInterface,
 ) (serviceVersion *fastly.Version, err error) {
 	serviceVersion, err = serviceVersionFlag.Parse(serviceID, apiClient)
 	if err != nil {
@@ -750,28 +983,6 @@ func manageExistingServiceFlow(
 		}
 	}
 
-	// Unlike other CLI commands that are a direct mapping to an API endpoint,
-	// the compute deploy command is a composite of behaviours, and so as we
-	// already automatically activate a version we should autoclone without
-	// requiring the user to explicitly provide an --autoclone flag.
-	if serviceVersion.Active || serviceVersion.Locked {
-		clonedVersion, err := apiClient.CloneVersion(&fastly.CloneVersionInput{
-			ServiceID:      serviceID,
-			ServiceVersion: serviceVersion.Number,
-		})
-		if err != nil {
-			errLogService(errLog, err, serviceID, serviceVersion.Number)
-			return serviceVersion, fmt.Errorf("error cloning service version: %w", err)
-		}
-		if verbose {
-			msg := fmt.Sprintf("Service version %d is not editable, so it was automatically cloned. Now operating on version %d.", serviceVersion.Number, clonedVersion.Number)
-			text.Break(out)
-			text.Output(out, msg)
-			text.Break(out)
-		}
-		serviceVersion = clonedVersion
-	}
-
 	return serviceVersion, nil
 }
 
@@ -797,20 +1008,22 @@ func checkServiceID(serviceID string, client api.Interface) error {
 // pkgCompare compares the local package hashsum against the existing service
 // package version and exits early with message if identical.
 func pkgCompare(client api.Interface, serviceID string, version int, hashSum string, progress text.Progress, out io.Writer) (bool, error) {
+	if pkgIdentical(client, serviceID, version, hashSum) {
+		progress.Done()
+		text.Info(out, "Skipping package deployment, local and service version are identical. (service %v, version %v) ", serviceID, version)
+		return false, nil
+	}
+	return true, nil
+}
+
+// pkgIdentical reports whether the package uploaded to the service version has
+// the same hashsum as the local package.
+func pkgIdentical(client api.Interface, serviceID string, version int, hashSum string) bool {
 	p, err := client.GetPackage(&fastly.GetPackageInput{
 		ServiceID:      serviceID,
 		ServiceVersion: version,
 	})
-
-	if err == nil {
-		if hashSum == p.Metadata.HashSum {
-			progress.Done()
-			text.Info(out, "Skipping package deployment, local and service version are identical. (service %v, version %v) ", serviceID, version)
-			return false, nil
-		}
-	}
-
-	return true, nil
+	return err == nil && hashSum == p.Metadata.HashSum
 }
 
 // getHashSum creates a SHA 512 hash from the given file contents in a specific order.
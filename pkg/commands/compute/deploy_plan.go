@@ -0,0 +1,271 @@
+package compute
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/commands/compute/setup"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// generatedDomain is displayed in place of the domain name that would be
+// randomly generated for a service when no --domain flag is provided.
+const generatedDomain = "<generated>.edgecompute.app"
+
+// deployPlan describes the changes a deploy would make to a service.
+type deployPlan struct {
+	ServiceID      string        `json:"service_id,omitempty"`
+	ServiceName    string        `json:"service_name,omitempty"`
+	CreateService  bool          `json:"create_service"`
+	ServiceVersion int           `json:"service_version"`
+	CloneVersion   bool          `json:"clone_version"`
+	Domains        []string      `json:"domains"`
+	Backends       []planBackend `json:"backends"`
+	Dictionaries   []string      `json:"dictionaries"`
+	LogEndpoints   []planLogger  `json:"log_endpoints"`
+	ACLs           []string      `json:"acls"`
+	Healthchecks   []string      `json:"healthchecks"`
+	ObjectStores   []string      `json:"object_stores"`
+	Drift          []string      `json:"drift,omitempty"`
+	Unmanaged      []string      `json:"unmanaged,omitempty"`
+	Package        planPackage   `json:"package"`
+	Activate       bool          `json:"activate"`
+}
+
+// planBackend describes a backend that would be created.
+type planBackend struct {
+	Name    string `json:"name"`
+	Address string `json:"address"`
+	Port    uint   `json:"port"`
+}
+
+// planLogger describes a log endpoint that would be created. Log endpoints for
+// providers that can't be created from a [setup] configuration have Create
+// set to false and must be created manually.
+type planLogger struct {
+	Name     string `json:"name"`
+	Provider string `json:"provider,omitempty"`
+	Create   bool   `json:"create"`
+}
+
+// planPackage describes the package that would be uploaded.
+type planPackage struct {
+	Path    string `json:"path"`
+	HashSum string `json:"hashsum"`
+	Upload  bool   `json:"upload"`
+}
+
+// plan works out the changes a deploy would make without calling any API
+// endpoint that modifies the service.
+func (c *DeployCommand) plan(serviceID string, source manifest.Source, pkgPath, hashSum string) (*deployPlan, error) {
+	apiClient := c.Globals.APIClient
+	errLog := c.Globals.ErrLog
+
+	p := &deployPlan{
+		Domains:      []string{},
+		Backends:     []planBackend{},
+		Dictionaries: []string{},
+		LogEndpoints: []planLogger{},
+		ACLs:         []string{},
+		Healthchecks: []string{},
+		ObjectStores: []string{},
+		Package: planPackage{
+			Path:    pkgPath,
+			HashSum: hashSum,
+			Upload:  true,
+		},
+	}
+
+	domain := c.Domain
+	if domain == "" {
+		domain = generatedDomain
+	}
+
+	if source == manifest.SourceUndefined {
+		p.CreateService = true
+		p.ServiceName = c.Manifest.File.Name
+		p.ServiceVersion = 1
+		p.Domains = append(p.Domains, domain)
+
+		if err := c.planSetup(p, "", 0); err != nil {
+			return nil, err
+		}
+		// NOTE: A service can't be activated without at least one backend, and so
+		// deploy creates an 'originless' backend when none are predefined.
+		if len(c.Manifest.File.Setup.Backends) == 0 {
+			p.Backends = append(p.Backends, planBackend{Name: "originless", Address: "127.0.0.1", Port: 80})
+		}
+
+		p.Activate = true
+		return p, nil
+	}
+
+	serviceVersion, err := resolveServiceVersion(serviceID, c.ServiceVersion, apiClient, errLog)
+	if err != nil {
+		return nil, err
+	}
+	p.ServiceID = serviceID
+	p.ServiceVersion = serviceVersion.Number
+	p.CloneVersion = serviceVersion.Active || serviceVersion.Locked
+
+	domains := &setup.Domains{
+		APIClient:      apiClient,
+		ServiceID:      serviceID,
+		ServiceVersion: serviceVersion.Number,
+	}
+	if err := domains.Validate(); err != nil {
+		errLogService(errLog, err, serviceID, serviceVersion.Number)
+		return nil, fmt.Errorf("error configuring service domains: %w", err)
+	}
+	if domains.Missing() {
+		p.Domains = append(p.Domains, domain)
+	}
+
+	var synced bool
+	if c.SyncSetup {
+		if err := c.planSetup(p, serviceID, serviceVersion.Number); err != nil {
+			errLogService(errLog, err, serviceID, serviceVersion.Number)
+			return nil, err
+		}
+		synced = len(p.Backends) > 0 || len(p.Dictionaries) > 0 || len(p.ACLs) > 0 ||
+			len(p.Healthchecks) > 0 || len(p.ObjectStores) > 0
+		for _, lg := range p.LogEndpoints {
+			synced = synced || lg.Create
+		}
+	}
+
+	if pkgIdentical(apiClient, serviceID, serviceVersion.Number, hashSum) {
+		p.Package.Upload = false
+	}
+	p.Activate = p.Package.Upload || synced
+
+	return p, nil
+}
+
+// planSetup adds the [setup] resources missing from the service version to the
+// plan. When no serviceID is given every resource is considered missing.
+//
+// NOTE: For an existing service it also adds the differences that
+// --sync-setup reports but doesn't resolve, and the resources that would only
+// be deleted if confirmed at a prompt.
+func (c *DeployCommand) planSetup(p *deployPlan, serviceID string, serviceVersion int) error {
+	apiClient := c.Globals.APIClient
+	errLog := c.Globals.ErrLog
+
+	backends := &setup.Backends{APIClient: apiClient, ServiceID: serviceID, ServiceVersion: serviceVersion, Setup: c.Manifest.File.Setup.Backends}
+	dictionaries := &setup.Dictionaries{APIClient: apiClient, ServiceID: serviceID, ServiceVersion: serviceVersion, Setup: c.Manifest.File.Setup.Dictionaries}
+	acls := &setup.ACLs{APIClient: apiClient, ServiceID: serviceID, ServiceVersion: serviceVersion, Setup: c.Manifest.File.Setup.ACLs}
+	healthchecks := &setup.Healthchecks{APIClient: apiClient, ServiceID: serviceID, ServiceVersion: serviceVersion, Setup: c.Manifest.File.Setup.Healthchecks}
+
+	resources := []syncResource{
+		{kind: "backend", reconciler: backends},
+		{kind: "dictionary", reconciler: dictionaries},
+		{kind: "ACL", reconciler: acls},
+		{kind: "healthcheck", reconciler: healthchecks},
+	}
+	for _, r := range resources {
+		if err := r.reconciler.Validate(); err != nil {
+			return err
+		}
+		p.Drift = append(p.Drift, r.reconciler.Drift()...)
+		for _, name := range r.reconciler.Unmanaged() {
+			p.Unmanaged = append(p.Unmanaged, fmt.Sprintf("%s '%s'", r.kind, name))
+		}
+	}
+
+	for _, name := range backends.MissingNames() {
+		settings := c.Manifest.File.Setup.Backends[name]
+		bk := planBackend{Name: name, Address: "127.0.0.1", Port: setup.DefaultBackendPort(settings)}
+		if settings.Address != "" {
+			bk.Address = settings.Address
+		}
+		p.Backends = append(p.Backends, bk)
+	}
+	p.Dictionaries = append(p.Dictionaries, dictionaries.MissingNames()...)
+	p.ACLs = append(p.ACLs, acls.MissingNames()...)
+	p.Healthchecks = append(p.Healthchecks, healthchecks.MissingNames()...)
+
+	loggers := &setup.Loggers{APIClient: apiClient, ServiceID: serviceID, ServiceVersion: serviceVersion, Setup: c.Manifest.File.Setup.Loggers}
+	if err := loggers.Validate(); err != nil {
+		return err
+	}
+	for _, name := range loggers.MissingNames() {
+		provider := c.Manifest.File.Setup.Loggers[name].Provider
+		p.LogEndpoints = append(p.LogEndpoints, planLogger{
+			Name:     name,
+			Provider: provider,
+			Create:   setup.SupportedLogger(provider),
+		})
+	}
+
+	objectStores := &setup.ObjectStores{APIClient: apiClient, Setup: c.Manifest.File.Setup.ObjectStores}
+	if err := objectStores.Validate(); err != nil {
+		errLog.Add(err)
+		return err
+	}
+	p.ObjectStores = append(p.ObjectStores, objectStores.MissingNames()...)
+
+	return nil
+}
+
+// printPlan displays the deployment plan.
+func printPlan(out io.Writer, p *deployPlan) {
+	text.Output(out, text.Bold("Deployment plan (no changes have been made)"))
+	text.Break(out)
+
+	if p.CreateService {
+		text.Output(out, "%s create service '%s'", text.Bold("Service:"), p.ServiceName)
+	} else {
+		text.Output(out, "%s %s", text.Bold("Service:"), p.ServiceID)
+	}
+	if p.CloneVersion {
+		text.Output(out, "%s clone version %d (it is not editable)", text.Bold("Version:"), p.ServiceVersion)
+	} else {
+		text.Output(out, "%s %d", text.Bold("Version:"), p.ServiceVersion)
+	}
+
+	for _, name := range p.Domains {
+		text.Output(out, "%s create domain '%s'", text.Bold("Domain:"), name)
+	}
+	for _, bk := range p.Backends {
+		text.Output(out, "%s create backend '%s' (host: %s, port: %d)", text.Bold("Backend:"), bk.Name, bk.Address, bk.Port)
+	}
+	for _, name := range p.Dictionaries {
+		text.Output(out, "%s create dictionary '%s'", text.Bold("Dictionary:"), name)
+	}
+	for _, name := range p.Healthchecks {
+		text.Output(out, "%s create healthcheck '%s'", text.Bold("Healthcheck:"), name)
+	}
+	for _, name := range p.ACLs {
+		text.Output(out, "%s create ACL '%s'", text.Bold("ACL:"), name)
+	}
+	for _, name := range p.ObjectStores {
+		text.Output(out, "%s create object store '%s'", text.Bold("Object store:"), name)
+	}
+	for _, lg := range p.LogEndpoints {
+		if lg.Create {
+			text.Output(out, "%s create log endpoint '%s' (provider: %s)", text.Bold("Log endpoint:"), lg.Name, lg.Provider)
+		} else {
+			text.Output(out, "%s '%s' must be created manually", text.Bold("Log endpoint:"), lg.Name)
+		}
+	}
+
+	for _, d := range p.Drift {
+		text.Output(out, "%s %s (not updated)", text.Bold("Drift:"), d)
+	}
+	for _, u := range p.Unmanaged {
+		text.Output(out, "%s %s is not defined in [setup] (only deleted if confirmed)", text.Bold("Unmanaged:"), u)
+	}
+
+	if p.Package.Upload {
+		text.Output(out, "%s upload %s", text.Bold("Package:"), p.Package.Path)
+	} else {
+		text.Output(out, "%s skip upload, local and service version are identical", text.Bold("Package:"))
+	}
+	if p.Activate {
+		text.Output(out, "%s activate version", text.Bold("Activate:"))
+	} else {
+		text.Output(out, "%s no", text.Bold("Activate:"))
+	}
+}
@@ -0,0 +1,158 @@
+package compute
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/commands/compute/setup"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// syncResource pairs a reconcilable [setup] resource with the label used to
+// describe it to the user.
+type syncResource struct {
+	kind       string
+	reconciler setup.Reconciler
+}
+
+// syncDeletion describes the service resources the user confirmed should be
+// deleted because they're no longer defined in the [setup] configuration.
+type syncDeletion struct {
+	resource syncResource
+	names    []string
+}
+
+// syncSetup validates the [setup] resources against an existing service
+// version, reporting any drift and any service resources that are no longer
+// defined in the fastly.toml. Each setup object is then restricted to its
+// missing resources, so that only those are configured and created.
+//
+// NOTE: Drift is only reported and never applied. Service resources that
+// aren't defined in the fastly.toml are only deleted when the user confirms
+// each deletion at a prompt, and so they're never deleted when using either
+// the --auto-yes or --non-interactive flag.
+//
+// The returned bool indicates if the sync will make any changes to the service
+// version (i.e. resources will be created or deleted).
+func (c *DeployCommand) syncSetup(
+	in io.Reader,
+	out io.Writer,
+	serviceVersion int,
+	acls *setup.ACLs,
+	backends *setup.Backends,
+	dictionaries *setup.Dictionaries,
+	healthchecks *setup.Healthchecks,
+	loggers *setup.Loggers,
+	objectStores *setup.ObjectStores,
+) (deletions []syncDeletion, changed bool, err error) {
+	// NOTE: The order matters as it's the order in which resources are deleted
+	// (e.g. a backend may reference a healthcheck).
+	resources := []syncResource{
+		{kind: "backend", reconciler: backends},
+		{kind: "dictionary", reconciler: dictionaries},
+		{kind: "ACL", reconciler: acls},
+		{kind: "healthcheck", reconciler: healthchecks},
+	}
+
+	for _, r := range resources {
+		if err := r.reconciler.Validate(); err != nil {
+			return nil, false, err
+		}
+	}
+	if err := loggers.Validate(); err != nil {
+		return nil, false, err
+	}
+	if err := objectStores.Validate(); err != nil {
+		return nil, false, err
+	}
+
+	acls.Setup = subset(acls.Setup, acls.MissingNames())
+	backends.Setup = subset(backends.Setup, backends.MissingNames())
+	dictionaries.Setup = subset(dictionaries.Setup, dictionaries.MissingNames())
+	healthchecks.Setup = subset(healthchecks.Setup, healthchecks.MissingNames())
+	loggers.Setup = subset(loggers.Setup, loggers.MissingNames())
+
+	changed = acls.Missing() || backends.Missing() || dictionaries.Missing() ||
+		healthchecks.Missing() || objectStores.Missing()
+
+	// NOTE: Log endpoints for unsupported providers are always reported as
+	// missing, but they can't be created and so don't change the service.
+	for _, settings := range loggers.Setup {
+		if setup.SupportedLogger(settings.Provider) {
+			changed = true
+		}
+	}
+
+	reportSetupSync(out, serviceVersion, resources)
+
+	if c.Globals.Flag.AutoYes || c.Globals.Flag.NonInteractive {
+		return nil, changed, nil
+	}
+
+	for _, r := range resources {
+		var names []string
+		for _, name := range r.reconciler.Unmanaged() {
+			label := text.BoldYellow(fmt.Sprintf("Delete %s '%s' from service version %d? [y/N] ", r.kind, name, serviceVersion))
+			answer, err := text.AskYesNo(out, label, in)
+			if err != nil {
+				return nil, false, err
+			}
+			if answer {
+				names = append(names, name)
+			}
+		}
+		if len(names) > 0 {
+			deletions = append(deletions, syncDeletion{resource: r, names: names})
+			changed = true
+		}
+	}
+
+	return deletions, changed, nil
+}
+
+// reportSetupSync displays the differences between the service version and
+// the [setup] configuration that --sync-setup doesn't resolve automatically.
+func reportSetupSync(out io.Writer, serviceVersion int, resources []syncResource) {
+	var drift, renamed, unmanaged []string
+	for _, r := range resources {
+		drift = append(drift, r.reconciler.Drift()...)
+
+		missing, names := r.reconciler.MissingNames(), r.reconciler.Unmanaged()
+		if len(missing) == 1 && len(names) == 1 {
+			renamed = append(renamed, fmt.Sprintf("%s '%s' may have been renamed to '%s'", r.kind, names[0], missing[0]))
+		}
+		for _, name := range names {
+			unmanaged = append(unmanaged, fmt.Sprintf("%s '%s'", r.kind, name))
+		}
+	}
+
+	if len(drift) > 0 {
+		text.Warning(out, "The following settings in service version %d differ from the [setup] configuration. They are not updated by --sync-setup:", serviceVersion)
+		for _, d := range drift {
+			text.Indent(out, 4, "%s", d)
+		}
+	}
+	if len(renamed) > 0 {
+		text.Info(out, "The following resources appear to have been renamed in the [setup] configuration:")
+		for _, r := range renamed {
+			text.Indent(out, 4, "%s", r)
+		}
+	}
+	if len(unmanaged) > 0 {
+		text.Info(out, "The following resources in service version %d are not defined in the [setup] configuration. They are only deleted when confirmed at a prompt:", serviceVersion)
+		for _, u := range unmanaged {
+			text.Indent(out, 4, "%s", u)
+		}
+	}
+}
+
+// subset returns the entries of m whose keys are in names.
+func subset[T any](m map[string]T, names []string) map[string]T {
+	s := make(map[string]T, len(names))
+	for _, name := range names {
+		if v, ok := m[name]; ok {
+			s[name] = v
+		}
+	}
+	return s
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/fastly/cli/pkg/mock"
 	"github.com/fastly/cli/pkg/testutil"
 	"github.com/fastly/go-fastly/v6/fastly"
+	"github.com/google/go-cmp/cmp"
 )
 
 // NOTE: Some tests don't provide a Service ID via any mechanism (e.g. flag
@@ -83,6 +84,7 @@ func TestDeploy(t *testing.T) {
 		api                  mock.API
 		args                 []string
 		dontWantOutput       []string
+		envManifest          string
 		httpClientRes        *http.Response
 		httpClientErr        error
 		manifest             string
@@ -1266,6 +1268,456 @@ func TestDeploy(t *testing.T) {
 				"SUCCESS: Deployed package (service 12345, version 1)",
 			},
 		},
+		{
+			name: "success with setup.log_endpoints s3 configuration and no existing service",
+			args: args("compute deploy --token 123"),
+			api: mock.API{
+				ActivateVersionFn:   activateVersionOk,
+				CreateBackendFn:     createBackendOK,
+				CreateDomainFn:      createDomainOK,
+				CreateS3Fn:          createS3OK,
+				CreateServiceFn:     createServiceOK,
+				GetPackageFn:        getPackageOk,
+				GetServiceFn:        getServiceOK,
+				GetServiceDetailsFn: getServiceDetailsWasm,
+				ListDomainsFn:       listDomainsOk,
+				ListVersionsFn:      testutil.ListVersions,
+				UpdatePackageFn:     updatePackageOk,
+			},
+			manifest: `
+			name = "package"
+			manifest_version = 2
+			language = "rust"
+
+			[setup.log_endpoints.my_s3]
+			provider = "s3"
+			description = "Request logs"
+			bucket_name = "my-bucket"
+			access_key = "my-access-key"
+			`,
+			stdin: []string{
+				"Y",      // when prompted to create a new service
+				"",       // when prompted for service name
+				"",       // this stops prompting for backends
+				"secret", // when prompted for the s3 secret key
+			},
+			wantOutput: []string{
+				"Configuring log endpoint 'my_s3' (provider: s3)",
+				"Request logs",
+				"Secret key: ",
+				"Creating log endpoint 'my_s3' (provider: s3)...",
+				"Uploading package...",
+				"Activating version...",
+				"SUCCESS: Deployed package (service 12345, version 1)",
+			},
+			dontWantOutput: []string{
+				"The package code requires the following log endpoints to be created.",
+			},
+		},
+		{
+			name: "error with setup.log_endpoints datadog configuration missing a secret and --non-interactive",
+			args: args("compute deploy --non-interactive --token 123"),
+			api: mock.API{
+				CreateDomainFn:      createDomainOK,
+				CreateServiceFn:     createServiceOK,
+				DeleteServiceFn:     deleteServiceOK,
+				GetPackageFn:        getPackageOk,
+				GetServiceFn:        getServiceOK,
+				GetServiceDetailsFn: getServiceDetailsWasm,
+				ListDomainsFn:       listDomainsOk,
+				ListVersionsFn:      testutil.ListVersions,
+			},
+			manifest: `
+			name = "package"
+			manifest_version = 2
+			language = "rust"
+
+			[setup.log_endpoints.my_datadog]
+			provider = "datadog"
+			region = "EU"
+			`,
+			wantError:            "error configuring service log endpoints: error configuring log endpoint 'my_datadog': required setting 'token' not provided",
+			wantRemediationError: "Check the [setup.log_endpoints] configuration in the fastly.toml.",
+		},
+		{
+			name: "success with setup.acls, setup.healthchecks and setup.object_stores configuration and no existing service",
+			args: args("compute deploy --non-interactive --token 123"),
+			api: mock.API{
+				ActivateVersionFn:   activateVersionOk,
+				CreateACLFn:         createACLOK,
+				CreateACLEntryFn:    createACLEntryOK,
+				CreateBackendFn:     createBackendOK,
+				CreateDomainFn:      createDomainOK,
+				CreateHealthCheckFn: createHealthCheckOK,
+				CreateObjectStoreFn: createObjectStoreOK,
+				CreateServiceFn:     createServiceOK,
+				GetPackageFn:        getPackageOk,
+				GetServiceFn:        getServiceOK,
+				GetServiceDetailsFn: getServiceDetailsWasm,
+				ListDomainsFn:       listDomainsOk,
+				ListObjectStoresFn:  listObjectStoresOK,
+				ListVersionsFn:      testutil.ListVersions,
+				UpdatePackageFn:     updatePackageOk,
+			},
+			manifest: `
+			name = "package"
+			manifest_version = 2
+			language = "rust"
+
+			[setup.acls.blocklist]
+			[[setup.acls.blocklist.entries]]
+			ip = "192.0.2.0"
+			subnet = 24
+
+			[setup.healthchecks.origin_check]
+			host = "example.com"
+			path = "/status"
+
+			[setup.object_stores.existing_store]
+			[setup.object_stores.new_store]
+			`,
+			wantOutput: []string{
+				"Creating healthcheck 'origin_check'...",
+				"Creating ACL 'blocklist'...",
+				"Creating ACL entry '192.0.2.0'...",
+				"Creating object store 'new_store'...",
+				"SUCCESS: Deployed package (service 12345, version 1)",
+			},
+			dontWantOutput: []string{
+				"Creating object store 'existing_store'...",
+			},
+		},
+		{
+			name: "error with setup.acls entry missing an ip",
+			args: args("compute deploy --non-interactive --token 123"),
+			api: mock.API{
+				CreateDomainFn:      createDomainOK,
+				CreateServiceFn:     createServiceOK,
+				DeleteServiceFn:     deleteServiceOK,
+				GetPackageFn:        getPackageOk,
+				GetServiceFn:        getServiceOK,
+				GetServiceDetailsFn: getServiceDetailsWasm,
+				ListDomainsFn:       listDomainsOk,
+				ListVersionsFn:      testutil.ListVersions,
+			},
+			manifest: `
+			name = "package"
+			manifest_version = 2
+			language = "rust"
+
+			[setup.acls.blocklist]
+			[[setup.acls.blocklist.entries]]
+			comment = "no ip"
+			`,
+			wantError: "error configuring service ACLs: error configuring ACL 'blocklist': entry 1 has no 'ip' defined",
+		},
+		{
+			name: "success with setup.backends TLS, timeout and shielding configuration and no existing service",
+			args: args("compute deploy --non-interactive --token 123"),
+			api: mock.API{
+				ActivateVersionFn:   activateVersionOk,
+				CreateBackendFn:     createBackendWithSettings,
+				CreateDomainFn:      createDomainOK,
+				CreateServiceFn:     createServiceOK,
+				GetPackageFn:        getPackageOk,
+				GetServiceFn:        getServiceOK,
+				GetServiceDetailsFn: getServiceDetailsWasm,
+				ListDomainsFn:       listDomainsOk,
+				ListVersionsFn:      testutil.ListVersions,
+				UpdatePackageFn:     updatePackageOk,
+			},
+			manifest: `
+			name = "package"
+			manifest_version = 2
+			language = "rust"
+
+			[setup.backends.origin]
+			address = "example.com"
+			use_ssl = true
+			ssl_sni_hostname = "sni.example.com"
+			connect_timeout = 1500
+			first_byte_timeout = 20000
+			shield = "london-uk"
+			healthcheck = "origin_check"
+			`,
+			wantOutput: []string{
+				"Creating backend 'origin' (host: example.com, port: 443)...",
+				"SUCCESS: Deployed package (service 12345, version 1)",
+			},
+		},
+		{
+			name: "success with --sync-setup for existing service",
+			args: args("compute deploy --service-id 123 --token 123 --sync-setup --non-interactive"),
+			api: mock.API{
+				ActivateVersionFn:   activateVersionOk,
+				CloneVersionFn:      testutil.CloneVersionResult(4),
+				CreateBackendFn:     createBackendOK,
+				GetPackageFn:        getPackageOk,
+				GetServiceFn:        getServiceOK,
+				GetServiceDetailsFn: getServiceDetailsWasm,
+				ListBackendsFn:      listSyncBackends,
+				ListDomainsFn:       listDomainsOk,
+				ListVersionsFn:      testutil.ListVersions,
+				UpdatePackageFn:     updatePackageOk,
+			},
+			manifest: `
+			name = "package"
+			manifest_version = 2
+			language = "rust"
+
+			[setup.backends.origin]
+			address = "example.com"
+
+			[setup.backends.new_origin]
+			address = "new.example.com"
+			port = 443
+			`,
+			wantOutput: []string{
+				"backend 'origin': address is 'old.example.com' on the service but 'example.com'",
+				"backend 'legacy' may have been renamed to 'new_origin'",
+				"backend 'legacy'\n",
+				"Creating backend 'new_origin' (host: new.example.com, port: 443)...",
+				"Deployed package (service 123, version 4)",
+			},
+			dontWantOutput: []string{
+				"Creating backend 'origin'",
+				"Deleting backend 'legacy'",
+				// use_ssl isn't set in the fastly.toml and so isn't compared.
+				"use_ssl",
+			},
+		},
+		{
+			name: "success with --sync-setup reporting use_ssl set in the fastly.toml",
+			args: args("compute deploy --service-id 123 --token 123 --sync-setup --non-interactive"),
+			api: mock.API{
+				ActivateVersionFn:   activateVersionOk,
+				CloneVersionFn:      testutil.CloneVersionResult(4),
+				GetPackageFn:        getPackageOk,
+				GetServiceFn:        getServiceOK,
+				GetServiceDetailsFn: getServiceDetailsWasm,
+				ListBackendsFn:      listSyncBackends,
+				ListDomainsFn:       listDomainsOk,
+				ListVersionsFn:      testutil.ListVersions,
+				UpdatePackageFn:     updatePackageOk,
+			},
+			manifest: `
+			name = "package"
+			manifest_version = 2
+			language = "rust"
+
+			[setup.backends.origin]
+			address = "old.example.com"
+			use_ssl = false
+			`,
+			wantOutput: []string{
+				"backend 'origin': use_ssl is 'true' on the service but 'false' in the fastly.toml",
+				"Deployed package (service 123, version 4)",
+			},
+		},
+		{
+			name: "success with --sync-setup deleting a confirmed resource",
+			args: args("compute deploy --service-id 123 --token 123 --sync-setup"),
+			api: mock.API{
+				ActivateVersionFn:   activateVersionOk,
+				CloneVersionFn:      testutil.CloneVersionResult(4),
+				DeleteBackendFn:     deleteBackendOK,
+				GetPackageFn:        getPackageOk,
+				GetServiceFn:        getServiceOK,
+				GetServiceDetailsFn: getServiceDetailsWasm,
+				ListBackendsFn:      listSyncBackends,
+				ListDomainsFn:       listDomainsOk,
+				ListVersionsFn:      testutil.ListVersions,
+				UpdatePackageFn:     updatePackageOk,
+			},
+			manifest: `
+			name = "package"
+			manifest_version = 2
+			language = "rust"
+
+			[setup.backends.origin]
+			address = "old.example.com"
+			`,
+			stdin: []string{
+				"y", // when prompted to delete the 'legacy' backend
+			},
+			wantOutput: []string{
+				"Delete backend 'legacy' from service version 4? [y/N]",
+				"Deleting backend 'legacy'...",
+				"Deployed package (service 123, version 4)",
+			},
+			dontWantOutput: []string{
+				"differ from the [setup] configuration",
+				"may have been renamed",
+			},
+		},
+		{
+			name: "dry run with --sync-setup for existing service",
+			args: args("compute deploy --service-id 123 --token 123 --dry-run --sync-setup"),
+			api: mock.API{
+				GetPackageFn:        getPackageOk,
+				GetServiceDetailsFn: getServiceDetailsWasm,
+				ListBackendsFn:      listSyncBackends,
+				ListDomainsFn:       listDomainsOk,
+				ListVersionsFn:      testutil.ListVersions,
+			},
+			manifest: `
+			name = "package"
+			manifest_version = 2
+			language = "rust"
+
+			[setup.backends.origin]
+			address = "example.com"
+
+			[setup.backends.new_origin]
+			address = "new.example.com"
+			`,
+			wantOutput: []string{
+				"Backend: create backend 'new_origin' (host: new.example.com, port: 80)",
+				"Drift: backend 'origin': address is 'old.example.com' on the service but 'example.com'",
+				"Unmanaged: backend 'legacy' is not defined in [setup] (only deleted if confirmed)",
+				"Activate: activate version",
+			},
+		},
+		// The following tests validate that --dry-run doesn't call any API
+		// endpoints that modify the service (the mock.API would panic as the
+		// relevant functions aren't defined).
+		{
+			name:      "dry run --json without --dry-run",
+			args:      args("compute deploy --service-id 123 --token 123 --json"),
+			wantError: "--json and --format require --dry-run",
+		},
+		{
+			name: "dry run with existing service",
+			args: args("compute deploy --service-id 123 --token 123 --dry-run"),
+			api: mock.API{
+				GetPackageFn:        getPackageOk,
+				GetServiceDetailsFn: getServiceDetailsWasm,
+				ListDomainsFn:       listDomainsOk,
+				ListVersionsFn:      testutil.ListVersions,
+			},
+			wantOutput: []string{
+				"Deployment plan (no changes have been made)",
+				"Service: 123",
+				"Version: clone version 1 (it is not editable)",
+				"Package: upload pkg/package.tar.gz",
+				"Activate: activate version",
+			},
+			dontWantOutput: []string{
+				"Domain:",
+				"Uploading package...",
+			},
+		},
+		{
+			name: "dry run with existing service and identical package",
+			args: args("compute deploy --service-id 123 --token 123 --dry-run --json"),
+			api: mock.API{
+				GetPackageFn:        getPackageIdentical,
+				GetServiceDetailsFn: getServiceDetailsWasm,
+				ListDomainsFn:       listDomainsOk,
+				ListVersionsFn:      testutil.ListVersions,
+			},
+			wantOutput: []string{
+				`"service_id":"123"`,
+				`"clone_version":true`,
+				`"upload":false}`,
+				`"activate":false`,
+			},
+		},
+		{
+			name: "dry run with no existing service",
+			args: args("compute deploy --token 123 --dry-run --domain example.com"),
+			api: mock.API{
+				ListObjectStoresFn: listObjectStoresOK,
+			},
+			manifest: `
+			name = "package"
+			manifest_version = 2
+			language = "rust"
+
+			[setup.backends.backend_name]
+			address = "developer.fastly.com"
+			port = 443
+
+			[setup.dictionaries.dict_name]
+			[setup.dictionaries.dict_name.items.foo]
+			value = "bar"
+
+			[setup.log_endpoints.foo]
+			provider = "BigQuery"
+
+			[setup.log_endpoints.bar]
+			provider = "s3"
+			bucket_name = "my-bucket"
+
+			[setup.acls.blocklist]
+			[setup.healthchecks.origin_check]
+			[setup.object_stores.existing_store]
+			[setup.object_stores.new_store]
+			`,
+			wantOutput: []string{
+				"Service: create service 'package'",
+				"Version: 1",
+				"Domain: create domain 'example.com'",
+				"Backend: create backend 'backend_name' (host: developer.fastly.com, port: 443)",
+				"Dictionary: create dictionary 'dict_name'",
+				"Healthcheck: create healthcheck 'origin_check'",
+				"ACL: create ACL 'blocklist'",
+				"Object store: create object store 'new_store'",
+				"Log endpoint: create log endpoint 'bar' (provider: s3)",
+				"Log endpoint: 'foo' must be created manually",
+				"Activate: activate version",
+			},
+			dontWantOutput: []string{
+				"Create new service:",
+				"originless",
+				"existing_store",
+			},
+		},
+		{
+			name: "dry run with environment manifest",
+			args: args("compute deploy --token 123 --dry-run --env stage"),
+			api: mock.API{
+				GetPackageFn:        getPackageOk,
+				GetServiceDetailsFn: getServiceDetailsWasm,
+				ListDomainsFn:       listDomainsOk,
+				ListVersionsFn:      testutil.ListVersions,
+			},
+			manifest: `
+			name = "package"
+			manifest_version = 2
+			language = "rust"
+			service_id = "123"
+			`,
+			envManifest: `
+			service_id = "456"
+			`,
+			wantOutput: []string{
+				"Service: 456",
+			},
+		},
+		{
+			name: "dry run with environment manifest without a service_id",
+			args: args("compute deploy --token 123 --dry-run --env stage --domain example.com"),
+			api: mock.API{
+				ListObjectStoresFn: listObjectStoresOK,
+			},
+			manifest: `
+			name = "package"
+			manifest_version = 2
+			language = "rust"
+			service_id = "123"
+			`,
+			envManifest: `
+			[scripts]
+			build = "cargo build --release"
+			`,
+			wantOutput: []string{
+				"Service: create service 'package'",
+			},
+			dontWantOutput: []string{
+				"Service: 123",
+			},
+		},
 	}
 	for testcaseIdx := range scenarios {
 		testcase := &scenarios[testcaseIdx]
@@ -1282,6 +1734,16 @@ func TestDeploy(t *testing.T) {
 				t.Fatal(err)
 			}
 
+			// The environment manifest is layered over the fastly.toml manifest when
+			// the --env flag is set.
+			if testcase.envManifest != "" {
+				envPath := filepath.Join(rootdir, manifest.EnvironmentFilename("stage"))
+				if err := os.WriteFile(envPath, []byte(testcase.envManifest), 0o777); err != nil {
+					t.Fatal(err)
+				}
+				defer os.Remove(envPath)
+			}
+
 			// For any test scenario that expects no manifest to exist, then instead
 			// of deleting the manifest and having to recreate it, we'll simply
 			// rename it, and then rename it back once the specific test scenario has
@@ -1378,6 +1840,28 @@ func TestDeploy(t *testing.T) {
 	}
 }
 
+// createBackendWithSettings validates the [setup.backends] settings defined by
+// the "success with setup.backends TLS..." scenario are passed to the API.
+func createBackendWithSettings(i *fastly.CreateBackendInput) (*fastly.Backend, error) {
+	want := fastly.CreateBackendInput{
+		ServiceID:        i.ServiceID,
+		ServiceVersion:   i.ServiceVersion,
+		Name:             "origin",
+		Address:          "example.com",
+		Port:             fastly.Uint(443),
+		SSLSNIHostname:   "sni.example.com",
+		UseSSL:           true,
+		ConnectTimeout:   fastly.Uint(1500),
+		FirstByteTimeout: fastly.Uint(20000),
+		Shield:           "london-uk",
+		HealthCheck:      "origin_check",
+	}
+	if diff := cmp.Diff(want, *i); diff != "" {
+		return nil, fmt.Errorf("unexpected backend input (-want +have):\n%s", diff)
+	}
+	return createBackendOK(i)
+}
+
 func createServiceOK(i *fastly.CreateServiceInput) (*fastly.Service, error) {
 	return &fastly.Service{
 		ID:   "12345",
@@ -1426,6 +1910,15 @@ func deleteBackendOK(i *fastly.DeleteBackendInput) error {
 	return nil
 }
 
+// listSyncBackends returns the backends of an existing service used by the
+// --sync-setup scenarios.
+func listSyncBackends(i *fastly.ListBackendsInput) ([]*fastly.Backend, error) {
+	return []*fastly.Backend{
+		{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, Name: "origin", Address: "old.example.com", Port: 80, UseSSL: true},
+		{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, Name: "legacy", Address: "legacy.example.com", Port: 80},
+	}, nil
+}
+
 func getPackageIdentical(i *fastly.GetPackageInput) (*fastly.Package, error) {
 	return &fastly.Package{
 		ServiceID:      i.ServiceID,
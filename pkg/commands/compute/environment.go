@@ -0,0 +1,22 @@
+package compute
+
+import (
+	"github.com/fastly/cli/pkg/manifest"
+)
+
+// flagEnvDesc is the description of the --env flag shared by the compute
+// commands.
+const flagEnvDesc = "The environment configuration to use (e.g. stage)"
+
+// applyEnvironment layers the fastly.<env>.toml manifest over the fastly.toml
+// manifest when an environment is set.
+//
+// NOTE: If the fastly.toml manifest couldn't be read, then there is nothing to
+// layer the environment over, and so we leave it to the caller to handle the
+// manifest ReadError().
+func applyEnvironment(data *manifest.Data, env string) error {
+	if env == "" || data.File.ReadError() != nil {
+		return nil
+	}
+	return data.File.ReadEnvironment(manifest.EnvironmentFilename(env))
+}
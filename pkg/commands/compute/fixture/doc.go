@@ -0,0 +1,3 @@
+// Package fixture contains logic for running the request fixtures used by the
+// `compute test` command against a locally running Compute@Edge package.
+package fixture
@@ -0,0 +1,188 @@
+package fixture
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	toml "github.com/pelletier/go-toml"
+)
+
+// DefaultFilename is the fixtures file read when none is specified.
+var DefaultFilename = "tests/fixtures.toml"
+
+// File represents a fixtures file, which describes the requests to send to a
+// Compute@Edge package and the responses they're expected to produce.
+type File struct {
+	Tests []Test `toml:"tests"`
+}
+
+// Test describes a single HTTP request and its expected response.
+type Test struct {
+	Name    string            `toml:"name"`
+	Method  string            `toml:"method"`
+	Path    string            `toml:"path"`
+	Headers map[string]string `toml:"headers"`
+	Body    string            `toml:"body"`
+	Expect  Expect            `toml:"expect"`
+}
+
+// Expect describes the expected response.
+//
+// NOTE: The header values and body are regular expressions, which are matched
+// against (rather than compared to) the response. An omitted status, header
+// or body isn't checked.
+type Expect struct {
+	Status  int               `toml:"status"`
+	Headers map[string]string `toml:"headers"`
+	Body    string            `toml:"body"`
+}
+
+// Result describes the outcome of running a Test.
+type Result struct {
+	Name     string
+	Duration time.Duration
+	Failures []string
+}
+
+// Passed indicates if the response met every expectation.
+func (r Result) Passed() bool {
+	return len(r.Failures) == 0
+}
+
+// Read parses and validates the fixtures file at path.
+func Read(path string) (*File, error) {
+	// gosec flagged this:
+	// G304 (CWE-22): Potential file inclusion via variable
+	//
+	// Disabling as we need to load the fixtures file chosen by the user.
+	/* #nosec */
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var f File
+	if err := toml.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", path, err)
+	}
+	if len(f.Tests) == 0 {
+		return nil, fmt.Errorf("no [[tests]] defined in %s", path)
+	}
+
+	for i := range f.Tests {
+		t := &f.Tests[i]
+		if t.Method == "" {
+			t.Method = http.MethodGet
+		}
+		t.Method = strings.ToUpper(t.Method)
+		if !strings.HasPrefix(t.Path, "/") {
+			return nil, fmt.Errorf("test %d in %s: 'path' must begin with a forward slash", i+1, path)
+		}
+		if t.Name == "" {
+			t.Name = fmt.Sprintf("%s %s", t.Method, t.Path)
+		}
+		if err := t.Expect.validate(); err != nil {
+			return nil, fmt.Errorf("test '%s' in %s: %w", t.Name, path, err)
+		}
+	}
+
+	return &f, nil
+}
+
+// Run sends each test request to baseURL and checks the responses.
+func Run(client *http.Client, baseURL string, tests []Test) []Result {
+	results := make([]Result, 0, len(tests))
+	for _, t := range tests {
+		results = append(results, t.Run(client, baseURL))
+	}
+	return results
+}
+
+// Run sends the test request to baseURL and checks the response.
+func (t Test) Run(client *http.Client, baseURL string) (r Result) {
+	r.Name = t.Name
+	start := time.Now()
+	defer func() {
+		r.Duration = time.Since(start)
+	}()
+
+	req, err := http.NewRequest(t.Method, strings.TrimSuffix(baseURL, "/")+t.Path, strings.NewReader(t.Body))
+	if err != nil {
+		r.Failures = append(r.Failures, fmt.Sprintf("error constructing request: %s", err))
+		return r
+	}
+	for k, v := range t.Headers {
+		req.Header.Set(k, v)
+	}
+	// NOTE: The Host header has to be set on the request itself.
+	if host := req.Header.Get("Host"); host != "" {
+		req.Host = host
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		r.Failures = append(r.Failures, fmt.Sprintf("error sending request: %s", err))
+		return r
+	}
+	defer resp.Body.Close() // #nosec G307
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		r.Failures = append(r.Failures, fmt.Sprintf("error reading response body: %s", err))
+		return r
+	}
+
+	r.Failures = t.Expect.check(resp, body)
+	return r
+}
+
+// validate checks the expected header values and body are valid regular
+// expressions.
+func (e Expect) validate() error {
+	for k, v := range e.Headers {
+		if _, err := regexp.Compile(v); err != nil {
+			return fmt.Errorf("invalid pattern for expected header '%s': %w", k, err)
+		}
+	}
+	if _, err := regexp.Compile(e.Body); err != nil {
+		return fmt.Errorf("invalid pattern for expected body: %w", err)
+	}
+	return nil
+}
+
+// check returns a description of each expectation the response didn't meet.
+func (e Expect) check(resp *http.Response, body []byte) (failures []string) {
+	if e.Status > 0 && resp.StatusCode != e.Status {
+		failures = append(failures, fmt.Sprintf("expected status %d, got %d", e.Status, resp.StatusCode))
+	}
+
+	names := make([]string, 0, len(e.Headers))
+	for k := range e.Headers {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	for _, k := range names {
+		values, ok := resp.Header[http.CanonicalHeaderKey(k)]
+		if !ok {
+			failures = append(failures, fmt.Sprintf("expected header '%s' to be set", k))
+			continue
+		}
+		v := strings.Join(values, ", ")
+		if !regexp.MustCompile(e.Headers[k]).MatchString(v) {
+			failures = append(failures, fmt.Sprintf("expected header '%s' to match '%s', got '%s'", k, e.Headers[k], v))
+		}
+	}
+
+	if e.Body != "" && !regexp.MustCompile(e.Body).Match(body) {
+		failures = append(failures, fmt.Sprintf("expected body to match '%s'", e.Body))
+	}
+
+	return failures
+}
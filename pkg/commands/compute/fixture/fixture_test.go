@@ -0,0 +1,169 @@
+package fixture_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/fastly/cli/pkg/commands/compute/fixture"
+	"github.com/fastly/cli/pkg/testutil"
+)
+
+func TestRead(t *testing.T) {
+	scenarios := []struct {
+		name       string
+		content    string
+		wantError  string
+		wantTests  int
+		wantName   string
+		wantMethod string
+	}{
+		{
+			name: "defaults",
+			content: `
+			[[tests]]
+			path = "/"
+			`,
+			wantTests:  1,
+			wantName:   "GET /",
+			wantMethod: "GET",
+		},
+		{
+			name: "explicit values",
+			content: `
+			[[tests]]
+			name = "create"
+			method = "post"
+			path = "/items"
+			body = "{}"
+			[tests.headers]
+			content-type = "application/json"
+			[tests.expect]
+			status = 201
+
+			[[tests]]
+			path = "/items"
+			`,
+			wantTests:  2,
+			wantName:   "create",
+			wantMethod: "POST",
+		},
+		{
+			name:      "no tests",
+			content:   `foo = "bar"`,
+			wantError: "no [[tests]] defined",
+		},
+		{
+			name: "invalid path",
+			content: `
+			[[tests]]
+			path = "items"
+			`,
+			wantError: "'path' must begin with a forward slash",
+		},
+		{
+			name: "invalid body pattern",
+			content: `
+			[[tests]]
+			path = "/"
+			[tests.expect]
+			body = "("
+			`,
+			wantError: "invalid pattern for expected body",
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "fixtures.toml")
+			if err := os.WriteFile(path, []byte(testcase.content), 0o644); err != nil {
+				t.Fatal(err)
+			}
+
+			f, err := fixture.Read(path)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			if err != nil {
+				return
+			}
+			if len(f.Tests) != testcase.wantTests {
+				t.Fatalf("want %d tests, have %d", testcase.wantTests, len(f.Tests))
+			}
+			testutil.AssertString(t, testcase.wantName, f.Tests[0].Name)
+			testutil.AssertString(t, testcase.wantMethod, f.Tests[0].Method)
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.Header().Set("X-Method", r.Method)
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, "hello %s from %s", r.Header.Get("X-Name"), r.URL.Path)
+	}))
+	defer srv.Close()
+
+	tests := []fixture.Test{
+		{
+			Name:    "pass",
+			Method:  http.MethodGet,
+			Path:    "/greeting",
+			Headers: map[string]string{"X-Name": "world"},
+			Expect: fixture.Expect{
+				Status:  http.StatusOK,
+				Headers: map[string]string{"content-type": "^text/plain"},
+				Body:    "^hello world from /greeting$",
+			},
+		},
+		{
+			Name:   "fail",
+			Method: http.MethodPost,
+			Path:   "/",
+			Expect: fixture.Expect{
+				Status:  http.StatusCreated,
+				Headers: map[string]string{"X-Method": "GET", "X-Missing": ".*"},
+				Body:    "goodbye",
+			},
+		},
+	}
+
+	results := fixture.Run(srv.Client(), srv.URL, tests)
+	if len(results) != 2 {
+		t.Fatalf("want 2 results, have %d", len(results))
+	}
+	if !results[0].Passed() {
+		t.Fatalf("want test to pass, have failures: %v", results[0].Failures)
+	}
+
+	want := []string{
+		"expected status 201, got 200",
+		"expected header 'X-Method' to match 'GET', got 'POST'",
+		"expected header 'X-Missing' to be set",
+		"expected body to match 'goodbye'",
+	}
+	testutil.AssertEqual(t, want, results[1].Failures)
+}
+
+func TestWriteJUnit(t *testing.T) {
+	results := []fixture.Result{
+		{Name: "pass"},
+		{Name: "fail", Failures: []string{"expected status 201, got 200", "expected body to match 'goodbye'"}},
+	}
+
+	var buf bytes.Buffer
+	if err := fixture.WriteJUnit(&buf, "tests/fixtures.toml", results); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, s := range []string{
+		`<?xml version="1.0" encoding="UTF-8"?>`,
+		`<testsuite name="tests/fixtures.toml" tests="2" failures="1" time="0.000">`,
+		`<testcase name="pass" classname="tests/fixtures.toml" time="0.000"></testcase>`,
+		`<failure message="expected status 201, got 200">expected status 201, got 200&#xA;expected body to match &#39;goodbye&#39;</failure>`,
+	} {
+		testutil.AssertStringContains(t, buf.String(), s)
+	}
+}
@@ -0,0 +1,83 @@
+package fixture
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// junitTestSuites is the root element of a JUnit XML report.
+type junitTestSuites struct {
+	XMLName xml.Name         `xml:"testsuites"`
+	Suites  []junitTestSuite `xml:"testsuite"`
+}
+
+// junitTestSuite describes the results of running a fixtures file.
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Time     string          `xml:"time,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+// junitTestCase describes the result of running a single Test.
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+}
+
+// junitFailure describes why a Test failed.
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// WriteJUnit writes the results as a JUnit XML report, with the results
+// grouped into a test suite of the given name.
+func WriteJUnit(w io.Writer, name string, results []Result) error {
+	suite := junitTestSuite{
+		Name:  name,
+		Tests: len(results),
+	}
+
+	var total time.Duration
+	for _, r := range results {
+		total += r.Duration
+
+		tc := junitTestCase{
+			Name:      r.Name,
+			ClassName: name,
+			Time:      seconds(r.Duration),
+		}
+		if !r.Passed() {
+			suite.Failures++
+			tc.Failure = &junitFailure{
+				Message: r.Failures[0],
+				Text:    strings.Join(r.Failures, "\n"),
+			}
+		}
+		suite.Cases = append(suite.Cases, tc)
+	}
+	suite.Time = seconds(total)
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(junitTestSuites{Suites: []junitTestSuite{suite}}); err != nil {
+		return fmt.Errorf("error encoding JUnit report: %w", err)
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// seconds formats d in the fractional seconds JUnit expects.
+func seconds(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
@@ -1,8 +1,12 @@
 package compute
 
 import (
+	"crypto/sha512"
 	"fmt"
 	"io"
+	"os"
+	"sort"
+	"strings"
 
 	"github.com/fastly/cli/pkg/cmd"
 	"github.com/fastly/cli/pkg/config"
@@ -16,9 +20,11 @@ type HashsumCommand struct {
 	cmd.Base
 
 	buildCmd  *BuildCommand
+	Env       string
 	Manifest  manifest.Data
 	Package   string
 	SkipBuild bool
+	Verify    string
 }
 
 // NewHashsumCommand returns a usable command registered under the parent.
@@ -28,13 +34,29 @@ func NewHashsumCommand(parent cmd.Registerer, globals *config.Data, build *Build
 	c.Globals = globals
 	c.Manifest = data
 	c.CmdClause = parent.Command("hashsum", "Generate a SHA512 digest from a Compute@Edge package")
+	c.CmdClause.Flag("env", flagEnvDesc).StringVar(&c.Env)
 	c.CmdClause.Flag("package", "Path to a package tar.gz").Short('p').StringVar(&c.Package)
 	c.CmdClause.Flag("skip-build", "Skip the build step").BoolVar(&c.SkipBuild)
+	c.CmdClause.Flag("verify", "Path to a package tar.gz to verify is byte-identical to the local build").StringVar(&c.Verify)
 	return &c
 }
 
 // Exec implements the command interface.
 func (c *HashsumCommand) Exec(in io.Reader, out io.Writer) (err error) {
+	// NOTE: The package to verify is read before building, as it may well be
+	// the package the build overwrites.
+	var want *packageSnapshot
+	if c.Verify != "" {
+		want, err = snapshotPackage(c.Verify)
+		if err != nil {
+			c.Globals.ErrLog.Add(err)
+			return fsterr.RemediationError{
+				Inner:       fmt.Errorf("failed to read package to verify: %w", err),
+				Remediation: "Check the --verify flag references a Compute@Edge package (.tar.gz).",
+			}
+		}
+	}
+
 	if !c.SkipBuild {
 		err = c.Build(in, out)
 		if err != nil {
@@ -42,7 +64,8 @@ func (c *HashsumCommand) Exec(in io.Reader, out io.Writer) (err error) {
 		}
 	}
 
-	_, hashSum, err := validatePackage(c.Manifest, c.Package, c.Globals.Verbose(), c.Globals.ErrLog, out)
+	// NOTE: The host ABI isn't checked as we only need the package digest.
+	_, hashSum, err := validatePackage(c.Manifest, c.Package, true, c.Globals.Verbose(), c.Globals.ErrLog, out)
 	if err != nil {
 		return fsterr.RemediationError{
 			Inner:       fmt.Errorf("failed to validate package: %w", err),
@@ -54,10 +77,97 @@ func (c *HashsumCommand) Exec(in io.Reader, out io.Writer) (err error) {
 		text.Break(out)
 	}
 
+	if want != nil {
+		pkgPath, err := packagePath(c.Package)
+		if err != nil {
+			return err
+		}
+		have, err := snapshotPackage(pkgPath)
+		if err != nil {
+			c.Globals.ErrLog.Add(err)
+			return err
+		}
+		if err := verifyPackage(want, have); err != nil {
+			c.Globals.ErrLog.Add(err)
+			return fsterr.RemediationError{
+				Inner:       fmt.Errorf("package %s is not reproducible: %w", c.Verify, err),
+				Remediation: "Check the package was built from the same source, toolchain and fastly.toml. Packages built with an older version of the CLI aren't reproducible and should be rebuilt.",
+			}
+		}
+		text.Success(out, "Verified package %s is identical to %s", c.Verify, pkgPath)
+	}
+
 	text.Output(out, hashSum)
 	return nil
 }
 
+// packageSnapshot describes a package archive at a point in time.
+type packageSnapshot struct {
+	// digest is the SHA512 digest of the whole archive.
+	digest string
+	// files are the SHA256 digests of each file within the archive.
+	files map[string]string
+}
+
+// snapshotPackage reads the package archive at path.
+func snapshotPackage(path string) (*packageSnapshot, error) {
+	// gosec flagged this:
+	// G304 (CWE-22): Potential file inclusion via variable
+	//
+	// Disabling as the package is chosen by the user.
+	/* #nosec */
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	files, err := archiveDigests(path)
+	if err != nil {
+		return nil, err
+	}
+	return &packageSnapshot{
+		digest: fmt.Sprintf("%x", sha512.Sum512(data)),
+		files:  files,
+	}, nil
+}
+
+// verifyPackage returns an error describing how the packages differ.
+func verifyPackage(want, have *packageSnapshot) error {
+	if want.digest == have.digest {
+		return nil
+	}
+
+	names := make(map[string]bool)
+	for name := range want.files {
+		names[name] = true
+	}
+	for name := range have.files {
+		names[name] = true
+	}
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+
+	var diffs []string
+	for _, name := range sorted {
+		w, inWant := want.files[name]
+		h, inHave := have.files[name]
+		switch {
+		case !inHave:
+			diffs = append(diffs, fmt.Sprintf("%s is missing from the local build", name))
+		case !inWant:
+			diffs = append(diffs, fmt.Sprintf("%s is only in the local build", name))
+		case w != h:
+			diffs = append(diffs, fmt.Sprintf("%s differs", name))
+		}
+	}
+	if len(diffs) == 0 {
+		return fmt.Errorf("the files are identical but the archive metadata (ordering, timestamps, ownership or permissions) differs")
+	}
+	return fmt.Errorf("%s", strings.Join(diffs, ", "))
+}
+
 // Build constructs and executes the build logic.
 func (c *HashsumCommand) Build(in io.Reader, out io.Writer) error {
 	output := out
@@ -65,6 +175,10 @@ func (c *HashsumCommand) Build(in io.Reader, out io.Writer) error {
 		output = io.Discard
 	}
 
+	if c.Env != "" {
+		c.buildCmd.Flags.Env = c.Env
+	}
+
 	err := c.buildCmd.Exec(in, output)
 	if err != nil {
 		return err
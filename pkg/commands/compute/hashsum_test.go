@@ -0,0 +1,89 @@
+package compute_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/testutil"
+)
+
+// TestHashsumVerify validates that packing the same files twice produces a
+// byte-identical package, regardless of file modification times, and that
+// `compute hashsum --verify` reports when a package differs.
+func TestHashsumVerify(t *testing.T) {
+	args := testutil.Args
+
+	// We're going to chdir to a test environment,
+	// so save the PWD to return to, afterwards.
+	pwd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rootdir := testutil.NewEnv(testutil.EnvOpts{
+		T: t,
+		Copy: []testutil.FileIO{
+			{Src: filepath.Join("testdata", "pack", "main.wasm"), Dst: "main.wasm"},
+		},
+		Write: []testutil.FileIO{
+			{Src: "manifest_version = 2\nname = \"reproducible\"\n", Dst: manifest.Filename},
+		},
+	})
+	defer os.RemoveAll(rootdir)
+
+	if err := os.Chdir(rootdir); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(pwd)
+
+	run := func(cmd string) (string, error) {
+		var stdout bytes.Buffer
+		opts := testutil.NewRunOpts(args(cmd), &stdout)
+		err := app.Run(opts)
+		t.Log(stdout.String())
+		return stdout.String(), err
+	}
+
+	if _, err := run("compute pack --wasm-binary ./main.wasm"); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Rename(filepath.Join("pkg", "package.tar.gz"), "previous.tar.gz"); err != nil {
+		t.Fatal(err)
+	}
+
+	later := time.Now().Add(time.Hour)
+	for _, f := range []string{"main.wasm", manifest.Filename} {
+		if err := os.Chtimes(f, later, later); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := run("compute pack --wasm-binary ./main.wasm"); err != nil {
+		t.Fatal(err)
+	}
+
+	stdout, err := run("compute hashsum --skip-build --verify previous.tar.gz")
+	testutil.AssertNoError(t, err)
+	testutil.AssertStringContains(t, stdout, "Verified package previous.tar.gz is identical")
+
+	f, err := os.OpenFile("main.wasm", os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.Write([]byte{0x00}); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := run("compute pack --wasm-binary ./main.wasm"); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = run("compute hashsum --skip-build --verify previous.tar.gz")
+	testutil.AssertErrorContains(t, err, "package previous.tar.gz is not reproducible: package/bin/main.wasm differs")
+}
@@ -0,0 +1,278 @@
+package compute
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/compute/wasm"
+	"github.com/fastly/cli/pkg/config"
+	fsterr "github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// InspectCommand describes the structure of a Compute@Edge package or Wasm
+// binary, to help diagnose package size and host call compatibility issues.
+type InspectCommand struct {
+	cmd.Base
+
+	file string
+	pkg  string
+	top  int
+}
+
+// NewInspectCommand returns a usable command registered under the parent.
+func NewInspectCommand(parent cmd.Registerer, globals *config.Data) *InspectCommand {
+	var c InspectCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("inspect", "Describe the Wasm binary within a Compute@Edge package")
+	c.CmdClause.Flag("file", "Path to a Wasm binary (e.g. bin/main.wasm) to inspect instead of a package").StringVar(&c.file)
+	c.CmdClause.Flag("package", "Path to a package tar.gz").Short('p').StringVar(&c.pkg)
+	c.CmdClause.Flag("top", "Number of the largest functions and data segments to report").Default("10").IntVar(&c.top)
+	c.RegisterOutputFlags()
+	return &c
+}
+
+// InspectReport describes a Compute@Edge package or Wasm binary.
+type InspectReport struct {
+	Source      string             `json:"source"`
+	PackageSize *int64             `json:"package_size,omitempty"`
+	WasmSize    int                `json:"wasm_size"`
+	Sections    []wasm.Section     `json:"sections"`
+	Functions   int                `json:"functions"`
+	Largest     []wasm.Function    `json:"largest_functions"`
+	DataSize    int                `json:"data_size"`
+	LargestData []wasm.DataSegment `json:"largest_data_segments"`
+	Imports     []InspectImports   `json:"imports"`
+	Exports     []wasm.Export      `json:"exports"`
+	Manifest    string             `json:"manifest,omitempty"`
+}
+
+// InspectImports are the imports of a single module (e.g. a host call ABI
+// module such as "fastly_http_req").
+type InspectImports struct {
+	Module  string        `json:"module"`
+	Imports []wasm.Import `json:"imports"`
+}
+
+// Exec implements the command interface.
+func (c *InspectCommand) Exec(_ io.Reader, out io.Writer) error {
+	if err := c.CheckOutputFlags(); err != nil {
+		return err
+	}
+	if c.file != "" && c.pkg != "" {
+		return fmt.Errorf("error parsing arguments: the --file flag is mutually exclusive with the --package flag")
+	}
+	if c.top < 0 {
+		return fsterr.RemediationError{
+			Inner:       fmt.Errorf("invalid --top value %d", c.top),
+			Remediation: "Provide a number of functions and data segments to report that is zero or more.",
+		}
+	}
+
+	report, err := c.inspect()
+	if err != nil {
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+
+	if ok, err := c.WriteOutput(out, report); ok {
+		return err
+	}
+	printInspectReport(out, report)
+	return nil
+}
+
+// inspect reads and parses the Wasm binary, either directly or from within
+// the package.
+func (c *InspectCommand) inspect() (*InspectReport, error) {
+	var (
+		report InspectReport
+		data   []byte
+	)
+
+	if c.file != "" {
+		report.Source = c.file
+		// gosec flagged this:
+		// G304 (CWE-22): Potential file inclusion via variable
+		//
+		// Disabling as the file is chosen by the user.
+		/* #nosec */
+		bs, err := os.ReadFile(c.file)
+		if err != nil {
+			return nil, fsterr.RemediationError{
+				Inner:       fmt.Errorf("failed to read Wasm binary: %w", err),
+				Remediation: "Check the --file flag references a Wasm binary (e.g. bin/main.wasm).",
+			}
+		}
+		data = bs
+	} else {
+		pkgPath, err := packagePath(c.pkg)
+		if err != nil {
+			return nil, err
+		}
+		report.Source = pkgPath
+
+		size, err := packageSize(pkgPath)
+		if err != nil {
+			return nil, fsterr.RemediationError{
+				Inner:       fmt.Errorf("failed to read package: %w", err),
+				Remediation: "Run `fastly compute build` to produce a Compute@Edge package, alternatively use the --package flag to reference a package outside of the current project, or the --file flag to reference a Wasm binary.",
+			}
+		}
+		report.PackageSize = &size
+
+		files, err := readArchiveFiles(pkgPath, "main.wasm", "fastly.toml")
+		if err != nil {
+			return nil, fsterr.RemediationError{
+				Inner:       fmt.Errorf("failed to read package: %w", err),
+				Remediation: "Check the --package flag references a Compute@Edge package (.tar.gz).",
+			}
+		}
+		bs, ok := files["main.wasm"]
+		if !ok {
+			return nil, fsterr.RemediationError{
+				Inner:       fmt.Errorf("package %s doesn't contain a main.wasm", pkgPath),
+				Remediation: "Run `fastly compute build` to produce a valid Compute@Edge package.",
+			}
+		}
+		data = bs
+		report.Manifest = string(files["fastly.toml"])
+	}
+
+	m, err := wasm.Parse(data)
+	if err != nil {
+		return nil, fsterr.RemediationError{
+			Inner:       fmt.Errorf("failed to parse Wasm binary from %s: %w", report.Source, err),
+			Remediation: "Check the Wasm binary was compiled for the wasm32-wasi target.",
+		}
+	}
+
+	report.WasmSize = m.Size
+	report.Sections = m.Sections
+	report.Functions = len(m.Functions)
+	report.Exports = m.Exports
+
+	functions := append([]wasm.Function(nil), m.Functions...)
+	sort.SliceStable(functions, func(i, j int) bool {
+		return functions[i].Size > functions[j].Size
+	})
+	report.Largest = largest(functions, c.top)
+
+	segments := append([]wasm.DataSegment(nil), m.Data...)
+	for _, d := range segments {
+		report.DataSize += d.Size
+	}
+	sort.SliceStable(segments, func(i, j int) bool {
+		return segments[i].Size > segments[j].Size
+	})
+	report.LargestData = largest(segments, c.top)
+
+	report.Imports = []InspectImports{}
+	index := make(map[string]int)
+	for _, imp := range m.Imports {
+		i, ok := index[imp.Module]
+		if !ok {
+			i = len(report.Imports)
+			index[imp.Module] = i
+			report.Imports = append(report.Imports, InspectImports{Module: imp.Module})
+		}
+		report.Imports[i].Imports = append(report.Imports[i].Imports, imp)
+	}
+
+	return &report, nil
+}
+
+// largest returns at most the first n elements of s.
+func largest[T any](s []T, n int) []T {
+	if len(s) > n {
+		return s[:n]
+	}
+	return s
+}
+
+// printInspectReport displays the report as text.
+func printInspectReport(out io.Writer, r *InspectReport) {
+	text.Break(out)
+	fmt.Fprintf(out, "Source: %s\n", r.Source)
+	if r.PackageSize != nil {
+		fmt.Fprintf(out, "Package size: %d bytes (%s of the %d byte limit)\n", *r.PackageSize, percent(int(*r.PackageSize), int(PackageSizeLimit)), PackageSizeLimit)
+	}
+	fmt.Fprintf(out, "Wasm size: %d bytes\n", r.WasmSize)
+
+	text.Break(out)
+	t := text.NewTable(out)
+	t.AddHeader("SECTION", "SIZE", "%")
+	for _, s := range r.Sections {
+		t.AddLine(s.Name, s.Size, percent(s.Size, r.WasmSize))
+	}
+	t.Print()
+
+	text.Break(out)
+	fmt.Fprintf(out, "Functions: %d\n", r.Functions)
+	if len(r.Largest) > 0 {
+		text.Break(out)
+		t = text.NewTable(out)
+		t.AddHeader("INDEX", "NAME", "SIZE", "%")
+		for _, f := range r.Largest {
+			t.AddLine(f.Index, f.Name, f.Size, percent(f.Size, r.WasmSize))
+		}
+		t.Print()
+	}
+
+	text.Break(out)
+	fmt.Fprintf(out, "Data: %d bytes\n", r.DataSize)
+	if len(r.LargestData) > 0 {
+		text.Break(out)
+		t = text.NewTable(out)
+		t.AddHeader("INDEX", "MODE", "OFFSET", "SIZE", "%")
+		for _, d := range r.LargestData {
+			offset := ""
+			if d.Offset != nil {
+				offset = fmt.Sprintf("%d", *d.Offset)
+			}
+			t.AddLine(d.Index, d.Mode, offset, d.Size, percent(d.Size, r.WasmSize))
+		}
+		t.Print()
+	}
+
+	text.Break(out)
+	fmt.Fprintln(out, "Imports:")
+	for _, group := range r.Imports {
+		text.Break(out)
+		fmt.Fprintf(out, "%s (%d)\n", group.Module, len(group.Imports))
+		for _, imp := range group.Imports {
+			if imp.Signature != "" {
+				fmt.Fprintf(out, "  %s %s\n", imp.Name, imp.Signature)
+			} else {
+				fmt.Fprintf(out, "  %s (%s)\n", imp.Name, imp.Kind)
+			}
+		}
+	}
+
+	text.Break(out)
+	t = text.NewTable(out)
+	t.AddHeader("EXPORT", "KIND", "INDEX")
+	for _, e := range r.Exports {
+		t.AddLine(e.Name, e.Kind, e.Index)
+	}
+	t.Print()
+
+	if r.Manifest != "" {
+		text.Break(out)
+		fmt.Fprintln(out, "Manifest:")
+		text.Break(out)
+		fmt.Fprintln(out, strings.TrimRight(r.Manifest, "\n"))
+	}
+}
+
+// percent formats n as a percentage of total.
+func percent(n, total int) string {
+	if total == 0 {
+		return "0.0%"
+	}
+	return fmt.Sprintf("%.1f%%", float64(n)*100/float64(total))
+}
@@ -0,0 +1,133 @@
+package compute_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/commands/compute"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/testutil"
+)
+
+func TestInspect(t *testing.T) {
+	args := testutil.Args
+
+	// We're going to chdir to a test environment,
+	// so save the PWD to return to, afterwards.
+	pwd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rootdir := testutil.NewEnv(testutil.EnvOpts{
+		T: t,
+		Copy: []testutil.FileIO{
+			{Src: filepath.Join("testdata", "inspect", "main.wasm"), Dst: "main.wasm"},
+		},
+		Write: []testutil.FileIO{
+			{Src: "manifest_version = 2\nname = \"inspect\"\n", Dst: manifest.Filename},
+		},
+	})
+	defer os.RemoveAll(rootdir)
+
+	if err := os.Chdir(rootdir); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(pwd)
+
+	run := func(cmd string) (string, error) {
+		var stdout bytes.Buffer
+		opts := testutil.NewRunOpts(args(cmd), &stdout)
+		err := app.Run(opts)
+		t.Log(stdout.String())
+		return stdout.String(), err
+	}
+
+	_, err = run("compute inspect")
+	testutil.AssertRemediationErrorContains(t, err, "Run `fastly compute build`")
+
+	if _, err := run("compute pack --wasm-binary ./main.wasm"); err != nil {
+		t.Fatal(err)
+	}
+
+	scenarios := []struct {
+		args                 string
+		wantError            string
+		wantOutput           []string
+		dontWantOutput       []string
+		wantManifest         bool
+		wantLargestFunctions int
+	}{
+		{
+			args: "compute inspect",
+			wantOutput: []string{
+				"Source: pkg/package.tar.gz",
+				"Package size:",
+				"Wasm size: 217 bytes",
+				"Functions: 2",
+				"fastly_http_req (2)",
+				"send (i32, i32) -> (i32)",
+				"wasi_snapshot_preview1 (1)",
+				"_start  func    3",
+				"Manifest:",
+				`name = "inspect"`,
+			},
+		},
+		{
+			args: "compute inspect --file ./main.wasm --top 1",
+			wantOutput: []string{
+				"Source: ./main.wasm",
+				"4      run   22",
+				"1      passive          16",
+			},
+			dontWantOutput: []string{
+				"Package size:",
+				"Manifest:",
+				"_start  2",
+			},
+		},
+		{
+			args:                 "compute inspect --package pkg/package.tar.gz --json",
+			wantManifest:         true,
+			wantLargestFunctions: 2,
+		},
+		{
+			args:      "compute inspect --file ./main.wasm --package pkg/package.tar.gz",
+			wantError: "the --file flag is mutually exclusive with the --package flag",
+		},
+		{
+			args:      "compute inspect --file fastly.toml",
+			wantError: "failed to parse Wasm binary from fastly.toml: not a WebAssembly binary module",
+		},
+	}
+	for _, s := range scenarios {
+		t.Run(s.args, func(t *testing.T) {
+			stdout, err := run(s.args)
+			if s.wantError != "" {
+				testutil.AssertErrorContains(t, err, s.wantError)
+				return
+			}
+			testutil.AssertNoError(t, err)
+			for _, want := range s.wantOutput {
+				testutil.AssertStringContains(t, stdout, want)
+			}
+			for _, dontWant := range s.dontWantOutput {
+				testutil.AssertStringDoesntContain(t, stdout, dontWant)
+			}
+			if s.wantLargestFunctions > 0 {
+				var report compute.InspectReport
+				if err := json.Unmarshal([]byte(stdout), &report); err != nil {
+					t.Fatal(err)
+				}
+				testutil.AssertEqual(t, s.wantLargestFunctions, len(report.Largest))
+				testutil.AssertEqual(t, "run", report.Largest[0].Name)
+				testutil.AssertBool(t, s.wantManifest, report.Manifest != "")
+				testutil.AssertEqual(t, 2, len(report.Imports))
+			}
+		})
+	}
+}
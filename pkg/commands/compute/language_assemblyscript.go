@@ -24,6 +24,9 @@ const AsCompilationURL = "https://www.npmjs.com/package/assemblyscript"
 // fastly.toml manifest.
 const AsDefaultBuildCommand = "$(npm bin)/asc assembly/index.ts --outFile bin/main.wasm --optimize --noAssert"
 
+// AsPackage is the npm package providing the AssemblyScript compiler.
+const AsPackage = "assemblyscript"
+
 // AsSDK is the required Compute@Edge SDK.
 // https://www.npmjs.com/package/@fastly/as-compute
 const AsSDK = "@fastly/as-compute"
@@ -100,6 +103,18 @@ type AssemblyScript struct {
 	postBuild string
 }
 
+// ToolchainVersion returns the version of the toolchain.
+func (a AssemblyScript) ToolchainVersion() (string, error) {
+	return jsToolchainVersion(AsPackage)
+}
+
+// SDKVersion overrides the embedded JavaScript implementation, as the ABI
+// versions supported by the AssemblyScript SDK releases aren't known to the CLI
+// and so recording its version would fail validation of the package.
+func (a AssemblyScript) SDKVersion() (string, error) {
+	return "", fmt.Errorf("the %s version isn't recorded in the %s", AsSDK, manifest.Filename)
+}
+
 // Build compiles the user's source code into a Wasm binary.
 func (a AssemblyScript) Build(out io.Writer, progress text.Progress, verbose bool, callback func() error) error {
 	// NOTE: We deliberately reference the validator pointer to the fastly.toml
@@ -0,0 +1,195 @@
+package compute
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+
+	fsterr "github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// CustomVersionPattern is the default regular expression for matching the
+// version within the output of a custom toolchain's version command.
+const CustomVersionPattern = `(\d+\.\d+(?:\.\d+)?[^\s]*)`
+
+// CustomToolchainRemediation is the error remediation for an invalid custom
+// toolchain declaration.
+const CustomToolchainRemediation = "Check the [toolchains.%s] section of the fastly.toml manifest or the CLI's config.toml."
+
+// customToolchain returns the declaration of the named custom language.
+//
+// NOTE: A declaration in the project's fastly.toml takes precedence over one
+// in the CLI's application configuration.
+func customToolchain(name string, project, global map[string]manifest.Toolchain) (manifest.Toolchain, bool) {
+	if t, ok := project[name]; ok {
+		return t, true
+	}
+	t, ok := global[name]
+	return t, ok
+}
+
+// NewCustom constructs a new custom language toolchain from its declaration.
+func NewCustom(
+	name string,
+	declaration manifest.Toolchain,
+	fastlyManifest *manifest.File,
+	errlog fsterr.LogInterface,
+	timeout int,
+	out io.Writer,
+) (*Custom, error) {
+	invalid := func(err error) error {
+		return fsterr.RemediationError{
+			Inner:       fmt.Errorf("invalid custom toolchain '%s': %w", name, err),
+			Remediation: fmt.Sprintf(CustomToolchainRemediation, name),
+		}
+	}
+
+	if declaration.Toolchain == "" {
+		return nil, invalid(fmt.Errorf("the 'toolchain' executable is required"))
+	}
+
+	versionPattern := declaration.ToolchainVersionPattern
+	if versionPattern == "" {
+		versionPattern = CustomVersionPattern
+	}
+	toolchainPattern, err := regexp.Compile(versionPattern)
+	if err != nil {
+		return nil, invalid(fmt.Errorf("error parsing 'toolchain_version_pattern': %w", err))
+	}
+
+	compilationPattern := declaration.CompilationPattern
+	if compilationPattern == "" {
+		compilationPattern = CustomVersionPattern
+		if declaration.CompilationIntegrated {
+			compilationPattern = fmt.Sprintf("(%s)", regexp.QuoteMeta(declaration.Compilation))
+		}
+	}
+	compilationRegexp, err := regexp.Compile(compilationPattern)
+	if err != nil {
+		return nil, invalid(fmt.Errorf("error parsing 'compilation_pattern': %w", err))
+	}
+
+	constraints := map[string]string{
+		"toolchain":   declaration.ToolchainConstraint,
+		"compilation": declaration.CompilationConstraint,
+	}
+
+	return &Custom{
+		Shell:       Shell{},
+		declaration: declaration,
+		errlog:      errlog,
+		postBuild:   fastlyManifest.Scripts.PostBuild,
+		timeout:     timeout,
+		validator: ToolchainValidator{
+			Compilation:                   declaration.Compilation,
+			CompilationIntegrated:         declaration.CompilationIntegrated,
+			CompilationCommandRemediation: declaration.CompilationRemediation,
+			CompilationSkipVersion:        declaration.CompilationCommand == "" || (!declaration.CompilationIntegrated && declaration.CompilationConstraint == ""),
+			CompilationTargetCommand:      declaration.CompilationCommand,
+			CompilationTargetPattern:      compilationRegexp,
+			CompilationURL:                declaration.CompilationURL,
+			Constraints:                   constraints,
+			DefaultBuildCommand:           declaration.Build,
+			ErrLog:                        errlog,
+			FastlyManifestFile:            fastlyManifest,
+			Installer:                     declaration.Installer,
+			Manifest:                      declaration.Manifest,
+			ManifestRemediation:           declaration.ManifestRemediation,
+			Output:                        out,
+			SDK:                           declaration.SDK,
+			Toolchain:                     declaration.Toolchain,
+			ToolchainCommandRemediation:   declaration.ToolchainRemediation,
+			ToolchainLanguage:             name,
+			ToolchainSkipVersion:          declaration.ToolchainVersionCommand == "" || declaration.ToolchainConstraint == "",
+			ToolchainURL:                  declaration.ToolchainURL,
+			ToolchainVersionCommand:       declaration.ToolchainVersionCommand,
+			ToolchainVersionPattern:       toolchainPattern,
+		},
+	}, nil
+}
+
+// Custom implements a Toolchain for a language declared in the [toolchains]
+// section of the fastly.toml manifest or the CLI's config.toml.
+type Custom struct {
+	Shell
+
+	// declaration is the [toolchains.<language>] configuration.
+	declaration manifest.Toolchain
+	// errlog is an abstraction for recording errors to disk.
+	errlog fsterr.LogInterface
+	// postBuild is a custom script executed after the build but before the Wasm
+	// binary is added to the .tar.gz archive.
+	postBuild string
+	// timeout is the build execution threshold.
+	timeout int
+	// validator is an abstraction to validate required resources are installed.
+	validator ToolchainValidator
+}
+
+// Initialize handles any non-build related set-up.
+func (c Custom) Initialize(_ io.Writer) error {
+	return nil
+}
+
+// Verify ensures the user's environment has all the required resources/tools.
+//
+// NOTE: Unlike the supported languages, only the declared checks are run and
+// the fastly.toml isn't patched with the declared build command, as it's used
+// whenever [scripts.build] is missing.
+func (c *Custom) Verify(_ io.Writer) error {
+	tv := c.validator
+	if err := tv.toolchain(); err != nil {
+		return err
+	}
+	if tv.Manifest != "" {
+		if err := tv.manifestFile(); err != nil {
+			return err
+		}
+		if tv.SDK != "" {
+			if err := tv.sdk(); err != nil {
+				return err
+			}
+		}
+	}
+	if err := tv.installDependencies(); err != nil {
+		return err
+	}
+	if tv.Compilation != "" && (!tv.CompilationIntegrated || tv.CompilationTargetCommand != "") {
+		if err := tv.compilation(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ToolchainVersion returns the version of the toolchain.
+func (c *Custom) ToolchainVersion() (string, error) {
+	if c.validator.ToolchainVersionCommand == "" {
+		return "", fmt.Errorf("no toolchain_version_command declared for %s", c.validator.ToolchainLanguage)
+	}
+	return c.validator.version()
+}
+
+// Build compiles the user's source code into a Wasm binary.
+func (c *Custom) Build(out io.Writer, progress text.Progress, verbose bool, callback func() error) error {
+	script := c.validator.FastlyManifestFile.Scripts.Build
+	if script == "" {
+		script = c.declaration.Build
+	}
+	if script == "" {
+		return fsterr.RemediationError{
+			Inner:       fmt.Errorf("no build command found for %s", c.validator.ToolchainLanguage),
+			Remediation: fmt.Sprintf("Add a [scripts.build] to the fastly.toml manifest, or a 'build' command to the [toolchains.%s] declaration.", c.validator.ToolchainLanguage),
+		}
+	}
+
+	return build(buildOpts{
+		buildScript: script,
+		buildFn:     c.Shell.Build,
+		errlog:      c.errlog,
+		postBuild:   c.postBuild,
+		timeout:     c.timeout,
+	}, out, progress, verbose, nil, callback)
+}
@@ -1,8 +1,11 @@
 package compute
 
 import (
+	"fmt"
 	"io"
+	"os"
 	"regexp"
+	"strings"
 
 	"github.com/fastly/cli/pkg/config"
 	fsterr "github.com/fastly/cli/pkg/errors"
@@ -132,6 +135,33 @@ func (g *Go) Verify(_ io.Writer) error {
 	return g.validator.Validate()
 }
 
+// ToolchainVersion returns the version of the toolchain.
+func (g *Go) ToolchainVersion() (string, error) {
+	return g.validator.version()
+}
+
+// SDKVersion returns the version of the SDK module required by the go.mod.
+func (g *Go) SDKVersion() (string, error) {
+	data, err := os.ReadFile(GoManifest)
+	if err != nil {
+		return "", fmt.Errorf("error reading %s: %w", GoManifest, err)
+	}
+
+	// NOTE: The requirement is either a single line (e.g. `require
+	// github.com/fastly/compute-sdk-go v0.1.1`) or a line within a require block.
+	module := "github.com/" + GoSDK
+	for _, line := range strings.Split(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) > 0 && fields[0] == "require" {
+			fields = fields[1:]
+		}
+		if len(fields) >= 2 && fields[0] == module {
+			return strings.TrimPrefix(fields[1], "v"), nil
+		}
+	}
+	return "", fmt.Errorf("the '%s' module wasn't found in the %s", module, GoManifest)
+}
+
 // Build compiles the user's source code into a Wasm binary.
 func (g *Go) Build(out io.Writer, progress text.Progress, verbose bool, callback func() error) error {
 	// NOTE: We deliberately reference the validator pointer to the fastly.toml
@@ -4,7 +4,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"os"
+	"os/exec"
 	"path/filepath"
+	"strings"
 
 	fsterr "github.com/fastly/cli/pkg/errors"
 	"github.com/fastly/cli/pkg/manifest"
@@ -126,6 +129,58 @@ func (j JavaScript) Verify(_ io.Writer) error {
 	return j.validator.Validate()
 }
 
+// ToolchainVersion returns the version of the toolchain.
+//
+// NOTE: The js-compute-runtime compiler is provided by the SDK package.
+func (j JavaScript) ToolchainVersion() (string, error) {
+	return jsToolchainVersion(JsSDK)
+}
+
+// SDKVersion returns the version of the SDK package installed by npm.
+func (j JavaScript) SDKVersion() (string, error) {
+	return jsPackageVersion(JsSDK)
+}
+
+// jsToolchainVersion returns the versions of Node.js and of the npm package
+// providing the compiler, which is installed per project rather than globally.
+func jsToolchainVersion(compiler string) (string, error) {
+	cmd := exec.Command("node", "--version")
+	node, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to execute command 'node --version': %w", err)
+	}
+	version, err := jsPackageVersion(compiler)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("node %s, %s %s", strings.TrimSpace(string(node)), compiler, version), nil
+}
+
+// jsPackageVersion returns the version of the named package installed by npm.
+func jsPackageVersion(name string) (string, error) {
+	path := filepath.Join("node_modules", filepath.FromSlash(name), JsManifest)
+
+	// gosec flagged this:
+	// G304 (CWE-22): Potential file inclusion via variable.
+	// Disabling as we need to load the package.json from the user's file system.
+	/* #nosec */
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("error reading %s: %w", path, err)
+	}
+
+	var p struct {
+		Version string `json:"version"`
+	}
+	if err := json.Unmarshal(data, &p); err != nil {
+		return "", fmt.Errorf("error parsing %s: %w", path, err)
+	}
+	if p.Version == "" {
+		return "", fmt.Errorf("no version found in %s", path)
+	}
+	return p.Version, nil
+}
+
 // Build compiles the user's source code into a Wasm binary.
 func (j JavaScript) Build(out io.Writer, progress text.Progress, verbose bool, callback func() error) error {
 	// NOTE: We deliberately reference the validator pointer to the fastly.toml
@@ -161,6 +161,25 @@ func (r *Rust) Verify(_ io.Writer) error {
 	return r.validator.Validate()
 }
 
+// ToolchainVersion returns the version of the toolchain.
+func (r *Rust) ToolchainVersion() (string, error) {
+	return r.validator.version()
+}
+
+// SDKVersion returns the version of the SDK crate resolved by Cargo.
+func (r *Rust) SDKVersion() (string, error) {
+	var metadata CargoMetadata
+	if err := metadata.Read(r.errlog); err != nil {
+		return "", fmt.Errorf("error reading cargo metadata: %w", err)
+	}
+	for _, p := range metadata.Package {
+		if p.Name == RustSDK {
+			return p.Version, nil
+		}
+	}
+	return "", fmt.Errorf("the '%s' crate wasn't found in the cargo metadata", RustSDK)
+}
+
 // Build compiles the user's source code into a Wasm binary.
 func (r *Rust) Build(out io.Writer, progress text.Progress, verbose bool, callback func() error) error {
 	// NOTE: We deliberately reference the validator pointer to the fastly.toml
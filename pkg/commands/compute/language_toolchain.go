@@ -257,6 +257,23 @@ func (tv ToolchainValidator) toolchainVersion() error {
 	return nil
 }
 
+// version returns the output of the toolchain version command, which is used
+// to identify the toolchain a package was built with.
+func (tv ToolchainValidator) version() (string, error) {
+	args := strings.Split(tv.ToolchainVersionCommand, " ")
+
+	// gosec flagged this:
+	// G204 (CWE-78): Subprocess launched with function call as argument or cmd arguments
+	// Disabling as we trust the source of the variable.
+	/* #nosec */
+	cmd := exec.Command(args[0], args[1:]...)
+	stdoutStderr, err := cmd.CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("failed to execute command '%s': %w", tv.ToolchainVersionCommand, err)
+	}
+	return strings.TrimSpace(string(stdoutStderr)), nil
+}
+
 // manifestFile validates the language manifestFile can be found.
 func (tv ToolchainValidator) manifestFile() error {
 	fmt.Fprintf(tv.Output, "\nChecking if manifest '%s' exists...\n", tv.Manifest)
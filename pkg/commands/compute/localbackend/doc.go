@@ -0,0 +1,3 @@
+// Package localbackend contains in-process HTTP servers that stand in for the
+// [local_server.backends] defined within the fastly.toml manifest file.
+package localbackend
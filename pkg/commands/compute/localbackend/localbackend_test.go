@@ -0,0 +1,280 @@
+package localbackend_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/fastly/cli/pkg/commands/compute/localbackend"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/testutil"
+)
+
+func TestStub(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	bodyFile := write("body.json", `{"ok":true}`)
+	write("static/index.html", "<h1>home</h1>")
+	write("static/css/site.css", "body {}")
+	write("recordings/0001.json", `{"request":{"method":"GET","uri":"/api?id=1"},"response":{"status":201,"headers":{"X-Seq":["1"]},"body":"first"}}`)
+	write("recordings/0002.json", `{"request":{"method":"GET","uri":"/api?id=1"},"response":{"status":200,"body_base64":"c2Vjb25k"}}`)
+
+	scenarios := []struct {
+		name       string
+		cfg        manifest.LocalBackendStub
+		path       string
+		wantStatus int
+		wantBody   string
+		wantHeader [2]string
+		wantError  string
+	}{
+		{
+			name:       "default response",
+			path:       "/anything",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "body with status and headers",
+			cfg:        manifest.LocalBackendStub{Body: "unavailable", Status: 503, Headers: map[string]string{"Retry-After": "10"}},
+			path:       "/",
+			wantStatus: http.StatusServiceUnavailable,
+			wantBody:   "unavailable",
+			wantHeader: [2]string{"Retry-After", "10"},
+		},
+		{
+			name:       "file",
+			cfg:        manifest.LocalBackendStub{File: bodyFile, Headers: map[string]string{"Content-Type": "application/json"}},
+			path:       "/",
+			wantStatus: http.StatusOK,
+			wantBody:   `{"ok":true}`,
+			wantHeader: [2]string{"Content-Type", "application/json"},
+		},
+		{
+			name:       "static directory index",
+			cfg:        manifest.LocalBackendStub{Dir: filepath.Join(dir, "static")},
+			path:       "/",
+			wantStatus: http.StatusOK,
+			wantBody:   "<h1>home</h1>",
+		},
+		{
+			name:       "static directory file",
+			cfg:        manifest.LocalBackendStub{Dir: filepath.Join(dir, "static")},
+			path:       "/css/site.css",
+			wantStatus: http.StatusOK,
+			wantBody:   "body {}",
+		},
+		{
+			name:       "static directory without a match",
+			cfg:        manifest.LocalBackendStub{Dir: filepath.Join(dir, "static")},
+			path:       "/missing.js",
+			wantStatus: http.StatusNotFound,
+			wantBody:   "no stub response for GET /missing.js\n",
+		},
+		{
+			name:       "static directory falls back to body",
+			cfg:        manifest.LocalBackendStub{Dir: filepath.Join(dir, "static"), Body: "fallback"},
+			path:       "/missing.js",
+			wantStatus: http.StatusOK,
+			wantBody:   "fallback",
+		},
+		{
+			name:       "recording",
+			cfg:        manifest.LocalBackendStub{Recordings: filepath.Join(dir, "recordings")},
+			path:       "/api?id=1",
+			wantStatus: http.StatusCreated,
+			wantBody:   "first",
+			wantHeader: [2]string{"X-Seq", "1"},
+		},
+		{
+			name:      "body and file",
+			cfg:       manifest.LocalBackendStub{Body: "a", File: bodyFile},
+			wantError: "only one of 'body' or 'file' can be set",
+		},
+		{
+			name:      "missing file",
+			cfg:       manifest.LocalBackendStub{File: filepath.Join(dir, "missing")},
+			wantError: "error reading 'file'",
+		},
+		{
+			name:      "missing recordings",
+			cfg:       manifest.LocalBackendStub{Recordings: filepath.Join(dir, "missing")},
+			wantError: "error reading recordings",
+		},
+	}
+	for _, testcase := range scenarios {
+		t.Run(testcase.name, func(t *testing.T) {
+			stub, err := localbackend.NewStub(testcase.cfg)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			if err != nil {
+				return
+			}
+
+			s, err := localbackend.Start(stub)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer s.Close()
+
+			status, header, body := get(t, s.URL+testcase.path)
+			if status != testcase.wantStatus {
+				t.Fatalf("want status %d, have %d", testcase.wantStatus, status)
+			}
+			testutil.AssertString(t, testcase.wantBody, body)
+			if k := testcase.wantHeader[0]; k != "" {
+				testutil.AssertString(t, testcase.wantHeader[1], header.Get(k))
+			}
+		})
+	}
+}
+
+// TestStubRecordingsSequence validates that a request recorded more than once
+// is replayed in order, with the last response being repeated.
+func TestStubRecordingsSequence(t *testing.T) {
+	dir := t.TempDir()
+	for name, content := range map[string]string{
+		"0001.json": `{"request":{"method":"GET","uri":"/"},"response":{"body":"first"}}`,
+		"0002.json": `{"request":{"method":"GET","uri":"/"},"response":{"body":"second"}}`,
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	stub, err := localbackend.NewStub(manifest.LocalBackendStub{Recordings: dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := localbackend.Start(stub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	for _, want := range []string{"first", "second", "second"} {
+		_, _, body := get(t, s.URL)
+		testutil.AssertString(t, want, body)
+	}
+}
+
+func get(t *testing.T, url string) (int, http.Header, string) {
+	t.Helper()
+	resp, err := http.Get(url) // #nosec G107
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp.StatusCode, resp.Header, string(body)
+}
+
+// TestRecorder validates that responses proxied by a Recorder are replayed by
+// a Stub configured with the recordings.
+func TestRecorder(t *testing.T) {
+	var hosts []string
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hosts = append(hosts, r.Host)
+		w.Header().Set("X-Path", r.URL.Path)
+		switch r.URL.Path {
+		case "/binary":
+			_, _ = w.Write([]byte{0xff, 0xfe, 0x00})
+		case "/missing":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			_, _ = w.Write([]byte("hello " + r.URL.RawQuery))
+		}
+	}))
+	defer backend.Close()
+
+	dir := filepath.Join(t.TempDir(), "origin")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	stale := filepath.Join(dir, "000099.json")
+	if err := os.WriteFile(stale, []byte("{}"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	rec, err := localbackend.NewRecorder(localbackend.RecorderConfig{
+		URL:          backend.URL,
+		OverrideHost: "example.com",
+		Dir:          dir,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(stale); !os.IsNotExist(err) {
+		t.Fatalf("want previous recording removed, have %v", err)
+	}
+
+	s, err := localbackend.Start(rec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	paths := []string{"/greet?name=a", "/greet?name=b", "/binary", "/missing"}
+	want := make(map[string]string)
+	for _, p := range paths {
+		_, _, body := get(t, s.URL+p)
+		want[p] = body
+	}
+	s.Close()
+
+	for _, h := range hosts {
+		testutil.AssertString(t, "example.com", h)
+	}
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != len(paths) {
+		t.Fatalf("want %d recordings, have %d", len(paths), len(files))
+	}
+
+	stub, err := localbackend.NewStub(manifest.LocalBackendStub{Recordings: dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err = localbackend.Start(stub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	for _, p := range paths {
+		status, header, body := get(t, s.URL+p)
+		testutil.AssertString(t, want[p], body)
+		testutil.AssertString(t, strings.SplitN(p, "?", 2)[0], header.Get("X-Path"))
+		if p == "/missing" && status != http.StatusNotFound {
+			t.Fatalf("want status %d, have %d", http.StatusNotFound, status)
+		}
+	}
+
+	status, _, _ := get(t, s.URL+"/greet?name=c")
+	if status != http.StatusNotFound {
+		t.Fatalf("want status %d for an unrecorded request, have %d", http.StatusNotFound, status)
+	}
+}
+
+func TestRecorderInvalidURL(t *testing.T) {
+	_, err := localbackend.NewRecorder(localbackend.RecorderConfig{
+		URL: "example.com",
+		Dir: t.TempDir(),
+	})
+	testutil.AssertErrorContains(t, err, "is not an absolute http(s) URL")
+}
@@ -0,0 +1,145 @@
+package localbackend
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"unicode/utf8"
+)
+
+// Recorder proxies backend requests to a URL and saves each request/response
+// pair to a directory, in a format that ReadRecordings can serve back.
+type Recorder struct {
+	dir   string
+	mu    sync.Mutex
+	proxy *httputil.ReverseProxy
+	seq   int
+}
+
+// RecorderConfig describes the backend a Recorder proxies to.
+type RecorderConfig struct {
+	// URL is the backend address requests are proxied to.
+	URL string
+	// OverrideHost, when set, is used as the Host header of proxied requests.
+	OverrideHost string
+	// CertHost, when set, is the hostname the backend certificate is verified
+	// against.
+	CertHost string
+	// Dir is the directory recordings are written to.
+	Dir string
+}
+
+// NewRecorder validates the backend URL and prepares the recordings directory.
+//
+// NOTE: Any recordings already within the directory are removed so that a
+// replay reflects a single recording session.
+func NewRecorder(cfg RecorderConfig) (*Recorder, error) {
+	target, err := url.Parse(cfg.URL)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing 'url': %w", err)
+	}
+	if target.Scheme != "http" && target.Scheme != "https" || target.Host == "" {
+		return nil, fmt.Errorf("error parsing 'url': %s is not an absolute http(s) URL", cfg.URL)
+	}
+
+	if err := os.MkdirAll(cfg.Dir, 0o750); err != nil {
+		return nil, fmt.Errorf("error creating recordings directory: %w", err)
+	}
+	previous, err := filepath.Glob(filepath.Join(cfg.Dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range previous {
+		if err := os.Remove(file); err != nil {
+			return nil, fmt.Errorf("error removing previous recording: %w", err)
+		}
+	}
+
+	rec := &Recorder{dir: cfg.Dir}
+
+	proxy := httputil.NewSingleHostReverseProxy(target)
+	director := proxy.Director
+	proxy.Director = func(r *http.Request) {
+		director(r)
+		if cfg.OverrideHost != "" {
+			r.Host = cfg.OverrideHost
+		}
+	}
+	if cfg.CertHost != "" {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = &tls.Config{
+			MinVersion: tls.VersionTLS12,
+			ServerName: cfg.CertHost,
+		}
+		proxy.Transport = transport
+	}
+	proxy.ModifyResponse = rec.record
+	rec.proxy = proxy
+
+	return rec, nil
+}
+
+// uriKey is the context key for the URI of the request received by the
+// Recorder, which is what a replayed request is matched against.
+type uriKey struct{}
+
+// ServeHTTP implements the http.Handler interface.
+func (rec *Recorder) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := context.WithValue(r.Context(), uriKey{}, r.URL.RequestURI())
+	rec.proxy.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// record saves the backend response, restoring its body for the client.
+func (rec *Recorder) record(resp *http.Response) error {
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return fmt.Errorf("error reading backend response: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	uri, _ := resp.Request.Context().Value(uriKey{}).(string)
+	r := Recording{
+		Request: RecordedRequest{
+			Method: resp.Request.Method,
+			URI:    uri,
+		},
+		Response: RecordedResponse{
+			Status:  resp.StatusCode,
+			Headers: resp.Header.Clone(),
+		},
+	}
+	if utf8.Valid(body) {
+		r.Response.Body = string(body)
+	} else {
+		r.Response.BodyBase64 = base64.StdEncoding.EncodeToString(body)
+	}
+	// NOTE: The length of a replayed body is determined when it's written.
+	r.Response.Headers.Del("Content-Length")
+
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	rec.seq++
+	// NOTE: The zero-padded sequence number keeps the lexical order that
+	// ReadRecordings relies on the same as the order responses were received.
+	file := filepath.Join(rec.dir, fmt.Sprintf("%06d.json", rec.seq))
+	if err := os.WriteFile(file, data, 0o600); err != nil {
+		return fmt.Errorf("error writing recording: %w", err)
+	}
+	return nil
+}
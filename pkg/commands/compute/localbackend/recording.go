@@ -0,0 +1,132 @@
+package localbackend
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Recording is a backend request/response pair saved to disk.
+type Recording struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest identifies the request a recorded response is served for.
+type RecordedRequest struct {
+	Method string `json:"method"`
+	URI    string `json:"uri"`
+}
+
+// RecordedResponse is a backend response saved to disk.
+//
+// NOTE: Only one of Body or BodyBase64 is set, the latter being used for
+// bodies that aren't valid UTF-8 so that a recording remains human-editable
+// whenever possible.
+type RecordedResponse struct {
+	Status     int         `json:"status"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       string      `json:"body,omitempty"`
+	BodyBase64 string      `json:"body_base64,omitempty"`
+}
+
+// Write sends the recorded response.
+func (r RecordedResponse) Write(w http.ResponseWriter) error {
+	body := []byte(r.Body)
+	if r.BodyBase64 != "" {
+		var err error
+		body, err = base64.StdEncoding.DecodeString(r.BodyBase64)
+		if err != nil {
+			return fmt.Errorf("error decoding recorded body: %w", err)
+		}
+	}
+
+	for k, values := range r.Headers {
+		for _, v := range values {
+			w.Header().Add(k, v)
+		}
+	}
+	status := r.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	w.WriteHeader(status)
+	_, err := w.Write(body)
+	return err
+}
+
+// Recordings are the recorded responses read from a directory.
+//
+// When the same request was recorded more than once, the responses are served
+// in the order they were recorded, with the last response being repeated.
+type Recordings struct {
+	mu        sync.Mutex
+	responses map[string][]RecordedResponse
+	served    map[string]int
+}
+
+// ReadRecordings reads the recordings (*.json) within dir in lexical order.
+func ReadRecordings(dir string) (*Recordings, error) {
+	if _, err := os.Stat(dir); err != nil {
+		return nil, fmt.Errorf("error reading recordings: %w", err)
+	}
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+
+	rs := &Recordings{
+		responses: make(map[string][]RecordedResponse),
+		served:    make(map[string]int),
+	}
+	for _, file := range files {
+		// gosec flagged this:
+		// G304 (CWE-22): Potential file inclusion via variable
+		//
+		// Disabling as the directory is chosen by the user.
+		/* #nosec */
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("error reading recording: %w", err)
+		}
+		var r Recording
+		if err := json.Unmarshal(data, &r); err != nil {
+			return nil, fmt.Errorf("error parsing recording '%s': %w", file, err)
+		}
+		k := key(r.Request.Method, r.Request.URI)
+		rs.responses[k] = append(rs.responses[k], r.Response)
+	}
+
+	return rs, nil
+}
+
+// Match returns the next recorded response for the request.
+func (rs *Recordings) Match(r *http.Request) (RecordedResponse, bool) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	k := key(r.Method, r.URL.RequestURI())
+	responses, ok := rs.responses[k]
+	if !ok {
+		return RecordedResponse{}, false
+	}
+
+	i := rs.served[k]
+	if i >= len(responses) {
+		i = len(responses) - 1
+	}
+	rs.served[k]++
+	return responses[i], true
+}
+
+// key identifies a request by its method and URI (path and query).
+func key(method, uri string) string {
+	return strings.ToUpper(method) + " " + uri
+}
@@ -0,0 +1,46 @@
+package localbackend
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+)
+
+// Server is an in-process HTTP server that stands in for a local backend.
+type Server struct {
+	// URL is the address to hand to Viceroy in place of the backend URL.
+	URL string
+
+	server *http.Server
+}
+
+// Start serves the handler on a random local port.
+func Start(h http.Handler) (*Server, error) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, fmt.Errorf("error starting local backend: %w", err)
+	}
+
+	s := &Server{
+		URL: "http://" + ln.Addr().String(),
+		server: &http.Server{
+			Handler:           h,
+			ReadHeaderTimeout: 10 * time.Second,
+		},
+	}
+
+	go func() {
+		// NOTE: Serve always returns an error, which is http.ErrServerClosed once
+		// Close is called. Any other error surfaces to the user as a failed
+		// backend request within Viceroy.
+		_ = s.server.Serve(ln)
+	}()
+
+	return s, nil
+}
+
+// Close stops the server.
+func (s *Server) Close() error {
+	return s.server.Close()
+}
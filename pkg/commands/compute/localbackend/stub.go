@@ -0,0 +1,133 @@
+package localbackend
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/fastly/cli/pkg/manifest"
+)
+
+// Stub responds to backend requests using a [local_server.backends.<T>.stub]
+// configuration.
+type Stub struct {
+	body       []byte
+	dir        string
+	headers    map[string]string
+	recordings *Recordings
+	respond    bool
+	status     int
+}
+
+// NewStub validates the stub configuration and reads any files it references.
+//
+// NOTE: Relative paths are resolved against the current working directory,
+// which is the project root when running `compute serve`.
+func NewStub(cfg manifest.LocalBackendStub) (*Stub, error) {
+	if cfg.Body != "" && cfg.File != "" {
+		return nil, fmt.Errorf("only one of 'body' or 'file' can be set")
+	}
+
+	s := &Stub{
+		body:    []byte(cfg.Body),
+		headers: cfg.Headers,
+		respond: cfg.Body != "" || cfg.File != "" || cfg.Status > 0 || (cfg.Dir == "" && cfg.Recordings == ""),
+		status:  cfg.Status,
+	}
+	if s.status == 0 {
+		s.status = http.StatusOK
+	}
+
+	if cfg.File != "" {
+		// gosec flagged this:
+		// G304 (CWE-22): Potential file inclusion via variable
+		//
+		// Disabling as the file is chosen by the user.
+		/* #nosec */
+		body, err := os.ReadFile(cfg.File)
+		if err != nil {
+			return nil, fmt.Errorf("error reading 'file': %w", err)
+		}
+		s.body = body
+	}
+
+	if cfg.Dir != "" {
+		fi, err := os.Stat(cfg.Dir)
+		if err != nil {
+			return nil, fmt.Errorf("error reading 'dir': %w", err)
+		}
+		if !fi.IsDir() {
+			return nil, fmt.Errorf("error reading 'dir': %s is not a directory", cfg.Dir)
+		}
+		s.dir = cfg.Dir
+	}
+
+	if cfg.Recordings != "" {
+		rs, err := ReadRecordings(cfg.Recordings)
+		if err != nil {
+			return nil, err
+		}
+		s.recordings = rs
+	}
+
+	return s, nil
+}
+
+// ServeHTTP implements the http.Handler interface.
+//
+// Recorded responses are tried first, then static files within the stub's
+// directory, and finally the stub's own response. A request that matches none
+// of these receives a 404.
+func (s *Stub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if s.recordings != nil {
+		if resp, ok := s.recordings.Match(r); ok {
+			if err := resp.Write(w); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+			}
+			return
+		}
+	}
+
+	if s.dir != "" {
+		if file, ok := s.staticFile(r.URL.Path); ok {
+			s.setHeaders(w)
+			http.ServeFile(w, r, file)
+			return
+		}
+	}
+
+	if s.respond {
+		s.setHeaders(w)
+		w.WriteHeader(s.status)
+		_, _ = w.Write(s.body)
+		return
+	}
+
+	http.Error(w, fmt.Sprintf("no stub response for %s %s", r.Method, r.URL.RequestURI()), http.StatusNotFound)
+}
+
+// staticFile returns the file within the stub's directory for the request
+// path, using index.html for directories.
+func (s *Stub) staticFile(urlPath string) (string, bool) {
+	// NOTE: Cleaning a rooted path removes any '..' elements, so the resulting
+	// path can't escape the stub's directory.
+	file := filepath.Join(s.dir, filepath.FromSlash(path.Clean("/"+urlPath)))
+	fi, err := os.Stat(file)
+	if err == nil && fi.IsDir() {
+		file = filepath.Join(file, "index.html")
+		fi, err = os.Stat(file)
+	}
+	if err != nil || fi.IsDir() {
+		return "", false
+	}
+	return file, true
+}
+
+// setHeaders sets the stub's headers on the response.
+func (s *Stub) setHeaders(w http.ResponseWriter) {
+	for k, v := range s.headers {
+		w.Header().Set(k, v)
+	}
+}
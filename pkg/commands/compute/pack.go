@@ -12,7 +12,6 @@ import (
 	"github.com/fastly/cli/pkg/filesystem"
 	"github.com/fastly/cli/pkg/manifest"
 	"github.com/fastly/cli/pkg/text"
-	"github.com/mholt/archiver/v3"
 )
 
 // PackCommand takes a .wasm and builds the required tar/gzip package ready to be uploaded.
@@ -102,13 +101,14 @@ func (c *PackCommand) Exec(_ io.Reader, out io.Writer) (err error) {
 	}
 
 	progress.Step("Creating .tar.gz file...")
-	tar := archiver.NewTarGz()
-	tar.OverwriteExisting = true
 	{
 		dir := "pkg/package"
-		src := []string{dir}
 		dst := fmt.Sprintf("%s.tar.gz", dir)
-		if err = tar.Archive(src, dst); err != nil {
+		files := []archiveFile{
+			{name: "package/bin/main.wasm", src: bin},
+			{name: "package/" + manifest.Filename, src: filepath.Join(dir, manifest.Filename)},
+		}
+		if err = writePackageArchive(files, dst); err != nil {
 			c.Globals.ErrLog.AddWithContext(err, map[string]any{
 				"Tar source":      dir,
 				"Tar destination": dst,
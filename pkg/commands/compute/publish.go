@@ -17,6 +17,8 @@ type PublishCommand struct {
 	deploy   *DeployCommand
 
 	// Build fields
+	env              cmd.OptionalString
+	cache            cmd.OptionalBool
 	includeSrc       cmd.OptionalBool
 	lang             cmd.OptionalString
 	skipVerification cmd.OptionalBool
@@ -25,9 +27,12 @@ type PublishCommand struct {
 	// Deploy fields
 	comment        cmd.OptionalString
 	domain         cmd.OptionalString
+	dryRun         cmd.OptionalBool
 	pkg            cmd.OptionalString
 	serviceName    cmd.OptionalServiceNameID
 	serviceVersion cmd.OptionalServiceVersion
+	skipABI        cmd.OptionalBool
+	syncSetup      cmd.OptionalBool
 }
 
 // NewPublishCommand returns a usable command registered under the parent.
@@ -39,8 +44,11 @@ func NewPublishCommand(parent cmd.Registerer, globals *config.Data, build *Build
 	c.deploy = deploy
 	c.CmdClause = parent.Command("publish", "Build and deploy a Compute@Edge package to a Fastly service")
 
+	c.CmdClause.Flag("cache", "Reuse the previous build when no changes were detected (use --no-cache to force a rebuild)").Default("true").Action(c.cache.Set).NegatableBoolVar(&c.cache.Value)
 	c.CmdClause.Flag("comment", "Human-readable comment").Action(c.comment.Set).StringVar(&c.comment.Value)
 	c.CmdClause.Flag("domain", "The name of the domain associated to the package").Action(c.domain.Set).StringVar(&c.domain.Value)
+	c.CmdClause.Flag("dry-run", "Build the package and display the deployment plan without making any changes").Action(c.dryRun.Set).BoolVar(&c.dryRun.Value)
+	c.CmdClause.Flag("env", flagEnvDesc).Action(c.env.Set).StringVar(&c.env.Value)
 	c.CmdClause.Flag("include-source", "Include source code in built package").Action(c.includeSrc.Set).BoolVar(&c.includeSrc.Value)
 	c.CmdClause.Flag("language", "Language type").Action(c.lang.Set).StringVar(&c.lang.Value)
 	c.CmdClause.Flag("package", "Path to a package tar.gz").Short('p').Action(c.pkg.Set).StringVar(&c.pkg.Value)
@@ -62,8 +70,11 @@ func NewPublishCommand(parent cmd.Registerer, globals *config.Data, build *Build
 		Dst:         &c.serviceVersion.Value,
 		Action:      c.serviceVersion.Set,
 	})
+	c.CmdClause.Flag("skip-abi", flagSkipABIDesc).Action(c.skipABI.Set).BoolVar(&c.skipABI.Value)
 	c.CmdClause.Flag("skip-verification", "Skip verification steps and force build").Action(c.skipVerification.Set).BoolVar(&c.skipVerification.Value)
+	c.CmdClause.Flag("sync-setup", "Create [setup] resources missing from an existing service, and report those that differ or are no longer defined").Action(c.syncSetup.Set).BoolVar(&c.syncSetup.Value)
 	c.CmdClause.Flag("timeout", "Timeout, in seconds, for the build compilation step").Action(c.timeout.Set).IntVar(&c.timeout.Value)
+	c.RegisterOutputFlags()
 
 	return &c
 }
@@ -76,7 +87,17 @@ func NewPublishCommand(parent cmd.Registerer, globals *config.Data, build *Build
 // non-deterministic ways. It's best to leave those nested commands to handle
 // the progress indicator.
 func (c *PublishCommand) Exec(in io.Reader, out io.Writer) (err error) {
+	if err := checkOutputFlags(&c.Base, c.dryRun.Value); err != nil {
+		return err
+	}
+
 	// Reset the fields on the BuildCommand based on PublishCommand values.
+	if c.cache.WasSet {
+		c.build.Flags.NoCache = !c.cache.Value
+	}
+	if c.env.WasSet {
+		c.build.Flags.Env = c.env.Value
+	}
 	if c.includeSrc.WasSet {
 		c.build.Flags.IncludeSrc = c.includeSrc.Value
 	}
@@ -91,13 +112,20 @@ func (c *PublishCommand) Exec(in io.Reader, out io.Writer) (err error) {
 	}
 	c.build.Manifest = c.manifest
 
-	err = c.build.Exec(in, out)
+	// NOTE: When the deployment plan is rendered as structured output we
+	// discard the build output so the plan can be consumed by other tools.
+	buildOut := out
+	if c.StructuredOutput() {
+		buildOut = io.Discard
+	}
+
+	err = c.build.Exec(in, buildOut)
 	if err != nil {
 		c.Globals.ErrLog.Add(err)
 		return err
 	}
 
-	text.Break(out)
+	text.Break(buildOut)
 
 	// Reset the fields on the DeployCommand based on PublishCommand values.
 	if c.pkg.WasSet {
@@ -115,7 +143,20 @@ func (c *PublishCommand) Exec(in io.Reader, out io.Writer) (err error) {
 	if c.comment.WasSet {
 		c.deploy.Comment = c.comment
 	}
+	if c.dryRun.WasSet {
+		c.deploy.DryRun = c.dryRun.Value
+	}
+	if c.env.WasSet {
+		c.deploy.Env = c.env.Value
+	}
+	if c.skipABI.WasSet {
+		c.deploy.SkipABI = c.skipABI.Value
+	}
+	if c.syncSetup.WasSet {
+		c.deploy.SyncSetup = c.syncSetup.Value
+	}
 	c.deploy.Manifest = c.manifest
+	c.deploy.CopyOutputFlags(&c.Base)
 
 	err = c.deploy.Exec(in, out)
 	if err != nil {
@@ -0,0 +1,211 @@
+package compute
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	fsterr "github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v6/fastly"
+)
+
+// RollbackCommand activates a previously deployed Compute@Edge package.
+type RollbackCommand struct {
+	cmd.Base
+
+	Env         string
+	Manifest    manifest.Data
+	ServiceName cmd.OptionalServiceNameID
+	To          cmd.OptionalInt
+}
+
+// NewRollbackCommand returns a usable command registered under the parent.
+func NewRollbackCommand(parent cmd.Registerer, globals *config.Data, data manifest.Data) *RollbackCommand {
+	var c RollbackCommand
+	c.Globals = globals
+	c.Manifest = data
+	c.CmdClause = parent.Command("rollback", "Activate the package of a previously active service version")
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Name:        cmd.FlagServiceIDName,
+		Description: cmd.FlagServiceIDDesc,
+		Dst:         &c.Manifest.Flag.ServiceID,
+		Short:       's',
+	})
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Action:      c.ServiceName.Set,
+		Name:        cmd.FlagServiceName,
+		Description: cmd.FlagServiceDesc,
+		Dst:         &c.ServiceName.Value,
+	})
+	c.CmdClause.Flag("env", flagEnvDesc).StringVar(&c.Env)
+	c.CmdClause.Flag("to", "The service version to roll back to (defaults to the most recent previously active version)").Action(c.To.Set).IntVar(&c.To.Value)
+	return &c
+}
+
+// Exec implements the command interface.
+func (c *RollbackCommand) Exec(in io.Reader, out io.Writer) error {
+	_, s := c.Globals.Token()
+	if s == config.SourceUndefined {
+		return fsterr.ErrNoToken
+	}
+
+	if err := applyEnvironment(&c.Manifest, c.Env); err != nil {
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+
+	serviceID, source, flag, err := cmd.ServiceID(c.ServiceName, c.Manifest, c.Globals.APIClient, c.Globals.ErrLog)
+	if err != nil {
+		return err
+	}
+	if c.Globals.Verbose() {
+		cmd.DisplayServiceID(serviceID, flag, source, out)
+	}
+
+	apiClient := c.Globals.APIClient
+	errLog := c.Globals.ErrLog
+
+	versions, err := apiClient.ListVersions(&fastly.ListVersionsInput{
+		ServiceID: serviceID,
+	})
+	if err != nil {
+		errLog.AddWithContext(err, map[string]any{
+			"Service ID": serviceID,
+		})
+		return fmt.Errorf("error listing service versions: %w", err)
+	}
+
+	target, pkg, err := rollbackTarget(apiClient, serviceID, versions, c.To)
+	if err != nil {
+		errLog.AddWithContext(err, map[string]any{
+			"Service ID": serviceID,
+			"To":         c.To.Value,
+		})
+		return err
+	}
+
+	text.Output(out, "%s %d", text.Bold("Version:"), target.Number)
+	text.Output(out, "%s %s", text.Bold("Package hashsum:"), pkg.Metadata.HashSum)
+	if target.Comment != "" {
+		text.Output(out, "%s %s", text.Bold("Comment:"), target.Comment)
+	}
+
+	if !c.Globals.Flag.AutoYes && !c.Globals.Flag.NonInteractive {
+		text.Break(out)
+		label := fmt.Sprintf("Activate version %d of service %s: [y/N] ", target.Number, serviceID)
+		answer, err := text.AskYesNo(out, text.BoldYellow(label), in)
+		if err != nil {
+			return err
+		}
+		if !answer {
+			return nil
+		}
+	}
+
+	text.Break(out)
+
+	progress := text.NewProgress(out, c.Globals.Verbose())
+	progress.Step("Activating version...")
+
+	_, err = apiClient.ActivateVersion(&fastly.ActivateVersionInput{
+		ServiceID:      serviceID,
+		ServiceVersion: target.Number,
+	})
+	if err != nil {
+		progress.Fail()
+		errLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": target.Number,
+		})
+		return fmt.Errorf("error activating version: %w", err)
+	}
+
+	progress.Done()
+
+	text.Break(out)
+
+	text.Description(out, "Manage this service at", fmt.Sprintf("%s%s", manageServiceBaseURL, serviceID))
+
+	displayDomain(apiClient, serviceID, target.Number, out)
+
+	text.Success(out, "Rolled back to version %d (service %s)", target.Number, serviceID)
+	return nil
+}
+
+// rollbackTarget returns the service version to roll back to, along with its
+// package. When the --to flag isn't set, the most recent version older than
+// the active version that was previously active (i.e. is locked) and whose
+// package differs from the active package is selected.
+func rollbackTarget(client api.Interface, serviceID string, versions []*fastly.Version, to cmd.OptionalInt) (*fastly.Version, *fastly.Package, error) {
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].Number > versions[j].Number
+	})
+
+	var active *fastly.Version
+	for _, v := range versions {
+		if v.Active {
+			active = v
+			break
+		}
+	}
+
+	if to.WasSet {
+		for _, v := range versions {
+			if v.Number != to.Value {
+				continue
+			}
+			if v.Active {
+				return nil, nil, fsterr.RemediationError{
+					Inner:       fmt.Errorf("version %d is already active", v.Number),
+					Remediation: "Provide a different version to the --to flag.",
+				}
+			}
+			p, err := client.GetPackage(&fastly.GetPackageInput{
+				ServiceID:      serviceID,
+				ServiceVersion: v.Number,
+			})
+			if err != nil {
+				return nil, nil, fmt.Errorf("error getting package for version %d: %w", v.Number, err)
+			}
+			return v, p, nil
+		}
+		return nil, nil, fsterr.RemediationError{
+			Inner:       fmt.Errorf("version %d not found", to.Value),
+			Remediation: "Run `fastly service-version list` to view the available versions.",
+		}
+	}
+
+	var activeHashSum string
+	if active != nil {
+		if p, err := client.GetPackage(&fastly.GetPackageInput{
+			ServiceID:      serviceID,
+			ServiceVersion: active.Number,
+		}); err == nil {
+			activeHashSum = p.Metadata.HashSum
+		}
+	}
+
+	for _, v := range versions {
+		if v.Active || !v.Locked || (active != nil && v.Number > active.Number) {
+			continue
+		}
+		p, err := client.GetPackage(&fastly.GetPackageInput{
+			ServiceID:      serviceID,
+			ServiceVersion: v.Number,
+		})
+		if err != nil || p.Metadata.HashSum == activeHashSum {
+			continue
+		}
+		return v, p, nil
+	}
+
+	return nil, nil, fsterr.RemediationError{
+		Inner:       fmt.Errorf("no previously active version with a different package was found"),
+		Remediation: "Use the --to flag to specify the version to roll back to.",
+	}
+}
@@ -0,0 +1,203 @@
+package compute_test
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v6/fastly"
+)
+
+func TestRollback(t *testing.T) {
+	args := testutil.Args
+	scenarios := []struct {
+		testutil.TestScenario
+		stdin          string
+		wantActivated  int
+		dontWantOutput []string
+	}{
+		{
+			TestScenario: testutil.TestScenario{
+				Name:      "validate missing --token flag",
+				Args:      args("compute rollback -s 123"),
+				WantError: "no token provided",
+			},
+		},
+		{
+			TestScenario: testutil.TestScenario{
+				Name:      "validate missing service ID",
+				Args:      args("compute rollback -t 123"),
+				WantError: "error reading service: no service ID found",
+			},
+		},
+		{
+			TestScenario: testutil.TestScenario{
+				Name: "validate ListVersions API error",
+				Args: args("compute rollback -s 123 -t 123"),
+				API: mock.API{
+					ListVersionsFn: testutil.ListVersionsError,
+				},
+				WantError: fmt.Sprintf("error listing service versions: %s", testutil.Err.Error()),
+			},
+		},
+		{
+			TestScenario: testutil.TestScenario{
+				Name: "success rolls back to most recent version with a different package",
+				Args: args("compute rollback -s 123 -t 123 --auto-yes"),
+				API: mock.API{
+					ListVersionsFn:    listRollbackVersions,
+					GetPackageFn:      getRollbackPackage,
+					ActivateVersionFn: activateVersionOk,
+					ListDomainsFn:     listDomainsOk,
+				},
+				WantOutputs: []string{
+					"Version: 2",
+					"Package hashsum: bbb",
+					"Comment: second release",
+					"Manage this service at:",
+					"https://manage.fastly.com/configure/services/123",
+					"View this service at:",
+					"https://directly-careful-coyote.edgecompute.app",
+					"Rolled back to version 2 (service 123)",
+				},
+			},
+			wantActivated: 2,
+		},
+		{
+			TestScenario: testutil.TestScenario{
+				Name: "success with --to flag",
+				Args: args("compute rollback -s 123 -t 123 --to 1 --auto-yes"),
+				API: mock.API{
+					ListVersionsFn:    listRollbackVersions,
+					GetPackageFn:      getRollbackPackage,
+					ActivateVersionFn: activateVersionOk,
+					ListDomainsFn:     listDomainsOk,
+				},
+				WantOutputs: []string{
+					"Version: 1",
+					"Package hashsum: aaa",
+					"Rolled back to version 1 (service 123)",
+				},
+			},
+			wantActivated: 1,
+		},
+		{
+			TestScenario: testutil.TestScenario{
+				Name: "validate --to flag with active version",
+				Args: args("compute rollback -s 123 -t 123 --to 4 --auto-yes"),
+				API: mock.API{
+					ListVersionsFn: listRollbackVersions,
+					GetPackageFn:   getRollbackPackage,
+				},
+				WantError: "version 4 is already active",
+			},
+		},
+		{
+			TestScenario: testutil.TestScenario{
+				Name: "validate --to flag with unknown version",
+				Args: args("compute rollback -s 123 -t 123 --to 9 --auto-yes"),
+				API: mock.API{
+					ListVersionsFn: listRollbackVersions,
+					GetPackageFn:   getRollbackPackage,
+				},
+				WantError: "version 9 not found",
+			},
+		},
+		{
+			TestScenario: testutil.TestScenario{
+				Name: "validate no previously active version",
+				Args: args("compute rollback -s 123 -t 123 --auto-yes"),
+				API: mock.API{
+					ListVersionsFn: testutil.ListVersions,
+					GetPackageFn:   getPackageOk,
+				},
+				WantError: "no previously active version with a different package was found",
+			},
+		},
+		{
+			TestScenario: testutil.TestScenario{
+				Name: "validate ActivateVersion API error",
+				Args: args("compute rollback -s 123 -t 123 --auto-yes"),
+				API: mock.API{
+					ListVersionsFn:    listRollbackVersions,
+					GetPackageFn:      getRollbackPackage,
+					ActivateVersionFn: activateVersionError,
+				},
+				WantError: fmt.Sprintf("error activating version: %s", testutil.Err.Error()),
+			},
+		},
+		{
+			TestScenario: testutil.TestScenario{
+				Name: "declined confirmation",
+				Args: args("compute rollback -s 123 -t 123"),
+				API: mock.API{
+					ListVersionsFn: listRollbackVersions,
+					GetPackageFn:   getRollbackPackage,
+				},
+				WantOutputs: []string{
+					"Version: 2",
+					"Activate version 2 of service 123: [y/N]",
+				},
+			},
+			stdin:          "N",
+			dontWantOutput: []string{"Rolled back"},
+		},
+	}
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var activated int
+			if fn := testcase.API.ActivateVersionFn; fn != nil {
+				testcase.API.ActivateVersionFn = func(i *fastly.ActivateVersionInput) (*fastly.Version, error) {
+					v, err := fn(i)
+					if err == nil {
+						activated = i.ServiceVersion
+					}
+					return v, err
+				}
+			}
+
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			opts.Stdin = strings.NewReader(testcase.stdin)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			for _, s := range testcase.WantOutputs {
+				testutil.AssertStringContains(t, stdout.String(), s)
+			}
+			for _, s := range testcase.dontWantOutput {
+				testutil.AssertStringDoesntContain(t, stdout.String(), s)
+			}
+			if testcase.wantActivated != activated {
+				t.Fatalf("want activated version %d, have %d", testcase.wantActivated, activated)
+			}
+		})
+	}
+}
+
+// listRollbackVersions returns a service whose active version (4) follows
+// three previously active versions, the most recent of which (3) has the same
+// package as the active version, and precedes a draft version (5).
+func listRollbackVersions(i *fastly.ListVersionsInput) ([]*fastly.Version, error) {
+	return []*fastly.Version{
+		{ServiceID: i.ServiceID, Number: 1, Locked: true, Comment: "first release"},
+		{ServiceID: i.ServiceID, Number: 2, Locked: true, Comment: "second release"},
+		{ServiceID: i.ServiceID, Number: 3, Locked: true},
+		{ServiceID: i.ServiceID, Number: 4, Locked: true, Active: true},
+		{ServiceID: i.ServiceID, Number: 5},
+	}, nil
+}
+
+func getRollbackPackage(i *fastly.GetPackageInput) (*fastly.Package, error) {
+	hashSums := map[int]string{1: "aaa", 2: "bbb", 3: "ccc", 4: "ccc", 5: "ddd"}
+	return &fastly.Package{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Metadata:       fastly.PackageMetadata{HashSum: hashSums[i.ServiceVersion]},
+	}, nil
+}
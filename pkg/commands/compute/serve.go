@@ -39,18 +39,22 @@ type ServeCommand struct {
 	viceroyVersioner update.Versioner
 
 	// Build fields
+	cache            cmd.OptionalBool
 	includeSrc       cmd.OptionalBool
 	lang             cmd.OptionalString
 	skipVerification cmd.OptionalBool
 	timeout          cmd.OptionalInt
 
 	// Serve fields
-	addr      string
-	debug     bool
-	env       cmd.OptionalString
-	file      string
-	skipBuild bool
-	watch     bool
+	addr          string
+	debug         bool
+	env           cmd.OptionalString
+	file          string
+	record        bool
+	recordingsDir string
+	replay        bool
+	skipBuild     bool
+	watch         bool
 }
 
 // NewServeCommand returns a usable command registered under the parent.
@@ -65,11 +69,15 @@ func NewServeCommand(parent cmd.Registerer, globals *config.Data, build *BuildCo
 	c.manifest = data
 
 	c.CmdClause.Flag("addr", "The IPv4 address and port to listen on").Default("127.0.0.1:7676").StringVar(&c.addr)
+	c.CmdClause.Flag("cache", "Reuse the previous build when no changes were detected (use --no-cache to force a rebuild)").Default("true").Action(c.cache.Set).NegatableBoolVar(&c.cache.Value)
 	c.CmdClause.Flag("debug", "Run the server in Debug Adapter mode").Hidden().BoolVar(&c.debug)
-	c.CmdClause.Flag("env", "The environment configuration to use (e.g. stage)").Action(c.env.Set).StringVar(&c.env.Value)
+	c.CmdClause.Flag("env", flagEnvDesc).Action(c.env.Set).StringVar(&c.env.Value)
 	c.CmdClause.Flag("file", "The Wasm file to run").Default("bin/main.wasm").StringVar(&c.file)
 	c.CmdClause.Flag("include-source", "Include source code in built package").Action(c.includeSrc.Set).BoolVar(&c.includeSrc.Value)
 	c.CmdClause.Flag("language", "Language type").Action(c.lang.Set).StringVar(&c.lang.Value)
+	c.CmdClause.Flag("record", "Proxy requests to each [local_server.backends] URL and save the responses, replacing previous recordings").BoolVar(&c.record)
+	c.CmdClause.Flag("recordings-dir", "The directory backend recordings are saved to and replayed from").Default("tests/recordings").StringVar(&c.recordingsDir)
+	c.CmdClause.Flag("replay", "Serve responses for each [local_server.backends] from the saved recordings").BoolVar(&c.replay)
 	c.CmdClause.Flag("skip-build", "Skip the build step").BoolVar(&c.skipBuild)
 	c.CmdClause.Flag("skip-verification", "Skip verification steps and force build").Action(c.skipVerification.Set).BoolVar(&c.skipVerification.Value)
 	c.CmdClause.Flag("timeout", "Timeout, in seconds, for the build compilation step").Action(c.timeout.Set).IntVar(&c.timeout.Value)
@@ -83,6 +91,9 @@ func (c *ServeCommand) Exec(in io.Reader, out io.Writer) (err error) {
 	if c.skipBuild && c.watch {
 		return fsterr.ErrIncompatibleServeFlags
 	}
+	if c.record && c.replay {
+		return fmt.Errorf("error parsing arguments: the --record flag is mutually exclusive with the --replay flag")
+	}
 
 	if !c.skipBuild {
 		err = c.Build(in, out)
@@ -98,11 +109,18 @@ func (c *ServeCommand) Exec(in io.Reader, out io.Writer) (err error) {
 		return err
 	}
 
+	manifestPath, cleanup, err := localManifest(c.recordingMode(), c.recordingsDir, c.Globals.Verbose(), out)
+	if err != nil {
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+	defer cleanup()
+
 	progress.Step("Running local server...")
 	progress.Done()
 
 	for {
-		err = local(bin, c.file, c.addr, c.env.Value, c.debug, c.watch, c.Globals.Verbose(), out, c.Globals.ErrLog)
+		err = local(bin, c.file, c.addr, manifestPath, c.debug, c.watch, c.Globals.Verbose(), out, c.Globals.ErrLog)
 		if err != nil {
 			if err != fsterr.ErrViceroyRestart {
 				if err == fsterr.ErrSignalInterrupt || err == fsterr.ErrSignalKilled {
@@ -124,9 +142,26 @@ func (c *ServeCommand) Exec(in io.Reader, out io.Writer) (err error) {
 	}
 }
 
+// recordingMode returns how the local backends are to be recorded.
+func (c *ServeCommand) recordingMode() recordingMode {
+	switch {
+	case c.record:
+		return recordingRecord
+	case c.replay:
+		return recordingReplay
+	}
+	return recordingOff
+}
+
 // Build constructs and executes the build logic.
 func (c *ServeCommand) Build(in io.Reader, out io.Writer) error {
 	// Reset the fields on the BuildCommand based on ServeCommand values.
+	if c.cache.WasSet {
+		c.build.Flags.NoCache = !c.cache.Value
+	}
+	if c.env.WasSet {
+		c.build.Flags.Env = c.env.Value
+	}
 	if c.includeSrc.WasSet {
 		c.build.Flags.IncludeSrc = c.includeSrc.Value
 	}
@@ -381,36 +416,8 @@ func setBinPerms(bin string) error {
 }
 
 // local spawns a subprocess that runs the compiled binary.
-func local(bin, file, addr, env string, debug, watch, verbose bool, out io.Writer, errLog fsterr.LogInterface) error {
-	if env != "" {
-		env = "." + env
-	}
-
-	wd, err := os.Getwd()
-	if err != nil {
-		errLog.Add(err)
-		return err
-	}
-
-	manifestPath := filepath.Join(wd, fmt.Sprintf("fastly%s.toml", env))
-	args := []string{"-C", manifestPath, "--addr", addr, file}
-
-	if debug {
-		args = append(args, "--debug")
-	}
-
-	if verbose {
-		text.Output(out, "Wasm file: %s", file)
-		text.Output(out, "Manifest: %s", manifestPath)
-	}
-
-	s := &fstexec.Streaming{
-		Args:     args,
-		Command:  bin,
-		Env:      os.Environ(),
-		Output:   out,
-		SignalCh: make(chan os.Signal, 1),
-	}
+func local(bin, file, addr, manifestPath string, debug, watch, verbose bool, out io.Writer, errLog fsterr.LogInterface) error {
+	s := viceroy(bin, file, addr, manifestPath, debug, verbose, out)
 	s.MonitorSignals()
 
 	text.Break(out)
@@ -461,6 +468,29 @@ func local(bin, file, addr, env string, debug, watch, verbose bool, out io.Write
 	return nil
 }
 
+// viceroy returns the command for running the compiled binary with Viceroy,
+// listening on the given address.
+func viceroy(bin, file, addr, manifestPath string, debug, verbose bool, out io.Writer) *fstexec.Streaming {
+	args := []string{"-C", manifestPath, "--addr", addr, file}
+
+	if debug {
+		args = append(args, "--debug")
+	}
+
+	if verbose {
+		text.Output(out, "Wasm file: %s", file)
+		text.Output(out, "Manifest: %s", manifestPath)
+	}
+
+	return &fstexec.Streaming{
+		Args:     args,
+		Command:  bin,
+		Env:      os.Environ(),
+		Output:   out,
+		SignalCh: make(chan os.Signal, 1),
+	}
+}
+
 // watchFiles watches the language source directory and restarts the viceroy
 // executable when changes are detected.
 func watchFiles(verbose bool, s *fstexec.Streaming, out io.Writer, restart chan<- bool) {
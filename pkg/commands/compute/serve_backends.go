@@ -0,0 +1,269 @@
+package compute
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/fastly/cli/pkg/commands/compute/localbackend"
+	fsterr "github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/text"
+	toml "github.com/pelletier/go-toml"
+)
+
+// recordingMode determines how [local_server.backends] without a stub are
+// handled by localManifest.
+type recordingMode int
+
+const (
+	// recordingOff leaves the backends untouched.
+	recordingOff recordingMode = iota
+	// recordingRecord proxies the backends, saving their responses.
+	recordingRecord
+	// recordingReplay serves the backends from previously saved responses.
+	recordingReplay
+)
+
+// localManifest returns the path of the manifest to hand to Viceroy, along
+// with a function that releases any resources created for it.
+//
+// NOTE: Viceroy is always handed the fastly.toml, as an environment manifest
+// only overrides the fields used to build and deploy (see ReadEnvironment) and
+// so doesn't hold the language or [local_server] configuration Viceroy needs.
+//
+// When any [local_server.backends] define a stub, or are being recorded
+// or replayed, an in-process server is started for each of them and Viceroy
+// is handed a temporary copy of the manifest with the backend URLs pointing at
+// those servers. The recordings for each backend are kept in a subdirectory of
+// recordingsDir named after the backend.
+func localManifest(mode recordingMode, recordingsDir string, verbose bool, out io.Writer) (path string, cleanup func(), err error) {
+	cleanup = func() {}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		return "", cleanup, err
+	}
+	path = filepath.Join(wd, manifest.Filename)
+
+	tree, err := toml.LoadFile(path)
+	if err != nil {
+		// NOTE: Viceroy reports its own error for a missing or invalid manifest.
+		return path, cleanup, nil
+	}
+	backends, ok := tree.GetPath([]string{"local_server", "backends"}).(*toml.Tree)
+	if !ok {
+		if mode != recordingOff {
+			return "", cleanup, fsterr.RemediationError{
+				Inner:       fmt.Errorf("no [local_server.backends] to record or replay"),
+				Remediation: "Define the backends to record or replay within the [local_server.backends] section of the fastly.toml.",
+			}
+		}
+		return path, cleanup, nil
+	}
+
+	var servers []*localbackend.Server
+	stop := func() {
+		for _, s := range servers {
+			_ = s.Close()
+		}
+	}
+
+	names := backends.Keys()
+	sort.Strings(names)
+
+	for _, name := range names {
+		backend, ok := backends.GetPath([]string{name}).(*toml.Tree)
+		if !ok {
+			continue
+		}
+
+		var (
+			action string
+			s      *localbackend.Server
+		)
+		dir := filepath.Join(recordingsDir, name)
+
+		switch _, stubbed := backend.GetPath([]string{"stub"}).(*toml.Tree); {
+		case stubbed:
+			action = "Stubbing"
+			s, err = stubLocalBackend(backend)
+			if err != nil {
+				err = fsterr.RemediationError{
+					Inner:       fmt.Errorf("error configuring stub for local backend '%s': %w", name, err),
+					Remediation: "Check the [local_server.backends.<name>.stub] configuration in the fastly.toml. A stub supports 'status', 'headers', one of 'body' or 'file', a static files 'dir' and a 'recordings' directory.",
+				}
+			}
+		case mode == recordingRecord:
+			action = "Recording"
+			s, err = recordLocalBackend(backend, dir)
+			if err != nil {
+				err = fsterr.RemediationError{
+					Inner:       fmt.Errorf("error configuring recording for local backend '%s': %w", name, err),
+					Remediation: "Check the [local_server.backends.<name>] 'url' in the fastly.toml is an absolute http(s) URL and that the --recordings-dir is writable.",
+				}
+			}
+		case mode == recordingReplay:
+			action = "Replaying"
+			s, err = replayLocalBackend(backend, dir)
+			if err != nil {
+				err = fsterr.RemediationError{
+					Inner:       fmt.Errorf("error configuring replay for local backend '%s': %w", name, err),
+					Remediation: "Run `fastly compute serve --record` to record the backend responses before using --replay, and check the --recordings-dir matches.",
+				}
+			}
+		default:
+			continue
+		}
+		if err != nil {
+			stop()
+			return "", cleanup, err
+		}
+		servers = append(servers, s)
+
+		if verbose {
+			text.Output(out, "%s local backend '%s' at %s", action, name, s.URL)
+		}
+	}
+
+	if len(servers) == 0 {
+		return path, cleanup, nil
+	}
+
+	// NOTE: The copy is written to a temporary directory, so any relative file
+	// paths need to be made absolute for Viceroy to still find them.
+	absLocalServerPaths(tree, wd)
+
+	f, err := os.CreateTemp("", "fastly-*.toml")
+	if err == nil {
+		_, err = tree.WriteTo(f)
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+	}
+	if err != nil {
+		stop()
+		return "", cleanup, fmt.Errorf("error writing local server manifest: %w", err)
+	}
+
+	cleanup = func() {
+		stop()
+		_ = os.Remove(f.Name())
+	}
+	return f.Name(), cleanup, nil
+}
+
+// stubLocalBackend starts a server for the backend's stub and points the
+// backend at it, removing the stub configuration that Viceroy doesn't support.
+func stubLocalBackend(backend *toml.Tree) (*localbackend.Server, error) {
+	var cfg manifest.LocalBackendStub
+	if err := backend.GetPath([]string{"stub"}).(*toml.Tree).Unmarshal(&cfg); err != nil {
+		return nil, err
+	}
+
+	stub, err := localbackend.NewStub(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := localbackend.Start(stub)
+	if err != nil {
+		return nil, err
+	}
+
+	pointLocalBackend(backend, s)
+	if err := backend.DeletePath([]string{"stub"}); err != nil {
+		_ = s.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// recordLocalBackend starts a recording proxy in front of the backend URL and
+// points the backend at it.
+func recordLocalBackend(backend *toml.Tree, dir string) (*localbackend.Server, error) {
+	var cfg manifest.LocalBackend
+	if err := backend.Unmarshal(&cfg); err != nil {
+		return nil, err
+	}
+	if cfg.URL == "" {
+		return nil, fmt.Errorf("no 'url' to record from")
+	}
+
+	rec, err := localbackend.NewRecorder(localbackend.RecorderConfig{
+		URL:          cfg.URL,
+		OverrideHost: cfg.OverrideHost,
+		CertHost:     cfg.CertHost,
+		Dir:          dir,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := localbackend.Start(rec)
+	if err != nil {
+		return nil, err
+	}
+
+	pointLocalBackend(backend, s)
+	return s, nil
+}
+
+// replayLocalBackend starts a server for the backend's recordings and points
+// the backend at it.
+func replayLocalBackend(backend *toml.Tree, dir string) (*localbackend.Server, error) {
+	stub, err := localbackend.NewStub(manifest.LocalBackendStub{Recordings: dir})
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := localbackend.Start(stub)
+	if err != nil {
+		return nil, err
+	}
+
+	pointLocalBackend(backend, s)
+	return s, nil
+}
+
+// pointLocalBackend sets the backend URL to the in-process server.
+//
+// NOTE: The server is plain HTTP, so the TLS settings no longer apply.
+func pointLocalBackend(backend *toml.Tree, s *localbackend.Server) {
+	backend.SetPath([]string{"url"}, s.URL)
+	for _, key := range []string{"cert_host", "use_sni"} {
+		if backend.Has(key) {
+			_ = backend.DeletePath([]string{key})
+		}
+	}
+}
+
+// absLocalServerPaths resolves the relative dictionary and object store file
+// paths within the [local_server] configuration against dir.
+func absLocalServerPaths(tree *toml.Tree, dir string) {
+	abs := func(t *toml.Tree, key string) {
+		if p, ok := t.GetPath([]string{key}).(string); ok && p != "" && !filepath.IsAbs(p) {
+			t.SetPath([]string{key}, filepath.Join(dir, p))
+		}
+	}
+
+	if dictionaries, ok := tree.GetPath([]string{"local_server", "dictionaries"}).(*toml.Tree); ok {
+		for _, name := range dictionaries.Keys() {
+			if d, ok := dictionaries.GetPath([]string{name}).(*toml.Tree); ok {
+				abs(d, "file")
+			}
+		}
+	}
+
+	if stores, ok := tree.GetPath([]string{"local_server", "object_stores"}).(*toml.Tree); ok {
+		for _, name := range stores.Keys() {
+			if entries, ok := stores.GetPath([]string{name}).([]*toml.Tree); ok {
+				for _, e := range entries {
+					abs(e, "path")
+				}
+			}
+		}
+	}
+}
@@ -4,12 +4,17 @@ import (
 	"bytes"
 	"os"
 	"path/filepath"
+	"runtime"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/fastly/cli/pkg/app"
 	"github.com/fastly/cli/pkg/commands/compute"
+	"github.com/fastly/cli/pkg/commands/compute/fixture"
 	"github.com/fastly/cli/pkg/config"
 	fsterr "github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/manifest"
 	"github.com/fastly/cli/pkg/mock"
 	"github.com/fastly/cli/pkg/testutil"
 	"github.com/fastly/cli/pkg/text"
@@ -114,3 +119,91 @@ func TestGetViceroy(t *testing.T) {
 		t.Fatalf("binary was not moved to the install directory: %s", err)
 	}
 }
+
+// TestServeEnvironment validates that Viceroy is handed the fastly.toml when
+// an environment is set, as the environment manifest only overrides the fields
+// used to build and deploy.
+//
+// NOTE: A script stands in for Viceroy, printing the manifest it was handed
+// and then exiting with an error so the command returns.
+func TestServeEnvironment(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the Viceroy stand-in is a shell script")
+	}
+
+	args := testutil.Args
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	viceroyBinName := "viceroy"
+
+	rootdir := testutil.NewEnv(testutil.EnvOpts{
+		T: t,
+		Write: []testutil.FileIO{
+			{Src: `
+			manifest_version = 2
+			name = "test"
+			language = "rust"
+			service_id = "base"
+
+			[local_server.backends.origin]
+			url = "https://example.com"`, Dst: manifest.Filename},
+			{Src: `service_id = "stage"`, Dst: manifest.EnvironmentFilename("stage")},
+			{Src: "[[tests]]\npath = \"/\"\n", Dst: fixture.DefaultFilename},
+			{Src: "#!/bin/sh\n[ \"$1\" = \"--version\" ] && echo \"viceroy 0.0.1\" && exit 0\ncat \"$2\"\nexit 1\n", Dst: viceroyBinName},
+		},
+	})
+	defer os.RemoveAll(rootdir)
+
+	if err := os.Chmod(filepath.Join(rootdir, viceroyBinName), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.Chdir(rootdir); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+
+	installDir := compute.InstallDir
+	compute.InstallDir = rootdir
+	defer func() {
+		compute.InstallDir = installDir
+	}()
+
+	for _, testcase := range []struct {
+		name string
+		args []string
+	}{
+		{
+			name: "serve",
+			args: args("compute serve --skip-build --env stage --addr 127.0.0.1:0 --verbose"),
+		},
+		{
+			name: "test",
+			args: args("compute test --skip-build --env stage --addr 127.0.0.1:0 --verbose"),
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.ConfigFile.Viceroy = config.Viceroy{
+				LastChecked:   time.Now().Format(time.RFC3339),
+				LatestVersion: "0.0.1",
+				TTL:           "24h",
+			}
+			opts.Versioners = app.Versioners{
+				Viceroy: mock.Versioner{BinaryFilename: viceroyBinName},
+			}
+			_ = app.Run(opts)
+
+			t.Log(stdout.String())
+
+			testutil.AssertStringContains(t, stdout.String(), "Manifest: "+filepath.Join(rootdir, manifest.Filename))
+			testutil.AssertStringContains(t, stdout.String(), `language = "rust"`)
+			testutil.AssertStringContains(t, stdout.String(), "[local_server.backends.origin]")
+		})
+	}
+}
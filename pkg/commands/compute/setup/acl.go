@@ -0,0 +1,225 @@
+package setup
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v6/fastly"
+)
+
+// ACLs represents the service state related to ACLs defined within the
+// fastly.toml [setup] configuration.
+//
+// NOTE: It implements the setup.Interface interface.
+type ACLs struct {
+	// Public
+	APIClient      api.Interface
+	Progress       text.Progress
+	ServiceID      string
+	ServiceVersion int
+	Setup          map[string]*manifest.SetupACL
+
+	// Private
+	missing   []string
+	required  []ACL
+	unmanaged []string
+}
+
+// ACL represents the configuration parameters for creating an ACL via the API
+// client.
+type ACL struct {
+	Name    string
+	Entries []ACLEntry
+}
+
+// ACLEntry represents the configuration parameters for creating ACL entries
+// via the API client.
+type ACLEntry struct {
+	IP      string
+	Subnet  int
+	Negated bool
+	Comment string
+}
+
+// Configure prompts the user for specific values related to the service resource.
+//
+// NOTE: ACL entries are defined in full within the fastly.toml and so there's
+// nothing to prompt for, but we do validate them.
+func (a *ACLs) Configure() error {
+	for _, name := range a.names() {
+		var entries []ACLEntry
+
+		for i, entry := range a.Setup[name].Entries {
+			if entry.IP == "" {
+				return fmt.Errorf("error configuring ACL '%s': entry %d has no 'ip' defined", name, i+1)
+			}
+			entries = append(entries, ACLEntry{
+				IP:      entry.IP,
+				Subnet:  entry.Subnet,
+				Negated: entry.Negated,
+				Comment: entry.Comment,
+			})
+		}
+
+		a.required = append(a.required, ACL{
+			Name:    name,
+			Entries: entries,
+		})
+	}
+
+	return nil
+}
+
+// Create calls the relevant API to create the service resource(s).
+func (a *ACLs) Create() error {
+	if a.Progress == nil {
+		return errors.RemediationError{
+			Inner:       fmt.Errorf("internal logic error: no text.Progress configured for setup.ACLs"),
+			Remediation: errors.BugRemediation,
+		}
+	}
+
+	for _, acl := range a.required {
+		a.Progress.Step(fmt.Sprintf("Creating ACL '%s'...", acl.Name))
+
+		resp, err := a.APIClient.CreateACL(&fastly.CreateACLInput{
+			ServiceID:      a.ServiceID,
+			ServiceVersion: a.ServiceVersion,
+			Name:           acl.Name,
+		})
+		if err != nil {
+			a.Progress.Fail()
+			return fmt.Errorf("error creating ACL: %w", err)
+		}
+
+		for _, entry := range acl.Entries {
+			a.Progress.Step(fmt.Sprintf("Creating ACL entry '%s'...", entry.IP))
+
+			_, err := a.APIClient.CreateACLEntry(&fastly.CreateACLEntryInput{
+				ServiceID: a.ServiceID,
+				ACLID:     resp.ID,
+				IP:        entry.IP,
+				Subnet:    entry.Subnet,
+				Negated:   fastly.Compatibool(entry.Negated),
+				Comment:   entry.Comment,
+			})
+			if err != nil {
+				a.Progress.Fail()
+				return fmt.Errorf("error creating ACL entry: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Delete calls the relevant API to delete the named service resource(s).
+func (a *ACLs) Delete(names []string) error {
+	if a.Progress == nil {
+		return errors.RemediationError{
+			Inner:       fmt.Errorf("internal logic error: no text.Progress configured for setup.ACLs"),
+			Remediation: errors.BugRemediation,
+		}
+	}
+
+	for _, name := range names {
+		a.Progress.Step(fmt.Sprintf("Deleting ACL '%s'...", name))
+
+		err := a.APIClient.DeleteACL(&fastly.DeleteACLInput{
+			ServiceID:      a.ServiceID,
+			ServiceVersion: a.ServiceVersion,
+			Name:           name,
+		})
+		if err != nil {
+			a.Progress.Fail()
+			return fmt.Errorf("error deleting ACL: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Drift describes the differences between the service resources and their
+// [setup] configuration.
+//
+// NOTE: ACL entries are managed outside of service versions and so only the
+// existence of an ACL is reconciled.
+func (a *ACLs) Drift() []string {
+	return nil
+}
+
+// Missing indicates if there are missing resources that need to be created.
+func (a *ACLs) Missing() bool {
+	return len(a.missing) > 0
+}
+
+// MissingNames returns the sorted names of the [setup.acls] that were found to
+// be missing from the service by Validate.
+func (a *ACLs) MissingNames() []string {
+	return a.missing
+}
+
+// Predefined indicates if the service resource has been specified within the
+// fastly.toml file using a [setup] configuration block.
+func (a *ACLs) Predefined() bool {
+	return len(a.Setup) > 0
+}
+
+// Unmanaged returns the sorted names of the service resources that aren't
+// defined within the [setup.acls] configuration.
+//
+// NOTE: Nothing is reported when there is no [setup.acls] configuration, as
+// the service resources are then not considered to be managed by it.
+func (a *ACLs) Unmanaged() []string {
+	return a.unmanaged
+}
+
+// Validate checks if the service has the required resources.
+//
+// NOTE: When no ServiceID is set (i.e. the service is yet to be created) every
+// [setup.acls] entry is considered missing.
+func (a *ACLs) Validate() error {
+	available := make(map[string]bool)
+	if a.ServiceID != "" && a.Predefined() {
+		acls, err := a.APIClient.ListACLs(&fastly.ListACLsInput{
+			ServiceID:      a.ServiceID,
+			ServiceVersion: a.ServiceVersion,
+		})
+		if err != nil {
+			return fmt.Errorf("error fetching service ACLs: %w", err)
+		}
+		for _, acl := range acls {
+			available[acl.Name] = true
+		}
+	}
+
+	a.missing, a.unmanaged = nil, nil
+	for _, name := range a.names() {
+		if !available[name] {
+			a.missing = append(a.missing, name)
+		}
+	}
+	if a.Predefined() {
+		for name := range available {
+			if _, ok := a.Setup[name]; !ok {
+				a.unmanaged = append(a.unmanaged, name)
+			}
+		}
+	}
+	sort.Strings(a.unmanaged)
+	return nil
+}
+
+// names returns the sorted [setup.acls] names.
+func (a *ACLs) names() []string {
+	names := make([]string, 0, len(a.Setup))
+	for name := range a.Setup {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
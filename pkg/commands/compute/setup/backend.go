@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"sort"
 	"strconv"
 
 	"github.com/fastly/cli/pkg/api"
@@ -31,18 +32,26 @@ type Backends struct {
 	Stdout         io.Writer
 
 	// Private
-	required []Backend
+	drift     []string
+	missing   []string
+	required  []Backend
+	unmanaged []string
 }
 
 // Backend represents the configuration parameters for creating a backend via
 // the API client.
 type Backend struct {
-	Address         string
-	Name            string
-	OverrideHost    string
-	Port            uint
-	SSLCertHostname string
-	SSLSNIHostname  string
+	Address          string
+	ConnectTimeout   uint
+	FirstByteTimeout uint
+	HealthCheck      string
+	Name             string
+	OverrideHost     string
+	Port             uint
+	Shield           string
+	SSLCertHostname  string
+	SSLSNIHostname   string
+	UseSSL           bool
 }
 
 // Configure prompts the user for specific values related to the service resource.
@@ -68,14 +77,19 @@ func (b *Backends) Create() error {
 		}
 
 		_, err := b.APIClient.CreateBackend(&fastly.CreateBackendInput{
-			ServiceID:       b.ServiceID,
-			ServiceVersion:  b.ServiceVersion,
-			Name:            bk.Name,
-			Address:         bk.Address,
-			Port:            fastly.Uint(bk.Port),
-			OverrideHost:    bk.OverrideHost,
-			SSLCertHostname: bk.SSLCertHostname,
-			SSLSNIHostname:  bk.SSLSNIHostname,
+			ServiceID:        b.ServiceID,
+			ServiceVersion:   b.ServiceVersion,
+			Name:             bk.Name,
+			Address:          bk.Address,
+			Port:             fastly.Uint(bk.Port),
+			OverrideHost:     bk.OverrideHost,
+			SSLCertHostname:  bk.SSLCertHostname,
+			SSLSNIHostname:   bk.SSLSNIHostname,
+			UseSSL:           fastly.Compatibool(bk.UseSSL),
+			ConnectTimeout:   optionalUintPtr(bk.ConnectTimeout),
+			FirstByteTimeout: optionalUintPtr(bk.FirstByteTimeout),
+			Shield:           bk.Shield,
+			HealthCheck:      bk.HealthCheck,
 		})
 		if err != nil {
 			b.Progress.Fail()
@@ -89,12 +103,143 @@ func (b *Backends) Create() error {
 	return nil
 }
 
+// Delete calls the relevant API to delete the named service resource(s).
+func (b *Backends) Delete(names []string) error {
+	if b.Progress == nil {
+		return errors.RemediationError{
+			Inner:       fmt.Errorf("internal logic error: no text.Progress configured for setup.Backends"),
+			Remediation: errors.BugRemediation,
+		}
+	}
+
+	for _, name := range names {
+		b.Progress.Step(fmt.Sprintf("Deleting backend '%s'...", name))
+
+		err := b.APIClient.DeleteBackend(&fastly.DeleteBackendInput{
+			ServiceID:      b.ServiceID,
+			ServiceVersion: b.ServiceVersion,
+			Name:           name,
+		})
+		if err != nil {
+			b.Progress.Fail()
+			return fmt.Errorf("error deleting backend: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Drift describes the differences between the service resources and their
+// [setup] configuration.
+func (b *Backends) Drift() []string {
+	return b.drift
+}
+
+// Missing indicates if there are missing resources that need to be created.
+func (b *Backends) Missing() bool {
+	return len(b.missing) > 0
+}
+
+// MissingNames returns the sorted names of the [setup.backends] that were
+// found to be missing from the service by Validate.
+func (b *Backends) MissingNames() []string {
+	return b.missing
+}
+
 // Predefined indicates if the service resource has been specified within the
 // fastly.toml file using a [setup] configuration block.
 func (b *Backends) Predefined() bool {
 	return len(b.Setup) > 0
 }
 
+// Unmanaged returns the sorted names of the service resources that aren't
+// defined within the [setup.backends] configuration.
+//
+// NOTE: Nothing is reported when there is no [setup.backends] configuration, as
+// the service resources are then not considered to be managed by it.
+func (b *Backends) Unmanaged() []string {
+	return b.unmanaged
+}
+
+// Validate checks if the service has the required resources.
+//
+// NOTE: When no ServiceID is set (i.e. the service is yet to be created) every
+// [setup.backends] entry is considered missing.
+func (b *Backends) Validate() error {
+	available := make(map[string]*fastly.Backend)
+	if b.ServiceID != "" && b.Predefined() {
+		backends, err := b.APIClient.ListBackends(&fastly.ListBackendsInput{
+			ServiceID:      b.ServiceID,
+			ServiceVersion: b.ServiceVersion,
+		})
+		if err != nil {
+			return fmt.Errorf("error fetching service backends: %w", err)
+		}
+		for _, bk := range backends {
+			available[bk.Name] = bk
+		}
+	}
+
+	b.drift, b.missing, b.unmanaged = nil, nil, nil
+	for name, settings := range b.Setup {
+		bk, ok := available[name]
+		if !ok {
+			b.missing = append(b.missing, name)
+			continue
+		}
+		b.drift = append(b.drift, backendDrift(name, settings, bk)...)
+	}
+	if b.Predefined() {
+		for name := range available {
+			if _, ok := b.Setup[name]; !ok {
+				b.unmanaged = append(b.unmanaged, name)
+			}
+		}
+	}
+	sort.Strings(b.drift)
+	sort.Strings(b.missing)
+	sort.Strings(b.unmanaged)
+	return nil
+}
+
+// backendDrift describes the differences between the service backend and its
+// [setup.backends] configuration. Optional settings are only compared when
+// they're defined in the fastly.toml.
+func backendDrift(name string, settings *manifest.SetupBackend, bk *fastly.Backend) []string {
+	var drift []string
+	diff := func(key, have, want string) {
+		if have != want {
+			drift = append(drift, fmt.Sprintf("backend '%s': %s is '%s' on the service but '%s' in the fastly.toml", name, key, have, want))
+		}
+	}
+
+	if settings.Address != "" {
+		diff("address", bk.Address, settings.Address)
+	}
+	if settings.Port > 0 {
+		diff("port", strconv.Itoa(int(bk.Port)), strconv.Itoa(int(settings.Port)))
+	}
+	if settings.UseSSL != nil {
+		diff("use_ssl", strconv.FormatBool(bk.UseSSL), strconv.FormatBool(*settings.UseSSL))
+	}
+	if settings.OverrideHost != "" {
+		diff("override_host", bk.OverrideHost, settings.OverrideHost)
+	}
+	if settings.Shield != "" {
+		diff("shield", bk.Shield, settings.Shield)
+	}
+	if settings.Healthcheck != "" {
+		diff("healthcheck", bk.HealthCheck, settings.Healthcheck)
+	}
+	if settings.ConnectTimeout > 0 {
+		diff("connect_timeout", strconv.Itoa(int(bk.ConnectTimeout)), strconv.Itoa(int(settings.ConnectTimeout)))
+	}
+	if settings.FirstByteTimeout > 0 {
+		diff("first_byte_timeout", strconv.Itoa(int(bk.FirstByteTimeout)), strconv.Itoa(int(settings.FirstByteTimeout)))
+	}
+	return drift
+}
+
 // isOriginless indicates if the required backend is originless.
 func (b *Backends) isOriginless() bool {
 	return len(b.required) == 1 && b.required[0].Name == "originless" && b.required[0].Address == "127.0.0.1"
@@ -139,10 +284,7 @@ func (b *Backends) checkPredefined() error {
 			addr = defaultAddress
 		}
 
-		port := uint(80)
-		if settings.Port > 0 {
-			port = settings.Port
-		}
+		port := DefaultBackendPort(settings)
 		if !b.AcceptDefaults && !b.NonInteractive {
 			input, err := text.Input(b.Stdout, text.BoldYellow(fmt.Sprintf("Port: [%d] ", port)), b.Stdin)
 			if err != nil {
@@ -157,20 +299,49 @@ func (b *Backends) checkPredefined() error {
 			}
 		}
 
-		overrideHost, sslSNIHostname, sslCertHostname := backend.SetBackendHostDefaults(addr)
+		// NOTE: The host defaults mirror `fastly backend create`, which only
+		// applies them when none of the host settings have been provided.
+		overrideHost, sslSNIHostname, sslCertHostname := settings.OverrideHost, settings.SSLSNIHostname, settings.SSLCertHostname
+		if overrideHost == "" && sslSNIHostname == "" && sslCertHostname == "" {
+			overrideHost, sslSNIHostname, sslCertHostname = backend.SetBackendHostDefaults(addr)
+		}
 		b.required = append(b.required, Backend{
-			Address:         addr,
-			Name:            name,
-			OverrideHost:    overrideHost,
-			Port:            port,
-			SSLCertHostname: sslCertHostname,
-			SSLSNIHostname:  sslSNIHostname,
+			Address:          addr,
+			ConnectTimeout:   settings.ConnectTimeout,
+			FirstByteTimeout: settings.FirstByteTimeout,
+			HealthCheck:      settings.Healthcheck,
+			Name:             name,
+			OverrideHost:     overrideHost,
+			Port:             port,
+			Shield:           settings.Shield,
+			SSLCertHostname:  sslCertHostname,
+			SSLSNIHostname:   sslSNIHostname,
+			UseSSL:           useSSL(settings),
 		})
 	}
 
 	return nil
 }
 
+// DefaultBackendPort returns the port for a [setup.backends] entry, which
+// defaults to 443 when use_ssl is set (as per `fastly backend create`) and 80
+// otherwise.
+func DefaultBackendPort(settings *manifest.SetupBackend) uint {
+	switch {
+	case settings.Port > 0:
+		return settings.Port
+	case useSSL(settings):
+		return 443
+	}
+	return 80
+}
+
+// useSSL indicates if a [setup.backends] entry enables TLS, which is disabled
+// unless use_ssl is set.
+func useSSL(settings *manifest.SetupBackend) bool {
+	return settings.UseSSL != nil && *settings.UseSSL
+}
+
 // promptForBackend issues a prompt requesting one or more Backends that will
 // be created within the user's service.
 func (b *Backends) promptForBackend() error {
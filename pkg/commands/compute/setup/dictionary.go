@@ -3,6 +3,7 @@ package setup
 import (
 	"fmt"
 	"io"
+	"sort"
 
 	"github.com/fastly/cli/pkg/api"
 	"github.com/fastly/cli/pkg/errors"
@@ -28,7 +29,9 @@ type Dictionaries struct {
 	Stdout         io.Writer
 
 	// Private
-	required []Dictionary
+	missing   []string
+	required  []Dictionary
+	unmanaged []string
 }
 
 // Dictionary represents the configuration parameters for creating a dictionary
@@ -148,8 +151,100 @@ func (d *Dictionaries) Create() error {
 	return nil
 }
 
+// Delete calls the relevant API to delete the named service resource(s).
+func (d *Dictionaries) Delete(names []string) error {
+	if d.Progress == nil {
+		return errors.RemediationError{
+			Inner:       fmt.Errorf("internal logic error: no text.Progress configured for setup.Dictionaries"),
+			Remediation: errors.BugRemediation,
+		}
+	}
+
+	for _, name := range names {
+		d.Progress.Step(fmt.Sprintf("Deleting dictionary '%s'...", name))
+
+		err := d.APIClient.DeleteDictionary(&fastly.DeleteDictionaryInput{
+			ServiceID:      d.ServiceID,
+			ServiceVersion: d.ServiceVersion,
+			Name:           name,
+		})
+		if err != nil {
+			d.Progress.Fail()
+			return fmt.Errorf("error deleting dictionary: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Drift describes the differences between the service resources and their
+// [setup] configuration.
+//
+// NOTE: Dictionary items are managed outside of service versions and so only
+// the existence of a dictionary is reconciled.
+func (d *Dictionaries) Drift() []string {
+	return nil
+}
+
+// Missing indicates if there are missing resources that need to be created.
+func (d *Dictionaries) Missing() bool {
+	return len(d.missing) > 0
+}
+
+// MissingNames returns the sorted names of the [setup.dictionaries] that were
+// found to be missing from the service by Validate.
+func (d *Dictionaries) MissingNames() []string {
+	return d.missing
+}
+
 // Predefined indicates if the service resource has been specified within the
 // fastly.toml file using a [setup] configuration block.
 func (d *Dictionaries) Predefined() bool {
 	return len(d.Setup) > 0
 }
+
+// Unmanaged returns the sorted names of the service resources that aren't
+// defined within the [setup.dictionaries] configuration.
+//
+// NOTE: Nothing is reported when there is no [setup.dictionaries] configuration, as
+// the service resources are then not considered to be managed by it.
+func (d *Dictionaries) Unmanaged() []string {
+	return d.unmanaged
+}
+
+// Validate checks if the service has the required resources.
+//
+// NOTE: When no ServiceID is set (i.e. the service is yet to be created) every
+// [setup.dictionaries] entry is considered missing.
+func (d *Dictionaries) Validate() error {
+	available := make(map[string]bool)
+	if d.ServiceID != "" && d.Predefined() {
+		dictionaries, err := d.APIClient.ListDictionaries(&fastly.ListDictionariesInput{
+			ServiceID:      d.ServiceID,
+			ServiceVersion: d.ServiceVersion,
+		})
+		if err != nil {
+			return fmt.Errorf("error fetching service dictionaries: %w", err)
+		}
+		for _, dict := range dictionaries {
+			available[dict.Name] = true
+		}
+	}
+
+	d.missing, d.unmanaged = nil, nil
+	for name := range d.Setup {
+		if !available[name] {
+			d.missing = append(d.missing, name)
+		}
+	}
+	if d.Predefined() {
+		for name := range available {
+			if _, ok := d.Setup[name]; !ok {
+				d.unmanaged = append(d.unmanaged, name)
+			}
+		}
+	}
+	sort.Strings(d.missing)
+	sort.Strings(d.unmanaged)
+	return nil
+}
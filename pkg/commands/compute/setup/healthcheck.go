@@ -0,0 +1,215 @@
+package setup
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v6/fastly"
+)
+
+// Healthchecks represents the service state related to healthchecks defined
+// within the fastly.toml [setup] configuration.
+//
+// NOTE: It implements the setup.Interface interface.
+type Healthchecks struct {
+	// Public
+	APIClient      api.Interface
+	Progress       text.Progress
+	ServiceID      string
+	ServiceVersion int
+	Setup          map[string]*manifest.SetupHealthcheck
+
+	// Private
+	drift     []string
+	missing   []string
+	required  []*fastly.CreateHealthCheckInput
+	unmanaged []string
+}
+
+// Configure prompts the user for specific values related to the service resource.
+//
+// NOTE: Healthchecks are defined in full within the fastly.toml and so there's
+// nothing to prompt for. Any omitted settings use the API defaults.
+func (h *Healthchecks) Configure() error {
+	for _, name := range h.names() {
+		settings := h.Setup[name]
+
+		h.required = append(h.required, &fastly.CreateHealthCheckInput{
+			ServiceID:        h.ServiceID,
+			ServiceVersion:   h.ServiceVersion,
+			Name:             name,
+			Comment:          settings.Description,
+			Method:           settings.Method,
+			Host:             settings.Host,
+			Path:             settings.Path,
+			HTTPVersion:      settings.HTTPVersion,
+			Timeout:          optionalUintPtr(settings.Timeout),
+			CheckInterval:    optionalUintPtr(settings.CheckInterval),
+			ExpectedResponse: optionalUintPtr(settings.ExpectedResponse),
+			Window:           optionalUintPtr(settings.Window),
+			Threshold:        optionalUintPtr(settings.Threshold),
+			Initial:          optionalUintPtr(settings.Initial),
+		})
+	}
+
+	return nil
+}
+
+// Create calls the relevant API to create the service resource(s).
+func (h *Healthchecks) Create() error {
+	if h.Progress == nil {
+		return errors.RemediationError{
+			Inner:       fmt.Errorf("internal logic error: no text.Progress configured for setup.Healthchecks"),
+			Remediation: errors.BugRemediation,
+		}
+	}
+
+	for _, input := range h.required {
+		h.Progress.Step(fmt.Sprintf("Creating healthcheck '%s'...", input.Name))
+
+		_, err := h.APIClient.CreateHealthCheck(input)
+		if err != nil {
+			h.Progress.Fail()
+			return fmt.Errorf("error creating healthcheck: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Delete calls the relevant API to delete the named service resource(s).
+func (h *Healthchecks) Delete(names []string) error {
+	if h.Progress == nil {
+		return errors.RemediationError{
+			Inner:       fmt.Errorf("internal logic error: no text.Progress configured for setup.Healthchecks"),
+			Remediation: errors.BugRemediation,
+		}
+	}
+
+	for _, name := range names {
+		h.Progress.Step(fmt.Sprintf("Deleting healthcheck '%s'...", name))
+
+		err := h.APIClient.DeleteHealthCheck(&fastly.DeleteHealthCheckInput{
+			ServiceID:      h.ServiceID,
+			ServiceVersion: h.ServiceVersion,
+			Name:           name,
+		})
+		if err != nil {
+			h.Progress.Fail()
+			return fmt.Errorf("error deleting healthcheck: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Drift describes the differences between the service resources and their
+// [setup] configuration.
+func (h *Healthchecks) Drift() []string {
+	return h.drift
+}
+
+// Missing indicates if there are missing resources that need to be created.
+func (h *Healthchecks) Missing() bool {
+	return len(h.missing) > 0
+}
+
+// MissingNames returns the sorted names of the [setup.healthchecks] that were
+// found to be missing from the service by Validate.
+func (h *Healthchecks) MissingNames() []string {
+	return h.missing
+}
+
+// Predefined indicates if the service resource has been specified within the
+// fastly.toml file using a [setup] configuration block.
+func (h *Healthchecks) Predefined() bool {
+	return len(h.Setup) > 0
+}
+
+// Unmanaged returns the sorted names of the service resources that aren't
+// defined within the [setup.healthchecks] configuration.
+//
+// NOTE: Nothing is reported when there is no [setup.healthchecks] configuration, as
+// the service resources are then not considered to be managed by it.
+func (h *Healthchecks) Unmanaged() []string {
+	return h.unmanaged
+}
+
+// Validate checks if the service has the required resources.
+//
+// NOTE: When no ServiceID is set (i.e. the service is yet to be created) every
+// [setup.healthchecks] entry is considered missing.
+func (h *Healthchecks) Validate() error {
+	available := make(map[string]*fastly.HealthCheck)
+	if h.ServiceID != "" && h.Predefined() {
+		healthchecks, err := h.APIClient.ListHealthChecks(&fastly.ListHealthChecksInput{
+			ServiceID:      h.ServiceID,
+			ServiceVersion: h.ServiceVersion,
+		})
+		if err != nil {
+			return fmt.Errorf("error fetching service healthchecks: %w", err)
+		}
+		for _, hc := range healthchecks {
+			available[hc.Name] = hc
+		}
+	}
+
+	h.drift, h.missing, h.unmanaged = nil, nil, nil
+	for _, name := range h.names() {
+		hc, ok := available[name]
+		if !ok {
+			h.missing = append(h.missing, name)
+			continue
+		}
+		h.drift = append(h.drift, healthcheckDrift(name, h.Setup[name], hc)...)
+	}
+	if h.Predefined() {
+		for name := range available {
+			if _, ok := h.Setup[name]; !ok {
+				h.unmanaged = append(h.unmanaged, name)
+			}
+		}
+	}
+	sort.Strings(h.unmanaged)
+	return nil
+}
+
+// healthcheckDrift describes the differences between the service healthcheck
+// and its [setup.healthchecks] configuration. Settings are only compared when
+// they're defined in the fastly.toml.
+func healthcheckDrift(name string, settings *manifest.SetupHealthcheck, hc *fastly.HealthCheck) []string {
+	var drift []string
+	diff := func(key, have, want string) {
+		if want != "" && have != want {
+			drift = append(drift, fmt.Sprintf("healthcheck '%s': %s is '%s' on the service but '%s' in the fastly.toml", name, key, have, want))
+		}
+	}
+
+	diff("host", hc.Host, settings.Host)
+	diff("path", hc.Path, settings.Path)
+	diff("method", hc.Method, settings.Method)
+	diff("http_version", hc.HTTPVersion, settings.HTTPVersion)
+	return drift
+}
+
+// names returns the sorted [setup.healthchecks] names.
+func (h *Healthchecks) names() []string {
+	names := make([]string, 0, len(h.Setup))
+	for name := range h.Setup {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// optionalUintPtr returns nil for a zero value so the API default is used.
+func optionalUintPtr(v uint) *uint {
+	if v == 0 {
+		return nil
+	}
+	return fastly.Uint(v)
+}
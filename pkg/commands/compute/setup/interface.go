@@ -19,3 +19,25 @@ type Interface interface {
 	// Validate checks if the service has the required resources.
 	Validate() error
 }
+
+// Reconciler represents a [setup] resource that can be reconciled with an
+// existing service version (see `compute deploy --sync-setup`).
+//
+// NOTE: Validate must be called before any of the following methods.
+type Reconciler interface {
+	Interface
+
+	// Delete calls the relevant API to delete the named service resource(s).
+	Delete(names []string) error
+
+	// Drift describes the differences between the service resources and their
+	// [setup] configuration.
+	Drift() []string
+
+	// MissingNames returns the sorted names of the missing service resources.
+	MissingNames() []string
+
+	// Unmanaged returns the sorted names of the service resources that aren't
+	// defined within a predefined [setup] configuration block.
+	Unmanaged() []string
+}
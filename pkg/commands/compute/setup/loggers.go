@@ -1,10 +1,31 @@
 package setup
 
 import (
+	"fmt"
 	"io"
+	"sort"
+	"strings"
 
+	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/logging/datadog"
+	"github.com/fastly/cli/pkg/commands/logging/https"
+	"github.com/fastly/cli/pkg/commands/logging/kafka"
+	"github.com/fastly/cli/pkg/commands/logging/s3"
+	"github.com/fastly/cli/pkg/commands/logging/splunk"
+	"github.com/fastly/cli/pkg/errors"
 	"github.com/fastly/cli/pkg/manifest"
 	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v6/fastly"
+)
+
+// The log endpoint providers that can be created from a [setup] configuration.
+const (
+	LoggerDatadog = "datadog"
+	LoggerHTTPS   = "https"
+	LoggerKafka   = "kafka"
+	LoggerS3      = "s3"
+	LoggerSplunk  = "splunk"
 )
 
 // Loggers represents the service state related to log entries defined within
@@ -12,23 +33,91 @@ import (
 //
 // NOTE: It implements the setup.Interface interface.
 type Loggers struct {
-	Setup  map[string]*manifest.SetupLogger
-	Stdout io.Writer
+	// Public
+	APIClient      api.Interface
+	AcceptDefaults bool
+	NonInteractive bool
+	Progress       text.Progress
+	ServiceID      string
+	ServiceVersion int
+	Setup          map[string]*manifest.SetupLogger
+	Stdin          io.Reader
+	Stdout         io.Writer
+
+	// Private
+	missing  []string
+	required []Logger
 }
 
-// Logger represents the configuration parameters for creating a dictionary
+// Logger represents the configuration parameters for creating a log endpoint
 // via the API client.
 type Logger struct {
+	Name     string
 	Provider string
+
+	create func(client api.Interface) error
+}
+
+// loggerSecret is a provider setting that shouldn't be stored in the
+// fastly.toml and so is prompted for when omitted.
+type loggerSecret struct {
+	Label string
+	Value *string
+}
+
+// SupportedLogger indicates if log endpoints for the given provider can be
+// created from a [setup] configuration.
+func SupportedLogger(provider string) bool {
+	switch strings.ToLower(provider) {
+	case LoggerDatadog, LoggerHTTPS, LoggerKafka, LoggerS3, LoggerSplunk:
+		return true
+	}
+	return false
 }
 
 // Configure prompts the user for specific values related to the service resource.
+//
+// NOTE: Log endpoints for unsupported providers can't be created and so the
+// user is informed of which log endpoints they need to create themselves.
 func (l *Loggers) Configure() error {
+	var manual []string
+
+	for _, name := range l.names() {
+		settings := l.Setup[name]
+		if !SupportedLogger(settings.Provider) {
+			manual = append(manual, name)
+			continue
+		}
+
+		// NOTE: We prompt for secrets using a copy of the settings so they're
+		// never persisted if the manifest is written back to disk.
+		s := *settings
+		if err := l.promptForSecrets(name, &s); err != nil {
+			return err
+		}
+
+		create, err := l.constructInput(name, &s)
+		if err != nil {
+			return fmt.Errorf("error configuring log endpoint '%s': %w", name, err)
+		}
+
+		l.required = append(l.required, Logger{
+			Name:     name,
+			Provider: strings.ToLower(settings.Provider),
+			create:   create,
+		})
+	}
+
+	if len(manual) == 0 {
+		return nil
+	}
+
 	text.Break(l.Stdout)
 	text.Info(l.Stdout, "The package code requires the following log endpoints to be created.")
 	text.Break(l.Stdout)
 
-	for name, settings := range l.Setup {
+	for _, name := range manual {
+		settings := l.Setup[name]
 		text.Output(l.Stdout, "%s %s", text.Bold("Name:"), name)
 		if settings.Provider != "" {
 			text.Output(l.Stdout, "%s %s", text.Bold("Provider:"), settings.Provider)
@@ -45,8 +134,403 @@ func (l *Loggers) Configure() error {
 	return nil
 }
 
+// Create calls the relevant API to create the service resource(s).
+func (l *Loggers) Create() error {
+	if l.Progress == nil {
+		return errors.RemediationError{
+			Inner:       fmt.Errorf("internal logic error: no text.Progress configured for setup.Loggers"),
+			Remediation: errors.BugRemediation,
+		}
+	}
+
+	for _, logger := range l.required {
+		l.Progress.Step(fmt.Sprintf("Creating log endpoint '%s' (provider: %s)...", logger.Name, logger.Provider))
+
+		if err := logger.create(l.APIClient); err != nil {
+			l.Progress.Fail()
+			return fmt.Errorf("error creating log endpoint: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Missing indicates if there are missing resources that need to be created.
+func (l *Loggers) Missing() bool {
+	return len(l.missing) > 0
+}
+
+// MissingNames returns the sorted names of the [setup.log_endpoints] that were
+// found to be missing from the service by Validate.
+func (l *Loggers) MissingNames() []string {
+	return l.missing
+}
+
 // Predefined indicates if the service resource has been specified within the
 // fastly.toml file using a [setup] configuration block.
 func (l *Loggers) Predefined() bool {
 	return len(l.Setup) > 0
 }
+
+// Validate checks if the service has the required resources.
+//
+// NOTE: When no ServiceID is set (i.e. the service is yet to be created) every
+// [setup.log_endpoints] entry is considered missing. Log endpoints for
+// unsupported providers are always considered missing as we can't tell which
+// provider API to check.
+func (l *Loggers) Validate() error {
+	available := make(map[string]map[string]bool)
+
+	l.missing = nil
+	for _, name := range l.names() {
+		provider := strings.ToLower(l.Setup[name].Provider)
+		if l.ServiceID == "" || !SupportedLogger(provider) {
+			l.missing = append(l.missing, name)
+			continue
+		}
+		if _, ok := available[provider]; !ok {
+			names, err := l.existing(provider)
+			if err != nil {
+				return fmt.Errorf("error fetching service log endpoints: %w", err)
+			}
+			available[provider] = names
+		}
+		if !available[provider][name] {
+			l.missing = append(l.missing, name)
+		}
+	}
+	return nil
+}
+
+// existing returns the names of the service's log endpoints for provider.
+func (l *Loggers) existing(provider string) (map[string]bool, error) {
+	var names []string
+
+	switch provider {
+	case LoggerDatadog:
+		endpoints, err := l.APIClient.ListDatadog(&fastly.ListDatadogInput{ServiceID: l.ServiceID, ServiceVersion: l.ServiceVersion})
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range endpoints {
+			names = append(names, e.Name)
+		}
+	case LoggerHTTPS:
+		endpoints, err := l.APIClient.ListHTTPS(&fastly.ListHTTPSInput{ServiceID: l.ServiceID, ServiceVersion: l.ServiceVersion})
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range endpoints {
+			names = append(names, e.Name)
+		}
+	case LoggerKafka:
+		endpoints, err := l.APIClient.ListKafkas(&fastly.ListKafkasInput{ServiceID: l.ServiceID, ServiceVersion: l.ServiceVersion})
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range endpoints {
+			names = append(names, e.Name)
+		}
+	case LoggerS3:
+		endpoints, err := l.APIClient.ListS3s(&fastly.ListS3sInput{ServiceID: l.ServiceID, ServiceVersion: l.ServiceVersion})
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range endpoints {
+			names = append(names, e.Name)
+		}
+	case LoggerSplunk:
+		endpoints, err := l.APIClient.ListSplunks(&fastly.ListSplunksInput{ServiceID: l.ServiceID, ServiceVersion: l.ServiceVersion})
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range endpoints {
+			names = append(names, e.Name)
+		}
+	}
+
+	available := make(map[string]bool, len(names))
+	for _, name := range names {
+		available[name] = true
+	}
+	return available, nil
+}
+
+// names returns the sorted [setup.log_endpoints] names.
+func (l *Loggers) names() []string {
+	names := make([]string, 0, len(l.Setup))
+	for name := range l.Setup {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// promptForSecrets prompts the user for any secrets omitted from the
+// [setup.log_endpoints] settings.
+func (l *Loggers) promptForSecrets(name string, s *manifest.SetupLogger) error {
+	if l.NonInteractive {
+		return nil
+	}
+
+	var intro bool
+	for _, secret := range secrets(s) {
+		if *secret.Value != "" {
+			continue
+		}
+		if !intro {
+			text.Break(l.Stdout)
+			text.Output(l.Stdout, "Configuring log endpoint '%s' (provider: %s)", name, strings.ToLower(s.Provider))
+			if s.Description != "" {
+				text.Output(l.Stdout, s.Description)
+			}
+			text.Break(l.Stdout)
+			intro = true
+		}
+
+		value, err := text.InputSecure(l.Stdout, text.BoldYellow(fmt.Sprintf("%s: ", secret.Label)), l.Stdin)
+		if err != nil {
+			return fmt.Errorf("error reading prompt input: %w", err)
+		}
+		*secret.Value = value
+	}
+
+	return nil
+}
+
+// secrets returns the settings that are considered secret for the provider,
+// and which are relevant given the other settings (e.g. a Kafka password is
+// only needed when SASL is enabled).
+func secrets(s *manifest.SetupLogger) []loggerSecret {
+	var secrets []loggerSecret
+
+	switch strings.ToLower(s.Provider) {
+	case LoggerDatadog:
+		secrets = append(secrets, loggerSecret{"Token", &s.Token})
+	case LoggerHTTPS:
+		if s.HeaderName != "" {
+			secrets = append(secrets, loggerSecret{fmt.Sprintf("Header value (%s)", s.HeaderName), &s.HeaderValue})
+		}
+		if s.TLSClientCert != "" {
+			secrets = append(secrets, loggerSecret{"TLS client key", &s.TLSClientKey})
+		}
+	case LoggerKafka:
+		if s.UseSASL {
+			secrets = append(secrets, loggerSecret{"Password", &s.Password})
+		}
+		if s.TLSClientCert != "" {
+			secrets = append(secrets, loggerSecret{"TLS client key", &s.TLSClientKey})
+		}
+	case LoggerS3:
+		if s.AccessKey != "" {
+			secrets = append(secrets, loggerSecret{"Secret key", &s.SecretKey})
+		}
+	case LoggerSplunk:
+		secrets = append(secrets, loggerSecret{"Token", &s.Token})
+		if s.TLSClientCert != "" {
+			secrets = append(secrets, loggerSecret{"TLS client key", &s.TLSClientKey})
+		}
+	}
+
+	return secrets
+}
+
+// constructInput validates the settings using the relevant `fastly logging
+// <provider> create` command and returns a function that creates the log
+// endpoint.
+func (l *Loggers) constructInput(name string, s *manifest.SetupLogger) (func(client api.Interface) error, error) {
+	switch strings.ToLower(s.Provider) {
+	case LoggerDatadog:
+		if s.Token == "" {
+			return nil, requiredSetting("token")
+		}
+		c := datadog.CreateCommand{
+			EndpointName:      name,
+			Token:             s.Token,
+			Region:            optionalString(s.Region),
+			Format:            optionalString(s.Format),
+			FormatVersion:     optionalUint(s.FormatVersion),
+			ResponseCondition: optionalString(s.ResponseCondition),
+			Placement:         optionalString(s.Placement),
+		}
+		input, err := c.ConstructInput(l.ServiceID, l.ServiceVersion)
+		if err != nil {
+			return nil, err
+		}
+		return func(client api.Interface) error {
+			_, err := client.CreateDatadog(input)
+			return err
+		}, nil
+
+	case LoggerHTTPS:
+		if s.URL == "" {
+			return nil, requiredSetting("url")
+		}
+		c := https.CreateCommand{
+			EndpointName:      name,
+			URL:               s.URL,
+			RequestMaxEntries: optionalUint(s.RequestMaxEntries),
+			RequestMaxBytes:   optionalUint(s.RequestMaxBytes),
+			TLSCACert:         optionalString(s.TLSCACert),
+			TLSClientCert:     optionalString(s.TLSClientCert),
+			TLSClientKey:      optionalString(s.TLSClientKey),
+			TLSHostname:       optionalString(s.TLSHostname),
+			MessageType:       optionalString(s.MessageType),
+			ContentType:       optionalString(s.ContentType),
+			HeaderName:        optionalString(s.HeaderName),
+			HeaderValue:       optionalString(s.HeaderValue),
+			Method:            optionalString(s.Method),
+			JSONFormat:        optionalString(s.JSONFormat),
+			Format:            optionalString(s.Format),
+			FormatVersion:     optionalUint(s.FormatVersion),
+			Placement:         optionalString(s.Placement),
+			ResponseCondition: optionalString(s.ResponseCondition),
+		}
+		input, err := c.ConstructInput(l.ServiceID, l.ServiceVersion)
+		if err != nil {
+			return nil, err
+		}
+		return func(client api.Interface) error {
+			_, err := client.CreateHTTPS(input)
+			return err
+		}, nil
+
+	case LoggerKafka:
+		if s.Topic == "" {
+			return nil, requiredSetting("topic")
+		}
+		if s.Brokers == "" {
+			return nil, requiredSetting("brokers")
+		}
+		switch s.AuthMethod {
+		case "", "plain", "scram-sha-256", "scram-sha-512":
+		default:
+			return nil, fmt.Errorf("invalid auth_method '%s', must be one of: plain, scram-sha-256, scram-sha-512", s.AuthMethod)
+		}
+		c := kafka.CreateCommand{
+			EndpointName:      name,
+			Topic:             s.Topic,
+			Brokers:           s.Brokers,
+			UseTLS:            optionalBool(s.UseTLS),
+			CompressionCodec:  optionalString(s.CompressionCodec),
+			RequiredACKs:      optionalString(s.RequiredACKs),
+			TLSCACert:         optionalString(s.TLSCACert),
+			TLSClientCert:     optionalString(s.TLSClientCert),
+			TLSClientKey:      optionalString(s.TLSClientKey),
+			TLSHostname:       optionalString(s.TLSHostname),
+			Format:            optionalString(s.Format),
+			FormatVersion:     optionalUint(s.FormatVersion),
+			Placement:         optionalString(s.Placement),
+			ResponseCondition: optionalString(s.ResponseCondition),
+			ParseLogKeyvals:   optionalBool(s.ParseLogKeyvals),
+			RequestMaxBytes:   optionalUint(s.RequestMaxBytes),
+			UseSASL:           optionalBool(s.UseSASL),
+			AuthMethod:        optionalString(s.AuthMethod),
+			User:              optionalString(s.User),
+			Password:          optionalString(s.Password),
+		}
+		input, err := c.ConstructInput(l.ServiceID, l.ServiceVersion)
+		if err != nil {
+			return nil, err
+		}
+		return func(client api.Interface) error {
+			_, err := client.CreateKafka(input)
+			return err
+		}, nil
+
+	case LoggerS3:
+		if s.BucketName == "" {
+			return nil, requiredSetting("bucket_name")
+		}
+		if s.Redundancy != "" {
+			if _, err := s3.ValidateRedundancy(s.Redundancy); err != nil {
+				return nil, err
+			}
+		}
+		switch s.ServerSideEncryption {
+		case "", string(fastly.S3ServerSideEncryptionAES), string(fastly.S3ServerSideEncryptionKMS):
+		default:
+			return nil, fmt.Errorf("invalid server_side_encryption '%s', must be one of: %s, %s", s.ServerSideEncryption, fastly.S3ServerSideEncryptionAES, fastly.S3ServerSideEncryptionKMS)
+		}
+		c := s3.CreateCommand{
+			EndpointName:                 name,
+			BucketName:                   s.BucketName,
+			AccessKey:                    optionalString(s.AccessKey),
+			SecretKey:                    optionalString(s.SecretKey),
+			IAMRole:                      optionalString(s.IAMRole),
+			Domain:                       optionalString(s.Domain),
+			Path:                         optionalString(s.Path),
+			Period:                       optionalUint(s.Period),
+			GzipLevel:                    optionalUint8(s.GzipLevel),
+			Format:                       optionalString(s.Format),
+			FormatVersion:                optionalUint(s.FormatVersion),
+			MessageType:                  optionalString(s.MessageType),
+			ResponseCondition:            optionalString(s.ResponseCondition),
+			TimestampFormat:              optionalString(s.TimestampFormat),
+			Placement:                    optionalString(s.Placement),
+			Redundancy:                   optionalString(s.Redundancy),
+			PublicKey:                    optionalString(s.PublicKey),
+			ServerSideEncryption:         optionalString(s.ServerSideEncryption),
+			ServerSideEncryptionKMSKeyID: optionalString(s.ServerSideEncryptionKMSKeyID),
+			CompressionCodec:             optionalString(s.CompressionCodec),
+		}
+		input, err := c.ConstructInput(l.ServiceID, l.ServiceVersion)
+		if err != nil {
+			return nil, err
+		}
+		return func(client api.Interface) error {
+			_, err := client.CreateS3(input)
+			return err
+		}, nil
+
+	case LoggerSplunk:
+		if s.URL == "" {
+			return nil, requiredSetting("url")
+		}
+		c := splunk.CreateCommand{
+			EndpointName:      name,
+			URL:               s.URL,
+			TLSHostname:       optionalString(s.TLSHostname),
+			TLSCACert:         optionalString(s.TLSCACert),
+			TLSClientCert:     optionalString(s.TLSClientCert),
+			TLSClientKey:      optionalString(s.TLSClientKey),
+			Format:            optionalString(s.Format),
+			FormatVersion:     optionalUint(s.FormatVersion),
+			ResponseCondition: optionalString(s.ResponseCondition),
+			Token:             optionalString(s.Token),
+			TimestampFormat:   optionalString(s.TimestampFormat),
+			Placement:         optionalString(s.Placement),
+		}
+		input, err := c.ConstructInput(l.ServiceID, l.ServiceVersion)
+		if err != nil {
+			return nil, err
+		}
+		return func(client api.Interface) error {
+			_, err := client.CreateSplunk(input)
+			return err
+		}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported provider: %s", s.Provider)
+}
+
+// requiredSetting returns an error for a required setting that wasn't provided.
+func requiredSetting(key string) error {
+	return fmt.Errorf("required setting '%s' not provided", key)
+}
+
+func optionalString(v string) cmd.OptionalString {
+	return cmd.OptionalString{Optional: cmd.Optional{WasSet: v != ""}, Value: v}
+}
+
+func optionalUint(v uint) cmd.OptionalUint {
+	return cmd.OptionalUint{Optional: cmd.Optional{WasSet: v > 0}, Value: v}
+}
+
+func optionalUint8(v uint8) cmd.OptionalUint8 {
+	return cmd.OptionalUint8{Optional: cmd.Optional{WasSet: v > 0}, Value: v}
+}
+
+func optionalBool(v bool) cmd.OptionalBool {
+	return cmd.OptionalBool{Optional: cmd.Optional{WasSet: v}, Value: v}
+}
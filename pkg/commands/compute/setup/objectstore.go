@@ -0,0 +1,109 @@
+package setup
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v6/fastly"
+)
+
+// ObjectStores represents the account state related to object stores defined
+// within the fastly.toml [setup] configuration.
+//
+// NOTE: It implements the setup.Interface interface.
+//
+// Unlike other [setup] resources, object stores belong to the account rather
+// than a service version, and so they're shared between services.
+type ObjectStores struct {
+	// Public
+	APIClient api.Interface
+	Progress  text.Progress
+	Setup     map[string]*manifest.SetupObjectStore
+
+	// Private
+	missing  []string
+	required []string
+}
+
+// Configure prompts the user for specific values related to the service resource.
+//
+// NOTE: Validate must be called first, as only the object stores that don't
+// already exist within the account are created.
+func (o *ObjectStores) Configure() error {
+	o.required = append(o.required, o.missing...)
+	return nil
+}
+
+// Create calls the relevant API to create the service resource(s).
+func (o *ObjectStores) Create() error {
+	if o.Progress == nil {
+		return errors.RemediationError{
+			Inner:       fmt.Errorf("internal logic error: no text.Progress configured for setup.ObjectStores"),
+			Remediation: errors.BugRemediation,
+		}
+	}
+
+	for _, name := range o.required {
+		o.Progress.Step(fmt.Sprintf("Creating object store '%s'...", name))
+
+		_, err := o.APIClient.CreateObjectStore(&fastly.CreateObjectStoreInput{
+			Name: name,
+		})
+		if err != nil {
+			o.Progress.Fail()
+			return fmt.Errorf("error creating object store: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Missing indicates if there are missing resources that need to be created.
+func (o *ObjectStores) Missing() bool {
+	return len(o.missing) > 0
+}
+
+// MissingNames returns the sorted names of the [setup.object_stores] that were
+// found to be missing from the account by Validate.
+func (o *ObjectStores) MissingNames() []string {
+	return o.missing
+}
+
+// Predefined indicates if the service resource has been specified within the
+// fastly.toml file using a [setup] configuration block.
+func (o *ObjectStores) Predefined() bool {
+	return len(o.Setup) > 0
+}
+
+// Validate checks if the account has the required resources.
+func (o *ObjectStores) Validate() error {
+	available := make(map[string]bool)
+	if o.Predefined() {
+		input := fastly.ListObjectStoresInput{}
+		for {
+			resp, err := o.APIClient.ListObjectStores(&input)
+			if err != nil {
+				return fmt.Errorf("error fetching object stores: %w", err)
+			}
+			for _, store := range resp.Data {
+				available[store.Name] = true
+			}
+			if input.Cursor = resp.Meta["next_cursor"]; input.Cursor == "" {
+				break
+			}
+		}
+	}
+
+	o.missing = nil
+	for name := range o.Setup {
+		if !available[name] {
+			o.missing = append(o.missing, name)
+		}
+	}
+	sort.Strings(o.missing)
+	return nil
+}
@@ -0,0 +1,300 @@
+package compute
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/compute/fixture"
+	"github.com/fastly/cli/pkg/commands/update"
+	"github.com/fastly/cli/pkg/config"
+	fsterr "github.com/fastly/cli/pkg/errors"
+	fstexec "github.com/fastly/cli/pkg/exec"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/cli/pkg/threadsafe"
+)
+
+// viceroyStartupTimeout is how long to wait for the local server to accept
+// connections before giving up.
+var viceroyStartupTimeout = 30 * time.Second
+
+// fixtureRequestTimeout is how long to wait for each fixture response.
+var fixtureRequestTimeout = 30 * time.Second
+
+// TestCommand builds a Compute@Edge package and runs request fixtures against
+// it using a local server.
+type TestCommand struct {
+	cmd.Base
+	manifest         manifest.Data
+	build            *BuildCommand
+	viceroyVersioner update.Versioner
+
+	// Build fields
+	cache            cmd.OptionalBool
+	includeSrc       cmd.OptionalBool
+	lang             cmd.OptionalString
+	skipVerification cmd.OptionalBool
+	timeout          cmd.OptionalInt
+
+	// Test fields
+	addr      string
+	env       cmd.OptionalString
+	file      string
+	fixtures  string
+	junit     string
+	skipBuild bool
+}
+
+// NewTestCommand returns a usable command registered under the parent.
+func NewTestCommand(parent cmd.Registerer, globals *config.Data, build *BuildCommand, viceroyVersioner update.Versioner, data manifest.Data) *TestCommand {
+	var c TestCommand
+
+	c.build = build
+	c.viceroyVersioner = viceroyVersioner
+
+	c.Globals = globals
+	c.CmdClause = parent.Command("test", "Build a Compute@Edge package and run request fixtures against it locally")
+	c.manifest = data
+
+	c.CmdClause.Flag("addr", "The IPv4 address and port for the local server to listen on").Default("127.0.0.1:7677").StringVar(&c.addr)
+	c.CmdClause.Flag("cache", "Reuse the previous build when no changes were detected (use --no-cache to force a rebuild)").Default("true").Action(c.cache.Set).NegatableBoolVar(&c.cache.Value)
+	c.CmdClause.Flag("env", flagEnvDesc).Action(c.env.Set).StringVar(&c.env.Value)
+	c.CmdClause.Flag("file", "The Wasm file to run").Default("bin/main.wasm").StringVar(&c.file)
+	c.CmdClause.Flag("fixtures", "Path to the request fixtures file").Default(fixture.DefaultFilename).StringVar(&c.fixtures)
+	c.CmdClause.Flag("include-source", "Include source code in built package").Action(c.includeSrc.Set).BoolVar(&c.includeSrc.Value)
+	c.CmdClause.Flag("junit", "Path to write a JUnit XML report of the results").StringVar(&c.junit)
+	c.CmdClause.Flag("language", "Language type").Action(c.lang.Set).StringVar(&c.lang.Value)
+	c.CmdClause.Flag("skip-build", "Skip the build step").BoolVar(&c.skipBuild)
+	c.CmdClause.Flag("skip-verification", "Skip verification steps and force build").Action(c.skipVerification.Set).BoolVar(&c.skipVerification.Value)
+	c.CmdClause.Flag("timeout", "Timeout, in seconds, for the build compilation step").Action(c.timeout.Set).IntVar(&c.timeout.Value)
+
+	return &c
+}
+
+// Exec implements the command interface.
+func (c *TestCommand) Exec(in io.Reader, out io.Writer) (err error) {
+	// NOTE: We read the fixtures before building so a mistake in the fixtures
+	// file is reported without waiting on a potentially slow build.
+	fixtures, err := fixture.Read(c.fixtures)
+	if err != nil {
+		c.Globals.ErrLog.Add(err)
+		return fsterr.RemediationError{
+			Inner:       fmt.Errorf("error reading fixtures: %w", err),
+			Remediation: fmt.Sprintf("Ensure the fixtures file exists (default: %s) and defines at least one [[tests]] entry with a 'path', or use the --fixtures flag.", fixture.DefaultFilename),
+		}
+	}
+
+	if !c.skipBuild {
+		err = c.Build(in, out)
+		if err != nil {
+			return err
+		}
+	}
+
+	progress := text.ResetProgress(out, c.Globals.Verbose())
+
+	bin, err := GetViceroy(progress, out, c.viceroyVersioner, c.Globals)
+	if err != nil {
+		return err
+	}
+
+	progress.Step("Starting local server...")
+
+	// NOTE: Only a server we start ourselves should receive the test requests.
+	if conn, err := net.DialTimeout("tcp", c.addr, time.Second); err == nil {
+		conn.Close()
+		progress.Fail()
+		return fsterr.RemediationError{
+			Inner:       fmt.Errorf("address %s is already in use", c.addr),
+			Remediation: "Stop the process listening on the address (e.g. `fastly compute serve`) or use the --addr flag.",
+		}
+	}
+
+	manifestPath, cleanup, err := localManifest(recordingOff, "", c.Globals.Verbose(), out)
+	if err != nil {
+		progress.Fail()
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+	defer cleanup()
+
+	// The local server output is only displayed if it fails to start, unless
+	// the --verbose flag is set.
+	var serverOutput threadsafe.Buffer
+	s := viceroy(bin, c.file, c.addr, manifestPath, false, c.Globals.Verbose(), out)
+	if !c.Globals.Verbose() {
+		s.Output = &serverOutput
+	}
+	s.MonitorSignals()
+
+	var execErr error
+	exited := make(chan struct{})
+	go func() {
+		execErr = s.Exec()
+		close(exited)
+	}()
+
+	err = waitForServer(c.addr, exited, viceroyStartupTimeout)
+	if err != nil {
+		progress.Fail()
+		stopServer(s, exited)
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Address":       c.addr,
+			"Server error":  execErr,
+			"Server output": serverOutput.String(),
+		})
+		if serverOutput.Len() > 0 {
+			text.Break(out)
+			fmt.Fprintln(out, strings.TrimSpace(serverOutput.String()))
+		}
+		return err
+	}
+
+	progress.Step("Running request fixtures...")
+	client := &http.Client{
+		Timeout: fixtureRequestTimeout,
+		// NOTE: Redirects are returned so their status can be checked.
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	results := fixture.Run(client, "http://"+c.addr, fixtures.Tests)
+	progress.Done()
+
+	stopServer(s, exited)
+
+	if c.junit != "" {
+		if err := writeJUnit(c.junit, c.fixtures, results); err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]any{
+				"JUnit path": c.junit,
+			})
+			return err
+		}
+	}
+
+	return displayFixtureResults(out, results)
+}
+
+// Build constructs and executes the build logic.
+func (c *TestCommand) Build(in io.Reader, out io.Writer) error {
+	// Reset the fields on the BuildCommand based on TestCommand values.
+	if c.cache.WasSet {
+		c.build.Flags.NoCache = !c.cache.Value
+	}
+	if c.env.WasSet {
+		c.build.Flags.Env = c.env.Value
+	}
+	if c.includeSrc.WasSet {
+		c.build.Flags.IncludeSrc = c.includeSrc.Value
+	}
+	if c.lang.WasSet {
+		c.build.Flags.Lang = c.lang.Value
+	}
+	if c.skipVerification.WasSet {
+		c.build.Flags.SkipVerification = c.skipVerification.Value
+	}
+	if c.timeout.WasSet {
+		c.build.Flags.Timeout = c.timeout.Value
+	}
+
+	err := c.build.Exec(in, out)
+	if err != nil {
+		return err
+	}
+
+	text.Break(out)
+
+	return nil
+}
+
+// waitForServer blocks until the local server accepts connections on addr.
+//
+// NOTE: An error is returned if the server exits or the timeout elapses first.
+func waitForServer(addr string, exited <-chan struct{}, timeout time.Duration) error {
+	deadline := time.After(timeout)
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-exited:
+			return errors.New("local server stopped before accepting requests")
+		case <-deadline:
+			return fmt.Errorf("local server didn't accept requests on %s within %s", addr, timeout)
+		case <-ticker.C:
+			conn, err := net.DialTimeout("tcp", addr, time.Second)
+			if err == nil {
+				conn.Close()
+				return nil
+			}
+		}
+	}
+}
+
+// stopServer terminates the local server and waits for it to exit.
+//
+// NOTE: Sending a signal to the SignalCh causes the listener configured by
+// (*fstexec.Streaming).MonitorSignals() to kill the process and stop
+// listening. The process is killed directly if it's still running after that,
+// as the listener can only kill a process that has finished starting.
+func stopServer(s *fstexec.Streaming, exited <-chan struct{}) {
+	select {
+	case s.SignalCh <- syscall.SIGTERM:
+	default:
+	}
+	for {
+		select {
+		case <-exited:
+			return
+		case <-time.After(time.Second):
+			_ = s.Signal(os.Kill)
+		}
+	}
+}
+
+// writeJUnit writes the results as a JUnit XML report to path.
+func writeJUnit(path, suite string, results []fixture.Result) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("error creating JUnit report: %w", err)
+	}
+	if err := fixture.WriteJUnit(f, suite, results); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// displayFixtureResults reports the result of each fixture, returning an
+// error if any of them failed so the command exits with a non-zero status.
+func displayFixtureResults(out io.Writer, results []fixture.Result) error {
+	text.Break(out)
+
+	var failed int
+	for _, r := range results {
+		if r.Passed() {
+			text.Output(out, "%s %s (%s)", text.BoldGreen("PASS"), r.Name, r.Duration.Round(time.Millisecond))
+			continue
+		}
+		failed++
+		text.Output(out, "%s %s (%s)", text.BoldRed("FAIL"), r.Name, r.Duration.Round(time.Millisecond))
+		for _, f := range r.Failures {
+			text.Indent(out, 4, "%s", f)
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d tests failed", failed, len(results))
+	}
+
+	text.Success(out, "%d tests passed", len(results))
+	return nil
+}
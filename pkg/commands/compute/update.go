@@ -15,6 +15,7 @@ import (
 // UpdateCommand calls the Fastly API to update packages.
 type UpdateCommand struct {
 	cmd.Base
+	env            string
 	manifest       manifest.Data
 	path           string
 	serviceName    cmd.OptionalServiceNameID
@@ -50,6 +51,7 @@ func NewUpdateCommand(parent cmd.Registerer, globals *config.Data, data manifest
 		Action: c.autoClone.Set,
 		Dst:    &c.autoClone.Value,
 	})
+	c.CmdClause.Flag("env", flagEnvDesc).StringVar(&c.env)
 	c.CmdClause.Flag("package", "Path to a package tar.gz").Required().Short('p').StringVar(&c.path)
 	return &c
 }
@@ -61,6 +63,12 @@ func (c *UpdateCommand) Exec(_ io.Reader, out io.Writer) (err error) {
 		return errors.ErrNoToken
 	}
 
+	err = applyEnvironment(&c.manifest, c.env)
+	if err != nil {
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+
 	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
 		AutoCloneFlag:      c.autoClone,
 		APIClient:          c.Globals.APIClient,
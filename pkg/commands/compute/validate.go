@@ -1,23 +1,35 @@
 package compute
 
 import (
+	"bytes"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/compute/abi"
+	"github.com/fastly/cli/pkg/commands/compute/wasm"
 	"github.com/fastly/cli/pkg/config"
+	fsterr "github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/manifest"
 	"github.com/fastly/cli/pkg/text"
 	"github.com/mholt/archiver/v3"
+	toml "github.com/pelletier/go-toml"
 )
 
+// flagSkipABIDesc is the description of the --skip-abi flag shared by the
+// compute commands that validate a package.
+const flagSkipABIDesc = "Skip checking the Wasm binary's imports against the Compute@Edge host functions known to the CLI (e.g. when the SDK is newer than the CLI)"
+
 // NewValidateCommand returns a usable command registered under the parent.
 func NewValidateCommand(parent cmd.Registerer, globals *config.Data) *ValidateCommand {
 	var c ValidateCommand
 	c.Globals = globals
 	c.CmdClause = parent.Command("validate", "Validate a Compute@Edge package")
 	c.CmdClause.Flag("package", "Path to a package tar.gz").Required().Short('p').StringVar(&c.path)
+	c.CmdClause.Flag("skip-abi", flagSkipABIDesc).BoolVar(&c.skipABI)
 	return &c
 }
 
@@ -31,13 +43,26 @@ func (c *ValidateCommand) Exec(_ io.Reader, out io.Writer) error {
 		return fmt.Errorf("error reading file path: %w", err)
 	}
 
-	if err := validate(p, nil); err != nil {
+	contents := map[string]*bytes.Buffer{
+		"fastly.toml": {},
+		"main.wasm":   {},
+	}
+	if err := validate(p, collectFiles(contents)); err != nil {
 		c.Globals.ErrLog.AddWithContext(err, map[string]any{
 			"Path": c.path,
 		})
 		return err
 	}
 
+	if !c.skipABI {
+		if err := validateHostABI(contents["fastly.toml"].Bytes(), contents["main.wasm"].Bytes(), true, c.Globals.Verbose(), out); err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]any{
+				"Path": c.path,
+			})
+			return err
+		}
+	}
+
 	text.Success(out, "Validated package %s", p)
 	return nil
 }
@@ -45,12 +70,27 @@ func (c *ValidateCommand) Exec(_ io.Reader, out io.Writer) error {
 // ValidateCommand validates a package archive.
 type ValidateCommand struct {
 	cmd.Base
-	path string
+	path    string
+	skipABI bool
 }
 
 // FileValidator validates a file.
 type FileValidator func(archiver.File) error
 
+// collectFiles returns a FileValidator that copies the contents of the named
+// files into their buffer.
+func collectFiles(contents map[string]*bytes.Buffer) FileValidator {
+	return func(f archiver.File) error {
+		fname := f.Name()
+		if buf, ok := contents[fname]; ok {
+			if _, err := io.Copy(buf, f); err != nil {
+				return fmt.Errorf("error reading %s: %w", fname, err)
+			}
+		}
+		return nil
+	}
+}
+
 // validate is a utility function to determine whether a package is valid.
 // It attempts to unarchive and read a tar.gz file from a specific path,
 // if successful, it then iterates through (streams) each file in the archive
@@ -115,3 +155,67 @@ func validate(path string, fileValidator FileValidator) error {
 
 	return nil
 }
+
+// validateHostABI checks the Wasm binary against the Compute@Edge host
+// functions bundled with the CLI. Each imported function must be a known host
+// function and, when the manifest declares an sdk_version, one that's available
+// to that release of the SDK. The Wasm binary must also export an entry point.
+//
+// NOTE: The list of host functions is bundled with the CLI, and so a package
+// compiled with an SDK newer than the CLI may import functions it doesn't know.
+// Unless strict is set, such imports are reported as warnings and only a
+// missing entry point is an error. The --skip-abi flag skips the check.
+func validateHostABI(manifestData, wasmData []byte, strict, verbose bool, out io.Writer) error {
+	m, err := wasm.Parse(wasmData)
+	if err != nil {
+		return fmt.Errorf("error validating package: error parsing main.wasm: %w", err)
+	}
+
+	if !abi.HasEntryPoint(m) {
+		return fsterr.RemediationError{
+			Inner:       fmt.Errorf("error validating package: main.wasm doesn't export a %s function", abi.EntryPoint),
+			Remediation: "Compile the package with a supported Compute@Edge SDK, which provides the entry point.",
+		}
+	}
+
+	spec, err := abi.Load()
+	if err != nil {
+		return err
+	}
+
+	var f manifest.File
+	if err := toml.Unmarshal(manifestData, &f); err != nil {
+		return fmt.Errorf("error validating package: error parsing fastly.toml: %w", err)
+	}
+
+	var version int
+	if f.SDKVersion != "" {
+		version, err = spec.SDKVersion(f.Language, f.SDKVersion)
+		switch {
+		case err != nil && strict:
+			return fsterr.RemediationError{
+				Inner:       fmt.Errorf("error validating package: %w", err),
+				Remediation: "Check the fastly.toml `language` and `sdk_version` fields describe the SDK the package was compiled with, or update the CLI to the latest version.",
+			}
+		case err != nil:
+			text.Warning(out, "Imports are checked against the latest ABI version (%d): %s", spec.Latest(), err)
+		case verbose:
+			text.Info(out, "Checking imports against ABI version %d (%s SDK %s)", version, f.Language, f.SDKVersion)
+		}
+	} else if verbose {
+		text.Info(out, "The fastly.toml doesn't declare an sdk_version, so imports are only checked against the latest ABI version (%d)", spec.Latest())
+	}
+
+	problems := spec.CheckImports(m, version)
+	if len(problems) == 0 {
+		return nil
+	}
+	if strict {
+		return fsterr.RemediationError{
+			Inner:       fmt.Errorf("error validating package: incompatible with the Compute@Edge host: %s", strings.Join(problems, ", ")),
+			Remediation: "Compile the package with a supported Compute@Edge SDK, and check the fastly.toml `sdk_version` matches it. If the SDK is newer than the CLI, update the CLI to the latest version, or use the --skip-abi flag to skip this check.",
+		}
+	}
+	text.Warning(out, "The package may be incompatible with the Compute@Edge host (%s). If the SDK is newer than the CLI, update the CLI to the latest version.", strings.Join(problems, ", "))
+	return nil
+}
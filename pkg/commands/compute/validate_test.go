@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/manifest"
 	"github.com/fastly/cli/pkg/testutil"
 )
 
@@ -58,3 +59,204 @@ func TestValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateHostABI(t *testing.T) {
+	args := testutil.Args
+	scenarios := []struct {
+		name     string
+		manifest string
+		imports  [][2]string
+		memory   bool
+		noStart  bool
+		skipABI  bool
+		verbose  bool
+		// wantError is the error returned by `compute validate`, which is only
+		// a warning for `compute deploy` unless wantDeployError is set.
+		wantError       string
+		wantDeployError bool
+		wantOut         string
+	}{
+		{
+			name:     "known imports without sdk_version",
+			manifest: `language = "rust"`,
+			imports:  [][2]string{{"fastly_http_req", "send"}, {"fastly_secret_store", "get"}},
+			verbose:  true,
+			wantOut:  "doesn't declare an sdk_version",
+		},
+		{
+			name:     "imports supported by sdk_version",
+			manifest: "language = \"rust\"\nsdk_version = \"0.9.1\"",
+			imports:  [][2]string{{"fastly_abi", "init"}, {"fastly_secret_store", "get"}},
+			verbose:  true,
+			wantOut:  "Checking imports against ABI version 3 (rust SDK 0.9.1)",
+		},
+		{
+			name:     "imported memory",
+			manifest: `language = "rust"`,
+			imports:  [][2]string{{"fastly_abi", "init"}},
+			memory:   true,
+		},
+		{
+			name:     "rust sdk imports",
+			manifest: "language = \"rust\"\nsdk_version = \"0.9.1\"",
+			imports: [][2]string{
+				{"fastly_http_req", "downstream_tls_ja3_md5"},
+				{"fastly_http_req", "downstream_tls_raw_client_certificate"},
+				{"fastly_http_req", "downstream_tls_client_cert_verify_result"},
+				{"fastly_http_req", "downstream_server_ip_addr"},
+				{"fastly_http_req", "redirect_to_websocket_proxy"},
+				{"fastly_http_req", "redirect_to_grip_proxy"},
+				{"fastly_http_body", "known_length"},
+				{"fastly_http_body", "trailer_append"},
+				{"fastly_config_store", "open"},
+				{"fastly_config_store", "get"},
+				{"fastly_backend", "get_host"},
+				{"fastly_backend", "is_ssl"},
+				{"fastly_cache", "transaction_lookup"},
+				{"fastly_erl", "check_rate"},
+				{"fastly_device_detection", "lookup"},
+			},
+		},
+		{
+			name:     "assemblyscript sdk imports",
+			manifest: `language = "assemblyscript"`,
+			imports:  [][2]string{{"env", "abort"}, {"fastly_http_req", "send"}},
+		},
+		{
+			name:      "import newer than sdk_version",
+			manifest:  "language = \"rust\"\nsdk_version = \"0.8.0\"",
+			imports:   [][2]string{{"fastly_http_req", "send"}, {"fastly_secret_store", "get"}},
+			wantError: "import fastly_secret_store.get requires ABI version 3, but the SDK supports ABI version 1",
+		},
+		{
+			name:      "unknown import",
+			manifest:  `language = "rust"`,
+			imports:   [][2]string{{"fastly_http_req", "teleport"}, {"env", "seed"}},
+			wantError: "unknown import fastly_http_req.teleport, unknown import env.seed",
+		},
+		{
+			name:     "unknown import with --skip-abi",
+			manifest: `language = "rust"`,
+			imports:  [][2]string{{"fastly_http_req", "teleport"}},
+			skipABI:  true,
+		},
+		{
+			name:            "missing entry point",
+			manifest:        `language = "go"`,
+			imports:         [][2]string{{"wasi_snapshot_preview1", "fd_write"}},
+			noStart:         true,
+			wantError:       "main.wasm doesn't export a _start function",
+			wantDeployError: true,
+		},
+		{
+			name:      "unknown sdk_version",
+			manifest:  "language = \"other\"\nsdk_version = \"1.0.0\"",
+			wantError: "no known ABI version for the other SDK version 1.0.0",
+		},
+	}
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			// We're going to chdir to a test environment,
+			// so save the PWD to return to, afterwards.
+			pwd, err := os.Getwd()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rootdir := testutil.NewEnv(testutil.EnvOpts{
+				T: t,
+				Write: []testutil.FileIO{
+					{Src: "manifest_version = 2\nname = \"abi\"\n" + s.manifest + "\n", Dst: manifest.Filename},
+					{Src: string(wasmModule(s.imports, s.memory, !s.noStart)), Dst: "main.wasm"},
+				},
+			})
+			defer os.RemoveAll(rootdir)
+
+			if err := os.Chdir(rootdir); err != nil {
+				t.Fatal(err)
+			}
+			defer os.Chdir(pwd)
+
+			var stdout bytes.Buffer
+			if err := app.Run(testutil.NewRunOpts(args("compute pack --wasm-binary ./main.wasm"), &stdout)); err != nil {
+				t.Fatal(err)
+			}
+
+			cmd := "compute validate --package pkg/package.tar.gz"
+			if s.skipABI {
+				cmd += " --skip-abi"
+			}
+			if s.verbose {
+				cmd += " --verbose"
+			}
+			stdout.Reset()
+			err = app.Run(testutil.NewRunOpts(args(cmd), &stdout))
+			t.Log(stdout.String())
+			testutil.AssertErrorContains(t, err, s.wantError)
+			if s.wantError == "" {
+				testutil.AssertStringContains(t, stdout.String(), "Validated package")
+				testutil.AssertStringContains(t, stdout.String(), s.wantOut)
+				return
+			}
+
+			// Deploy only fails before uploading a package that can't be run, and
+			// otherwise reports the problem as a warning.
+			stdout.Reset()
+			err = app.Run(testutil.NewRunOpts(args("compute deploy --token 123 --dry-run --package pkg/package.tar.gz"), &stdout))
+			t.Log(stdout.String())
+			if s.wantDeployError {
+				testutil.AssertErrorContains(t, err, s.wantError)
+				return
+			}
+			testutil.AssertNoError(t, err)
+			testutil.AssertStringContains(t, stdout.String(), s.wantError)
+		})
+	}
+}
+
+// wasmModule returns a Wasm binary that imports the given (module, name)
+// functions, and optionally imports a memory and exports a _start function.
+func wasmModule(imports [][2]string, memory, start bool) []byte {
+	uleb := func(n int) []byte {
+		var b []byte
+		for {
+			c := byte(n & 0x7f)
+			n >>= 7
+			if n == 0 {
+				return append(b, c)
+			}
+			b = append(b, c|0x80)
+		}
+	}
+	vec := func(items ...[]byte) []byte {
+		b := uleb(len(items))
+		for _, item := range items {
+			b = append(b, item...)
+		}
+		return b
+	}
+	name := func(s string) []byte {
+		return append(uleb(len(s)), s...)
+	}
+	section := func(id byte, content []byte) []byte {
+		return append(append([]byte{id}, uleb(len(content))...), content...)
+	}
+
+	m := []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+	m = append(m, section(1, vec([]byte{0x60, 0x00, 0x00}))...) // () -> ()
+
+	var imps [][]byte
+	for _, imp := range imports {
+		imps = append(imps, append(append(name(imp[0]), name(imp[1])...), 0x00, 0x00))
+	}
+	if memory {
+		imps = append(imps, append(append(name("env"), name("memory")...), 0x02, 0x00, 0x01))
+	}
+	m = append(m, section(2, vec(imps...))...)
+	m = append(m, section(3, vec([]byte{0x00}))...)
+	if start {
+		m = append(m, section(7, vec(append(append(name("_start"), 0x00), uleb(len(imports))...)))...)
+	}
+	m = append(m, section(10, vec([]byte{0x02, 0x00, 0x0b}))...)
+	return m
+}
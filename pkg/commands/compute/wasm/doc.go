@@ -0,0 +1,4 @@
+// Package wasm contains a minimal parser for WebAssembly binary modules,
+// sufficient to describe the structure of a Compute@Edge package's Wasm binary
+// (its sections, imports, exports, function bodies and data segments).
+package wasm
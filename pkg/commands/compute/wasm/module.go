@@ -0,0 +1,437 @@
+package wasm
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// Section IDs as defined by the WebAssembly binary format.
+const (
+	sectionCustom = 0
+	sectionType   = 1
+	sectionImport = 2
+	sectionExport = 7
+	sectionCode   = 10
+	sectionData   = 11
+)
+
+// sectionNames are the names of the known section IDs.
+var sectionNames = map[byte]string{
+	0:  "custom",
+	1:  "type",
+	2:  "import",
+	3:  "function",
+	4:  "table",
+	5:  "memory",
+	6:  "global",
+	7:  "export",
+	8:  "start",
+	9:  "element",
+	10: "code",
+	11: "data",
+	12: "datacount",
+	13: "tag",
+}
+
+// externalKinds are the names of the kinds of import and export.
+var externalKinds = map[byte]string{
+	0: "func",
+	1: "table",
+	2: "memory",
+	3: "global",
+	4: "tag",
+}
+
+// valueTypes are the names of the value types used in function signatures.
+var valueTypes = map[byte]string{
+	0x7f: "i32",
+	0x7e: "i64",
+	0x7d: "f32",
+	0x7c: "f64",
+	0x7b: "v128",
+	0x70: "funcref",
+	0x6f: "externref",
+}
+
+// magic is the preamble of every WebAssembly binary module.
+var magic = []byte{0x00, 0x61, 0x73, 0x6d}
+
+// Module describes the structure of a WebAssembly module.
+type Module struct {
+	Size      int           `json:"size"`
+	Sections  []Section     `json:"sections"`
+	Imports   []Import      `json:"imports"`
+	Exports   []Export      `json:"exports"`
+	Functions []Function    `json:"functions"`
+	Data      []DataSegment `json:"data"`
+}
+
+// Section describes a section of a module.
+//
+// NOTE: Custom sections are identified by their name (e.g. "name",
+// "producers", ".debug_info").
+type Section struct {
+	ID   byte   `json:"id"`
+	Name string `json:"name"`
+	Size int    `json:"size"`
+}
+
+// Import describes an imported function, table, memory, global or tag.
+type Import struct {
+	Module    string `json:"module"`
+	Name      string `json:"name"`
+	Kind      string `json:"kind"`
+	Signature string `json:"signature,omitempty"`
+}
+
+// Export describes an exported function, table, memory, global or tag.
+type Export struct {
+	Name  string `json:"name"`
+	Kind  string `json:"kind"`
+	Index uint32 `json:"index"`
+}
+
+// Function describes a function defined (rather than imported) by a module.
+//
+// NOTE: The name is only known when the module has a "name" custom section.
+type Function struct {
+	Index uint32 `json:"index"`
+	Name  string `json:"name,omitempty"`
+	Size  int    `json:"size"`
+}
+
+// DataSegment describes a data segment.
+//
+// NOTE: The offset is only known for an active segment whose offset is a
+// constant.
+type DataSegment struct {
+	Index  int    `json:"index"`
+	Mode   string `json:"mode"`
+	Offset *int64 `json:"offset,omitempty"`
+	Size   int    `json:"size"`
+}
+
+// Parse decodes the structure of a WebAssembly binary module.
+func Parse(data []byte) (*Module, error) {
+	if len(data) < 8 || !bytes.Equal(data[:4], magic) {
+		return nil, fmt.Errorf("not a WebAssembly binary module")
+	}
+	if data[4] != 0x01 || data[5] != 0 || data[6] != 0 || data[7] != 0 {
+		return nil, fmt.Errorf("unsupported WebAssembly binary version %d", data[4])
+	}
+
+	m := &Module{
+		Size:      len(data),
+		Sections:  []Section{},
+		Imports:   []Import{},
+		Exports:   []Export{},
+		Functions: []Function{},
+		Data:      []DataSegment{},
+	}
+
+	var (
+		signatures    []string
+		importedFuncs uint32
+		names         map[uint32]string
+	)
+
+	r := &reader{data: data, pos: 8}
+	for r.len() > 0 {
+		id, err := r.byte()
+		if err != nil {
+			return nil, err
+		}
+		size, err := r.u32()
+		if err != nil {
+			return nil, err
+		}
+		content, err := r.bytes(int(size))
+		if err != nil {
+			return nil, fmt.Errorf("error reading section %d: %w", id, err)
+		}
+
+		s := Section{ID: id, Name: sectionNames[id], Size: int(size)}
+		if s.Name == "" {
+			s.Name = fmt.Sprintf("unknown (%d)", id)
+		}
+		sr := &reader{data: content}
+
+		switch id {
+		case sectionCustom:
+			name, err := sr.name()
+			if err != nil {
+				return nil, fmt.Errorf("error reading custom section: %w", err)
+			}
+			s.Name = name
+			if name == "name" {
+				// NOTE: The name section is only informational, so a malformed one
+				// is ignored rather than failing the parse.
+				names, _ = functionNames(&reader{data: sr.data[sr.pos:]})
+			}
+		case sectionType:
+			signatures, err = parseTypes(sr)
+		case sectionImport:
+			m.Imports, err = parseImports(sr, signatures)
+			for _, imp := range m.Imports {
+				if imp.Kind == externalKinds[0] {
+					importedFuncs++
+				}
+			}
+		case sectionExport:
+			m.Exports, err = parseExports(sr)
+		case sectionCode:
+			m.Functions, err = parseCode(sr, importedFuncs)
+		case sectionData:
+			m.Data, err = parseData(sr)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error reading %s section: %w", s.Name, err)
+		}
+
+		m.Sections = append(m.Sections, s)
+	}
+
+	for i, f := range m.Functions {
+		m.Functions[i].Name = names[f.Index]
+	}
+
+	return m, nil
+}
+
+// parseTypes returns the signature of each function type.
+func parseTypes(r *reader) ([]string, error) {
+	n, err := r.u32()
+	if err != nil {
+		return nil, err
+	}
+	signatures := make([]string, 0, r.capacity(n))
+	for i := uint32(0); i < n; i++ {
+		form, err := r.byte()
+		if err != nil {
+			return nil, err
+		}
+		if form != 0x60 {
+			return nil, fmt.Errorf("unsupported type form 0x%02x", form)
+		}
+		params, err := valueTypeList(r)
+		if err != nil {
+			return nil, err
+		}
+		results, err := valueTypeList(r)
+		if err != nil {
+			return nil, err
+		}
+		signatures = append(signatures, fmt.Sprintf("(%s) -> (%s)", params, results))
+	}
+	return signatures, nil
+}
+
+// valueTypeList reads a vector of value types as a comma separated list.
+func valueTypeList(r *reader) (string, error) {
+	n, err := r.u32()
+	if err != nil {
+		return "", err
+	}
+	types := make([]string, 0, r.capacity(n))
+	for i := uint32(0); i < n; i++ {
+		t, err := r.byte()
+		if err != nil {
+			return "", err
+		}
+		name, ok := valueTypes[t]
+		if !ok {
+			name = fmt.Sprintf("0x%02x", t)
+		}
+		types = append(types, name)
+	}
+	return strings.Join(types, ", "), nil
+}
+
+// parseImports returns the imports, with the signature of imported functions.
+func parseImports(r *reader, signatures []string) ([]Import, error) {
+	n, err := r.u32()
+	if err != nil {
+		return nil, err
+	}
+	imports := make([]Import, 0, r.capacity(n))
+	for i := uint32(0); i < n; i++ {
+		var imp Import
+		if imp.Module, err = r.name(); err != nil {
+			return nil, err
+		}
+		if imp.Name, err = r.name(); err != nil {
+			return nil, err
+		}
+		kind, err := r.byte()
+		if err != nil {
+			return nil, err
+		}
+		imp.Kind = externalKinds[kind]
+
+		switch kind {
+		case 0x00: // func
+			idx, err := r.u32()
+			if err != nil {
+				return nil, err
+			}
+			if int(idx) < len(signatures) {
+				imp.Signature = signatures[idx]
+			}
+		case 0x01: // table
+			if _, err := r.byte(); err != nil {
+				return nil, err
+			}
+			err = r.limits()
+		case 0x02: // memory
+			err = r.limits()
+		case 0x03: // global
+			_, err = r.bytes(2)
+		case 0x04: // tag
+			if _, err = r.byte(); err == nil {
+				_, err = r.u32()
+			}
+		default:
+			return nil, fmt.Errorf("unsupported import kind 0x%02x", kind)
+		}
+		if err != nil {
+			return nil, err
+		}
+		imports = append(imports, imp)
+	}
+	return imports, nil
+}
+
+// parseExports returns the exports.
+func parseExports(r *reader) ([]Export, error) {
+	n, err := r.u32()
+	if err != nil {
+		return nil, err
+	}
+	exports := make([]Export, 0, r.capacity(n))
+	for i := uint32(0); i < n; i++ {
+		var e Export
+		if e.Name, err = r.name(); err != nil {
+			return nil, err
+		}
+		kind, err := r.byte()
+		if err != nil {
+			return nil, err
+		}
+		e.Kind = externalKinds[kind]
+		if e.Kind == "" {
+			return nil, fmt.Errorf("unsupported export kind 0x%02x", kind)
+		}
+		if e.Index, err = r.u32(); err != nil {
+			return nil, err
+		}
+		exports = append(exports, e)
+	}
+	return exports, nil
+}
+
+// parseCode returns the size of each function body.
+//
+// NOTE: Defined functions are indexed after the imported functions.
+func parseCode(r *reader, importedFuncs uint32) ([]Function, error) {
+	n, err := r.u32()
+	if err != nil {
+		return nil, err
+	}
+	functions := make([]Function, 0, r.capacity(n))
+	for i := uint32(0); i < n; i++ {
+		size, err := r.u32()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := r.bytes(int(size)); err != nil {
+			return nil, err
+		}
+		functions = append(functions, Function{
+			Index: importedFuncs + i,
+			Size:  int(size),
+		})
+	}
+	return functions, nil
+}
+
+// parseData returns the data segments.
+func parseData(r *reader) ([]DataSegment, error) {
+	n, err := r.u32()
+	if err != nil {
+		return nil, err
+	}
+	segments := make([]DataSegment, 0, r.capacity(n))
+	for i := 0; i < int(n); i++ {
+		flags, err := r.u32()
+		if err != nil {
+			return nil, err
+		}
+		d := DataSegment{Index: i, Mode: "active"}
+		switch flags {
+		case 0:
+			d.Offset, err = r.constExpr()
+		case 1:
+			d.Mode = "passive"
+		case 2:
+			if _, err = r.u32(); err == nil {
+				d.Offset, err = r.constExpr()
+			}
+		default:
+			return nil, fmt.Errorf("unsupported data segment flags %d", flags)
+		}
+		if err != nil {
+			return nil, err
+		}
+		size, err := r.u32()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := r.bytes(int(size)); err != nil {
+			return nil, err
+		}
+		d.Size = int(size)
+		segments = append(segments, d)
+	}
+	return segments, nil
+}
+
+// functionNames returns the function names from the "name" custom section.
+func functionNames(r *reader) (map[uint32]string, error) {
+	names := make(map[uint32]string)
+	for r.len() > 0 {
+		id, err := r.byte()
+		if err != nil {
+			return names, err
+		}
+		size, err := r.u32()
+		if err != nil {
+			return names, err
+		}
+		content, err := r.bytes(int(size))
+		if err != nil {
+			return names, err
+		}
+		if id != 1 { // function names
+			continue
+		}
+
+		sr := &reader{data: content}
+		n, err := sr.u32()
+		if err != nil {
+			return names, err
+		}
+		for i := uint32(0); i < n; i++ {
+			idx, err := sr.u32()
+			if err != nil {
+				return names, err
+			}
+			name, err := sr.name()
+			if err != nil {
+				return names, err
+			}
+			names[idx] = name
+		}
+	}
+	return names, nil
+}
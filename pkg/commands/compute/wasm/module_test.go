@@ -0,0 +1,115 @@
+package wasm_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/fastly/cli/pkg/commands/compute/wasm"
+	"github.com/fastly/cli/pkg/testutil"
+)
+
+func TestParse(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("..", "testdata", "inspect", "main.wasm"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := wasm.Parse(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testutil.AssertEqual(t, len(data), m.Size)
+
+	var names []string
+	for _, s := range m.Sections {
+		names = append(names, s.Name)
+	}
+	testutil.AssertEqual(t, []string{"type", "import", "function", "memory", "export", "code", "data", "name"}, names)
+
+	testutil.AssertEqual(t, []wasm.Import{
+		{Module: "fastly_http_req", Name: "send", Kind: "func", Signature: "(i32, i32) -> (i32)"},
+		{Module: "fastly_http_req", Name: "new", Kind: "func", Signature: "(i32, i32) -> (i32)"},
+		{Module: "wasi_snapshot_preview1", Name: "proc_exit", Kind: "func", Signature: "(i32, i32) -> (i32)"},
+	}, m.Imports)
+
+	testutil.AssertEqual(t, []wasm.Export{
+		{Name: "_start", Kind: "func", Index: 3},
+		{Name: "memory", Kind: "memory", Index: 0},
+	}, m.Exports)
+
+	testutil.AssertEqual(t, []wasm.Function{
+		{Index: 3, Name: "_start", Size: 2},
+		{Index: 4, Name: "run", Size: 22},
+	}, m.Functions)
+
+	offset := int64(1024)
+	testutil.AssertEqual(t, []wasm.DataSegment{
+		{Index: 0, Mode: "active", Offset: &offset, Size: 4},
+		{Index: 1, Mode: "passive", Size: 16},
+	}, m.Data)
+}
+
+func TestParseInvalid(t *testing.T) {
+	type scenario struct {
+		name      string
+		data      []byte
+		wantError string
+	}
+	scenarios := []scenario{
+		{
+			name:      "empty",
+			data:      []byte{},
+			wantError: "not a WebAssembly binary module",
+		},
+		{
+			name:      "not wasm",
+			data:      []byte("#!/bin/sh\necho"),
+			wantError: "not a WebAssembly binary module",
+		},
+		{
+			name:      "unsupported version",
+			data:      []byte{0x00, 0x61, 0x73, 0x6d, 0x02, 0x00, 0x00, 0x00},
+			wantError: "unsupported WebAssembly binary version 2",
+		},
+		{
+			name:      "truncated section",
+			data:      []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00, 0x01, 0x05, 0x01},
+			wantError: "error reading section 1: unexpected end of module",
+		},
+	}
+
+	// A vector count far larger than the section mustn't be used to allocate
+	// memory before its elements are read.
+	for _, section := range []struct {
+		id   byte
+		name string
+	}{
+		{1, "type"},
+		{2, "import"},
+		{7, "export"},
+		{10, "code"},
+		{11, "data"},
+	} {
+		scenarios = append(scenarios, scenario{
+			name:      section.name + " count exceeds section",
+			data:      []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00, section.id, 0x05, 0xff, 0xff, 0xff, 0xff, 0x0f},
+			wantError: "error reading " + section.name + " section: unexpected end of module",
+		})
+	}
+
+	// A type whose parameter count exceeds the section.
+	scenarios = append(scenarios, scenario{
+		name:      "parameter count exceeds section",
+		data:      []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00, 0x01, 0x07, 0x01, 0x60, 0xff, 0xff, 0xff, 0xff, 0x0f},
+		wantError: "error reading type section: unexpected end of module",
+	})
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			_, err := wasm.Parse(s.data)
+			testutil.AssertErrorContains(t, err, s.wantError)
+		})
+	}
+}
@@ -0,0 +1,191 @@
+package wasm
+
+import (
+	"errors"
+	"fmt"
+)
+
+// errUnexpectedEOF is returned when a module ends part way through a value.
+var errUnexpectedEOF = errors.New("unexpected end of module")
+
+// reader decodes values from the WebAssembly binary format.
+type reader struct {
+	data []byte
+	pos  int
+}
+
+// len returns the number of unread bytes.
+func (r *reader) len() int {
+	return len(r.data) - r.pos
+}
+
+// byte reads a single byte.
+func (r *reader) byte() (byte, error) {
+	if r.pos >= len(r.data) {
+		return 0, errUnexpectedEOF
+	}
+	b := r.data[r.pos]
+	r.pos++
+	return b, nil
+}
+
+// bytes reads n bytes.
+func (r *reader) bytes(n int) ([]byte, error) {
+	if n < 0 || n > r.len() {
+		return nil, errUnexpectedEOF
+	}
+	b := r.data[r.pos : r.pos+n]
+	r.pos += n
+	return b, nil
+}
+
+// capacity returns the initial capacity for a vector of n elements.
+//
+// NOTE: The count is read from the module, so it can't be trusted. As every
+// element is at least one byte, the count is capped at the unread bytes so a
+// malformed module can't exhaust memory before the elements are read.
+func (r *reader) capacity(n uint32) int {
+	if uint64(n) > uint64(r.len()) {
+		return r.len()
+	}
+	return int(n)
+}
+
+// u32 reads an unsigned LEB128 encoded 32-bit integer.
+func (r *reader) u32() (uint32, error) {
+	v, err := r.uleb(32)
+	return uint32(v), err
+}
+
+// u64 reads an unsigned LEB128 encoded 64-bit integer.
+func (r *reader) u64() (uint64, error) {
+	return r.uleb(64)
+}
+
+// uleb reads an unsigned LEB128 encoded integer of at most bits.
+func (r *reader) uleb(bits uint) (uint64, error) {
+	var (
+		result uint64
+		shift  uint
+	)
+	for {
+		b, err := r.byte()
+		if err != nil {
+			return 0, err
+		}
+		if shift >= bits {
+			return 0, fmt.Errorf("integer representation too long at offset %d", r.pos-1)
+		}
+		result |= uint64(b&0x7f) << shift
+		if b&0x80 == 0 {
+			return result, nil
+		}
+		shift += 7
+	}
+}
+
+// sleb reads a signed LEB128 encoded integer of at most bits.
+func (r *reader) sleb(bits uint) (int64, error) {
+	var (
+		result int64
+		shift  uint
+		b      byte
+		err    error
+	)
+	for {
+		b, err = r.byte()
+		if err != nil {
+			return 0, err
+		}
+		if shift >= bits {
+			return 0, fmt.Errorf("integer representation too long at offset %d", r.pos-1)
+		}
+		result |= int64(b&0x7f) << shift
+		shift += 7
+		if b&0x80 == 0 {
+			break
+		}
+	}
+	if shift < 64 && b&0x40 != 0 {
+		result |= -1 << shift
+	}
+	return result, nil
+}
+
+// name reads a UTF-8 name prefixed by its length.
+func (r *reader) name() (string, error) {
+	n, err := r.u32()
+	if err != nil {
+		return "", err
+	}
+	b, err := r.bytes(int(n))
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// limits reads the limits of a table or memory.
+func (r *reader) limits() error {
+	flags, err := r.byte()
+	if err != nil {
+		return err
+	}
+	if _, err := r.u64(); err != nil {
+		return err
+	}
+	if flags&0x01 != 0 {
+		if _, err := r.u64(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// constExpr reads a constant expression, returning the value of an integer
+// constant (e.g. a data segment offset) when that's all the expression is.
+func (r *reader) constExpr() (*int64, error) {
+	var (
+		instructions int
+		value        *int64
+		v            int64
+	)
+	for {
+		op, err := r.byte()
+		if err != nil {
+			return nil, err
+		}
+		switch op {
+		case 0x0b: // end
+			if instructions == 1 && value != nil {
+				return value, nil
+			}
+			return nil, nil
+		case 0x41: // i32.const
+			if v, err = r.sleb(32); err != nil {
+				return nil, err
+			}
+			value = &v
+		case 0x42: // i64.const
+			if v, err = r.sleb(64); err != nil {
+				return nil, err
+			}
+			value = &v
+		case 0x23, 0xd2: // global.get, ref.func
+			if _, err := r.u32(); err != nil {
+				return nil, err
+			}
+			value = nil
+		case 0xd0: // ref.null
+			if _, err := r.byte(); err != nil {
+				return nil, err
+			}
+			value = nil
+		case 0x6a, 0x6b, 0x6c, 0x7c, 0x7d, 0x7e: // extended constant arithmetic
+			value = nil
+		default:
+			return nil, fmt.Errorf("unsupported instruction 0x%02x in constant expression at offset %d", op, r.pos-1)
+		}
+		instructions++
+	}
+}
@@ -0,0 +1,406 @@
+package condition_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v6/fastly"
+)
+
+func TestConditionCreate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name:      "validate missing --name flag",
+			Args:      args("condition create --service-id 123 --version 1 --statement true"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Name:      "validate missing --statement flag",
+			Args:      args("condition create --service-id 123 --version 1 --name always"),
+			WantError: "error parsing arguments: required flag --statement not provided",
+		},
+		{
+			Name:      "validate invalid --type flag",
+			Args:      args("condition create --service-id 123 --version 1 --name always --statement true --type FOO"),
+			WantError: "enum value must be one of REQUEST,CACHE,RESPONSE",
+		},
+		{
+			Name: "validate CreateCondition API error",
+			API: mock.API{
+				ListVersionsFn:    testutil.ListVersions,
+				CloneVersionFn:    testutil.CloneVersionResult(4),
+				CreateConditionFn: createConditionError,
+			},
+			Args:      args("condition create --service-id 123 --version 1 --name always --statement true --autoclone"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate CreateCondition API success",
+			API: mock.API{
+				ListVersionsFn:    testutil.ListVersions,
+				CloneVersionFn:    testutil.CloneVersionResult(4),
+				CreateConditionFn: createConditionOK,
+			},
+			Args:       args("condition create --service-id 123 --version 1 --name always --statement true --type CACHE --priority 5 --autoclone"),
+			WantOutput: "Created condition always (service 123 version 4)",
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestConditionList(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name: "validate ListConditions API error",
+			API: mock.API{
+				ListVersionsFn:   testutil.ListVersions,
+				ListConditionsFn: listConditionsError,
+			},
+			Args:      args("condition list --service-id 123 --version 1"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate ListConditions API success",
+			API: mock.API{
+				ListVersionsFn:   testutil.ListVersions,
+				ListConditionsFn: listConditionsOK,
+			},
+			Args:       args("condition list --service-id 123 --version 1"),
+			WantOutput: listConditionsShortOutput,
+		},
+		{
+			Name: "validate --verbose flag",
+			API: mock.API{
+				ListVersionsFn:   testutil.ListVersions,
+				ListConditionsFn: listConditionsOK,
+			},
+			Args:       args("condition list --service-id 123 --version 1 --verbose"),
+			WantOutput: listConditionsVerboseOutput,
+		},
+		{
+			Name: "validate --json flag",
+			API: mock.API{
+				ListVersionsFn:   testutil.ListVersions,
+				ListConditionsFn: listConditionsOK,
+			},
+			Args:       args("condition list --service-id 123 --version 1 --json"),
+			WantOutput: `"Name":"always","Comment":"","Statement":"true","Type":"REQUEST","Priority":10`,
+		},
+		{
+			Name: "validate --format=template flag",
+			API: mock.API{
+				ListVersionsFn:   testutil.ListVersions,
+				ListConditionsFn: listConditionsOK,
+			},
+			Args:       args("condition list --service-id 123 --version 1 --format=template --template={{.Name}}:{{.Priority}}"),
+			WantOutput: "always:10\nis_api:20\n",
+		},
+		{
+			Name: "validate --format=yaml flag",
+			API: mock.API{
+				ListVersionsFn:   testutil.ListVersions,
+				ListConditionsFn: listConditionsOK,
+			},
+			Args:       args("condition list --service-id 123 --version 1 --format=yaml"),
+			WantOutput: "- ServiceID: \"123\"\n  ServiceVersion: 1\n  Name: always\n",
+		},
+		{
+			Name:      "validate --format=template requires --template",
+			Args:      args("condition list --service-id 123 --version 1 --format=template"),
+			WantError: "--format=template requires a --template",
+		},
+		{
+			Name:      "validate --json and --format=text are mutually exclusive",
+			Args:      args("condition list --service-id 123 --version 1 --json --format=text"),
+			WantError: "invalid flag combination, --json and --format=text",
+		},
+		{
+			Name:      "validate --json and --format=yaml are mutually exclusive",
+			Args:      args("condition list --service-id 123 --version 1 --format=yaml --json"),
+			WantError: "invalid flag combination, --json and --format=yaml",
+		},
+		{
+			Name: "validate --json and --format=json are compatible",
+			API: mock.API{
+				ListVersionsFn:   testutil.ListVersions,
+				ListConditionsFn: listConditionsOK,
+			},
+			Args:       args("condition list --service-id 123 --version 1 --json --format=json"),
+			WantOutput: `"Name":"always"`,
+		},
+		{
+			Name:      "validate --verbose and --format=csv are mutually exclusive",
+			Args:      args("condition list --service-id 123 --version 1 --format=csv --verbose"),
+			WantError: "invalid flag combination, --verbose and --format",
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestConditionDescribe(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name:      "validate missing --name flag",
+			Args:      args("condition describe --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Name: "validate GetCondition API error",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetConditionFn: getConditionError,
+			},
+			Args:      args("condition describe --service-id 123 --version 1 --name always"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate GetCondition API success",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetConditionFn: getConditionOK,
+			},
+			Args:       args("condition describe --service-id 123 --version 1 --name always"),
+			WantOutput: describeConditionOutput,
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestConditionUpdate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name:      "validate missing --name flag",
+			Args:      args("condition update --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Name: "validate UpdateCondition API error",
+			API: mock.API{
+				ListVersionsFn:    testutil.ListVersions,
+				CloneVersionFn:    testutil.CloneVersionResult(4),
+				UpdateConditionFn: updateConditionError,
+			},
+			Args:      args("condition update --service-id 123 --version 1 --name always --statement false --autoclone"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate UpdateCondition API success",
+			API: mock.API{
+				ListVersionsFn:    testutil.ListVersions,
+				CloneVersionFn:    testutil.CloneVersionResult(4),
+				UpdateConditionFn: updateConditionOK,
+			},
+			Args:       args("condition update --service-id 123 --version 1 --name always --statement false --type RESPONSE --autoclone"),
+			WantOutput: "Updated condition always (service 123 version 4)",
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestConditionDelete(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name:      "validate missing --name flag",
+			Args:      args("condition delete --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Name: "validate DeleteCondition API error",
+			API: mock.API{
+				ListVersionsFn:    testutil.ListVersions,
+				CloneVersionFn:    testutil.CloneVersionResult(4),
+				DeleteConditionFn: deleteConditionError,
+			},
+			Args:      args("condition delete --service-id 123 --version 1 --name always --autoclone"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate DeleteCondition API success",
+			API: mock.API{
+				ListVersionsFn:    testutil.ListVersions,
+				CloneVersionFn:    testutil.CloneVersionResult(4),
+				DeleteConditionFn: deleteConditionOK,
+			},
+			Args:       args("condition delete --service-id 123 --version 1 --name always --autoclone"),
+			WantOutput: "Deleted condition always (service 123 version 4)",
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func createConditionOK(i *fastly.CreateConditionInput) (*fastly.Condition, error) {
+	return &fastly.Condition{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           i.Name,
+		Statement:      i.Statement,
+		Type:           i.Type,
+	}, nil
+}
+
+func createConditionError(i *fastly.CreateConditionInput) (*fastly.Condition, error) {
+	return nil, testutil.Err
+}
+
+func listConditionsOK(i *fastly.ListConditionsInput) ([]*fastly.Condition, error) {
+	return []*fastly.Condition{
+		{
+			ServiceID:      i.ServiceID,
+			ServiceVersion: i.ServiceVersion,
+			Name:           "always",
+			Statement:      "true",
+			Type:           "REQUEST",
+			Priority:       10,
+		},
+		{
+			ServiceID:      i.ServiceID,
+			ServiceVersion: i.ServiceVersion,
+			Name:           "is_api",
+			Comment:        "API requests",
+			Statement:      `req.url ~ "^/api/"`,
+			Type:           "CACHE",
+			Priority:       20,
+		},
+	}, nil
+}
+
+func listConditionsError(i *fastly.ListConditionsInput) ([]*fastly.Condition, error) {
+	return nil, testutil.Err
+}
+
+var listConditionsShortOutput = strings.TrimSpace(`
+SERVICE  VERSION  NAME    TYPE     PRIORITY  STATEMENT
+123      1        always  REQUEST  10        true
+123      1        is_api  CACHE    20        req.url ~ "^/api/"
+`) + "\n"
+
+var listConditionsVerboseOutput = strings.Join([]string{
+	"Fastly API token not provided",
+	"Fastly API endpoint: https://api.fastly.com",
+	"Service ID (via --service-id): 123",
+	"",
+	"Version: 1",
+	"	Condition 1/2",
+	"		Name: always",
+	"		Comment: ",
+	"		Type: REQUEST",
+	"		Statement: true",
+	"		Priority: 10",
+	"	Condition 2/2",
+	"		Name: is_api",
+	"		Comment: API requests",
+	"		Type: CACHE",
+	`		Statement: req.url ~ "^/api/"`,
+	"		Priority: 20",
+}, "\n") + "\n\n"
+
+func getConditionOK(i *fastly.GetConditionInput) (*fastly.Condition, error) {
+	return &fastly.Condition{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           "always",
+		Statement:      "true",
+		Type:           "REQUEST",
+		Priority:       10,
+	}, nil
+}
+
+func getConditionError(i *fastly.GetConditionInput) (*fastly.Condition, error) {
+	return nil, testutil.Err
+}
+
+var describeConditionOutput = "\n" + strings.Join([]string{
+	"Service ID: 123",
+	"Version: 1",
+	"Name: always",
+	"Comment: ",
+	"Type: REQUEST",
+	"Statement: true",
+	"Priority: 10",
+}, "\n") + "\n"
+
+func updateConditionOK(i *fastly.UpdateConditionInput) (*fastly.Condition, error) {
+	return &fastly.Condition{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           i.Name,
+		Statement:      *i.Statement,
+		Type:           *i.Type,
+	}, nil
+}
+
+func updateConditionError(i *fastly.UpdateConditionInput) (*fastly.Condition, error) {
+	return nil, testutil.Err
+}
+
+func deleteConditionOK(i *fastly.DeleteConditionInput) error {
+	return nil
+}
+
+func deleteConditionError(i *fastly.DeleteConditionInput) error {
+	return testutil.Err
+}
@@ -0,0 +1,100 @@
+package condition
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v6/fastly"
+)
+
+// conditionTypes is the list of supported condition types.
+var conditionTypes = []string{"REQUEST", "CACHE", "RESPONSE"}
+
+// CreateCommand calls the Fastly API to create conditions.
+type CreateCommand struct {
+	cmd.Base
+	input          fastly.CreateConditionInput
+	autoClone      cmd.OptionalAutoClone
+	manifest       manifest.Data
+	priority       cmd.OptionalInt
+	serviceName    cmd.OptionalServiceNameID
+	serviceVersion cmd.OptionalServiceVersion
+}
+
+// NewCreateCommand returns a usable command registered under the parent.
+func NewCreateCommand(parent cmd.Registerer, globals *config.Data, data manifest.Data) *CreateCommand {
+	var c CreateCommand
+	c.Globals = globals
+	c.manifest = data
+	c.CmdClause = parent.Command("create", "Create a condition on a Fastly service version").Alias("add")
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Name:        cmd.FlagServiceIDName,
+		Description: cmd.FlagServiceIDDesc,
+		Dst:         &c.manifest.Flag.ServiceID,
+		Short:       's',
+	})
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Action:      c.serviceName.Set,
+		Name:        cmd.FlagServiceName,
+		Description: cmd.FlagServiceDesc,
+		Dst:         &c.serviceName.Value,
+	})
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Name:        cmd.FlagVersionName,
+		Description: cmd.FlagVersionDesc,
+		Dst:         &c.serviceVersion.Value,
+		Required:    true,
+	})
+	c.RegisterAutoCloneFlag(cmd.AutoCloneFlagOpts{
+		Action: c.autoClone.Set,
+		Dst:    &c.autoClone.Value,
+	})
+	c.CmdClause.Flag("name", "Condition name").Short('n').Required().StringVar(&c.input.Name)
+	c.CmdClause.Flag("statement", "The VCL conditional expression, e.g. req.url ~ \"^/api/\"").Required().StringVar(&c.input.Statement)
+	c.CmdClause.Flag("type", "Type of the condition: REQUEST, CACHE or RESPONSE").Default("REQUEST").EnumVar(&c.input.Type, conditionTypes...)
+	c.CmdClause.Flag("priority", "Priority determines the order in which multiple conditions execute. Lower numbers execute first").Action(c.priority.Set).IntVar(&c.priority.Value)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *CreateCommand) Exec(_ io.Reader, out io.Writer) error {
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AutoCloneFlag:      c.autoClone,
+		APIClient:          c.Globals.APIClient,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceNameFlag:    c.serviceName,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	c.input.ServiceID = serviceID
+	c.input.ServiceVersion = serviceVersion.Number
+
+	if c.priority.WasSet {
+		c.input.Priority = fastly.Int(c.priority.Value)
+	}
+
+	cond, err := c.Globals.APIClient.CreateCondition(&c.input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	text.Success(out, "Created condition %s (service %s version %d)", cond.Name, cond.ServiceID, cond.ServiceVersion)
+	return nil
+}
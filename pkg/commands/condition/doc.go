@@ -0,0 +1,2 @@
+// Package condition contains commands to inspect and manipulate Fastly service conditions.
+package condition
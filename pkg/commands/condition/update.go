@@ -0,0 +1,114 @@
+package condition
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v6/fastly"
+)
+
+// UpdateCommand calls the Fastly API to update conditions.
+type UpdateCommand struct {
+	cmd.Base
+	manifest       manifest.Data
+	input          fastly.UpdateConditionInput
+	serviceName    cmd.OptionalServiceNameID
+	serviceVersion cmd.OptionalServiceVersion
+	autoClone      cmd.OptionalAutoClone
+
+	Comment   cmd.OptionalString
+	Statement cmd.OptionalString
+	Type      cmd.OptionalString
+	Priority  cmd.OptionalInt
+}
+
+// NewUpdateCommand returns a usable command registered under the parent.
+func NewUpdateCommand(parent cmd.Registerer, globals *config.Data, data manifest.Data) *UpdateCommand {
+	var c UpdateCommand
+	c.Globals = globals
+	c.manifest = data
+	c.CmdClause = parent.Command("update", "Update a condition on a Fastly service version")
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Name:        cmd.FlagServiceIDName,
+		Description: cmd.FlagServiceIDDesc,
+		Dst:         &c.manifest.Flag.ServiceID,
+		Short:       's',
+	})
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Action:      c.serviceName.Set,
+		Name:        cmd.FlagServiceName,
+		Description: cmd.FlagServiceDesc,
+		Dst:         &c.serviceName.Value,
+	})
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Name:        cmd.FlagVersionName,
+		Description: cmd.FlagVersionDesc,
+		Dst:         &c.serviceVersion.Value,
+		Required:    true,
+	})
+	c.RegisterAutoCloneFlag(cmd.AutoCloneFlagOpts{
+		Action: c.autoClone.Set,
+		Dst:    &c.autoClone.Value,
+	})
+	c.CmdClause.Flag("name", "Condition name").Short('n').Required().StringVar(&c.input.Name)
+	c.CmdClause.Flag("comment", "A descriptive note").Action(c.Comment.Set).StringVar(&c.Comment.Value)
+	c.CmdClause.Flag("statement", "The VCL conditional expression, e.g. req.url ~ \"^/api/\"").Action(c.Statement.Set).StringVar(&c.Statement.Value)
+	c.CmdClause.Flag("type", "Type of the condition: REQUEST, CACHE or RESPONSE").Action(c.Type.Set).EnumVar(&c.Type.Value, conditionTypes...)
+	c.CmdClause.Flag("priority", "Priority determines the order in which multiple conditions execute. Lower numbers execute first").Action(c.Priority.Set).IntVar(&c.Priority.Value)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *UpdateCommand) Exec(_ io.Reader, out io.Writer) error {
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AutoCloneFlag:      c.autoClone,
+		APIClient:          c.Globals.APIClient,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceNameFlag:    c.serviceName,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	c.input.ServiceID = serviceID
+	c.input.ServiceVersion = serviceVersion.Number
+
+	if c.Comment.WasSet {
+		c.input.Comment = fastly.String(c.Comment.Value)
+	}
+
+	if c.Statement.WasSet {
+		c.input.Statement = fastly.String(c.Statement.Value)
+	}
+
+	if c.Type.WasSet {
+		c.input.Type = fastly.String(c.Type.Value)
+	}
+
+	if c.Priority.WasSet {
+		c.input.Priority = fastly.Int(c.Priority.Value)
+	}
+
+	cond, err := c.Globals.APIClient.UpdateCondition(&c.input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	text.Success(out, "Updated condition %s (service %s version %d)", cond.Name, cond.ServiceID, cond.ServiceVersion)
+	return nil
+}
@@ -1,8 +1,6 @@
 package dictionary
 
 import (
-	"encoding/json"
-	"fmt"
 	"io"
 
 	"github.com/fastly/cli/pkg/cmd"
@@ -18,7 +16,6 @@ type DescribeCommand struct {
 	cmd.Base
 	manifest       manifest.Data
 	Input          fastly.GetDictionaryInput
-	json           bool
 	serviceName    cmd.OptionalServiceNameID
 	serviceVersion cmd.OptionalServiceVersion
 }
@@ -29,12 +26,7 @@ func NewDescribeCommand(parent cmd.Registerer, globals *config.Data, data manife
 	c.Globals = globals
 	c.manifest = data
 	c.CmdClause = parent.Command("describe", "Show detailed information about a Fastly edge dictionary").Alias("get")
-	c.RegisterFlagBool(cmd.BoolFlagOpts{
-		Name:        cmd.FlagJSONName,
-		Description: cmd.FlagJSONDesc,
-		Dst:         &c.json,
-		Short:       'j',
-	})
+	c.RegisterOutputFlags()
 	c.RegisterFlag(cmd.StringFlagOpts{
 		Name:        cmd.FlagServiceIDName,
 		Description: cmd.FlagServiceIDDesc,
@@ -59,8 +51,8 @@ func NewDescribeCommand(parent cmd.Registerer, globals *config.Data, data manife
 
 // Exec invokes the application logic for the command.
 func (c *DescribeCommand) Exec(_ io.Reader, out io.Writer) error {
-	if c.Globals.Verbose() && c.json {
-		return fsterr.ErrInvalidVerboseJSONCombo
+	if err := c.CheckOutputFlags(); err != nil {
+		return err
 	}
 
 	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
@@ -96,7 +88,7 @@ func (c *DescribeCommand) Exec(_ io.Reader, out io.Writer) error {
 		info  *fastly.DictionaryInfo
 		items []*fastly.DictionaryItem
 	)
-	if c.Globals.Verbose() || c.json {
+	if c.Globals.Verbose() || c.StructuredOutput() {
 		infoInput := fastly.GetDictionaryInfoInput{
 			ServiceID:      c.Input.ServiceID,
 			ServiceVersion: c.Input.ServiceVersion,
@@ -124,25 +116,18 @@ func (c *DescribeCommand) Exec(_ io.Reader, out io.Writer) error {
 		}
 	}
 
-	if c.json {
-		// NOTE: When not using JSON you have to provide the --verbose flag to get
-		// some extra information about the dictionary. When using --json we go
-		// ahead and acquire that info and combine it into the JSON output.
+	if c.StructuredOutput() {
+		// NOTE: When not using structured output you have to provide the
+		// --verbose flag to get some extra information about the dictionary.
+		// When using --json or --format we go ahead and acquire that info and
+		// combine it into the output.
 		type container struct {
 			*fastly.Dictionary
 			*fastly.DictionaryInfo
 			Items []*fastly.DictionaryItem
 		}
-		data, err := json.Marshal(&container{Dictionary: dictionary, DictionaryInfo: info, Items: items})
-		if err != nil {
-			return err
-		}
-		_, err = out.Write(data)
-		if err != nil {
-			c.Globals.ErrLog.Add(err)
-			return fmt.Errorf("error: unable to write data to stdout: %w", err)
-		}
-		return nil
+		_, err := c.WriteOutput(out, &container{Dictionary: dictionary, DictionaryInfo: info, Items: items})
+		return err
 	}
 
 	if !c.Globals.Verbose() {
@@ -1,7 +1,6 @@
 package dictionary
 
 import (
-	"encoding/json"
 	"fmt"
 	"io"
 
@@ -16,7 +15,6 @@ import (
 // ListCommand calls the Fastly API to list dictionaries
 type ListCommand struct {
 	cmd.Base
-	json           bool
 	manifest       manifest.Data
 	Input          fastly.ListDictionariesInput
 	serviceName    cmd.OptionalServiceNameID
@@ -29,12 +27,7 @@ func NewListCommand(parent cmd.Registerer, globals *config.Data, data manifest.D
 	c.Globals = globals
 	c.manifest = data
 	c.CmdClause = parent.Command("list", "List all dictionaries on a Fastly service version")
-	c.RegisterFlagBool(cmd.BoolFlagOpts{
-		Name:        cmd.FlagJSONName,
-		Description: cmd.FlagJSONDesc,
-		Dst:         &c.json,
-		Short:       'j',
-	})
+	c.RegisterOutputFlags()
 	c.RegisterFlag(cmd.StringFlagOpts{
 		Name:        cmd.FlagServiceIDName,
 		Description: cmd.FlagServiceIDDesc,
@@ -58,8 +51,8 @@ func NewListCommand(parent cmd.Registerer, globals *config.Data, data manifest.D
 
 // Exec invokes the application logic for the command.
 func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
-	if c.Globals.Verbose() && c.json {
-		return fsterr.ErrInvalidVerboseJSONCombo
+	if err := c.CheckOutputFlags(); err != nil {
+		return err
 	}
 	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
 		AllowActiveLocked:  true,
@@ -90,17 +83,8 @@ func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
 		return err
 	}
 
-	if c.json {
-		data, err := json.Marshal(dictionaries)
-		if err != nil {
-			return err
-		}
-		_, err = out.Write(data)
-		if err != nil {
-			c.Globals.ErrLog.Add(err)
-			return fmt.Errorf("error: unable to write data to stdout: %w", err)
-		}
-		return nil
+	if ok, err := c.WriteOutput(out, dictionaries); ok {
+		return err
 	}
 
 	if !c.Globals.Verbose() {
@@ -1,13 +1,11 @@
 package dictionaryitem
 
 import (
-	"encoding/json"
 	"fmt"
 	"io"
 
 	"github.com/fastly/cli/pkg/cmd"
 	"github.com/fastly/cli/pkg/config"
-	fsterr "github.com/fastly/cli/pkg/errors"
 	"github.com/fastly/cli/pkg/manifest"
 	"github.com/fastly/cli/pkg/text"
 	"github.com/fastly/go-fastly/v6/fastly"
@@ -18,7 +16,6 @@ type ListCommand struct {
 	cmd.Base
 	manifest    manifest.Data
 	input       fastly.ListDictionaryItemsInput
-	json        bool
 	serviceName cmd.OptionalServiceNameID
 }
 
@@ -30,12 +27,7 @@ func NewListCommand(parent cmd.Registerer, globals *config.Data, data manifest.D
 	c.CmdClause = parent.Command("list", "List items in a Fastly edge dictionary")
 	c.CmdClause.Flag("dictionary-id", "Dictionary ID").Required().StringVar(&c.input.DictionaryID)
 	c.CmdClause.Flag("direction", "Direction in which to sort results").Default(cmd.PaginationDirection[0]).HintOptions(cmd.PaginationDirection...).EnumVar(&c.input.Direction, cmd.PaginationDirection...)
-	c.RegisterFlagBool(cmd.BoolFlagOpts{
-		Name:        cmd.FlagJSONName,
-		Description: cmd.FlagJSONDesc,
-		Dst:         &c.json,
-		Short:       'j',
-	})
+	c.RegisterOutputFlags()
 	c.CmdClause.Flag("page", "Page number of data set to fetch").IntVar(&c.input.Page)
 	c.CmdClause.Flag("per-page", "Number of records per page").IntVar(&c.input.PerPage)
 	c.RegisterFlag(cmd.StringFlagOpts{
@@ -56,8 +48,8 @@ func NewListCommand(parent cmd.Registerer, globals *config.Data, data manifest.D
 
 // Exec invokes the application logic for the command.
 func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
-	if c.Globals.Verbose() && c.json {
-		return fsterr.ErrInvalidVerboseJSONCombo
+	if err := c.CheckOutputFlags(); err != nil {
+		return err
 	}
 
 	serviceID, source, flag, err := cmd.ServiceID(c.serviceName, c.manifest, c.Globals.APIClient, c.Globals.ErrLog)
@@ -85,17 +77,8 @@ func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
 		ds = append(ds, data...)
 	}
 
-	if c.json {
-		data, err := json.Marshal(ds)
-		if err != nil {
-			return err
-		}
-		_, err = out.Write(data)
-		if err != nil {
-			c.Globals.ErrLog.Add(err)
-			return fmt.Errorf("error: unable to write data to stdout: %w", err)
-		}
-		return nil
+	if ok, err := c.WriteOutput(out, ds); ok {
+		return err
 	}
 
 	if !c.Globals.Verbose() {
@@ -0,0 +1,126 @@
+package director
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v6/fastly"
+)
+
+// directorTypes maps the supported --type flag values to a director type.
+var directorTypes = map[string]fastly.DirectorType{
+	"random": fastly.DirectorTypeRandom,
+	"hash":   fastly.DirectorTypeHash,
+	"client": fastly.DirectorTypeClient,
+}
+
+// directorTypeNames is the list of supported --type flag values.
+var directorTypeNames = []string{"random", "hash", "client"}
+
+// directorTypeName returns the --type flag value for a director type.
+func directorTypeName(t fastly.DirectorType) string {
+	for name, dt := range directorTypes {
+		if dt == t {
+			return name
+		}
+	}
+	return "unknown"
+}
+
+// CreateCommand calls the Fastly API to create directors.
+type CreateCommand struct {
+	cmd.Base
+	input          fastly.CreateDirectorInput
+	autoClone      cmd.OptionalAutoClone
+	directorType   string
+	manifest       manifest.Data
+	quorum         cmd.OptionalUint
+	retries        cmd.OptionalUint
+	serviceName    cmd.OptionalServiceNameID
+	serviceVersion cmd.OptionalServiceVersion
+}
+
+// NewCreateCommand returns a usable command registered under the parent.
+func NewCreateCommand(parent cmd.Registerer, globals *config.Data, data manifest.Data) *CreateCommand {
+	var c CreateCommand
+	c.Globals = globals
+	c.manifest = data
+	c.CmdClause = parent.Command("create", "Create a director on a Fastly service version").Alias("add")
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Name:        cmd.FlagServiceIDName,
+		Description: cmd.FlagServiceIDDesc,
+		Dst:         &c.manifest.Flag.ServiceID,
+		Short:       's',
+	})
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Action:      c.serviceName.Set,
+		Name:        cmd.FlagServiceName,
+		Description: cmd.FlagServiceDesc,
+		Dst:         &c.serviceName.Value,
+	})
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Name:        cmd.FlagVersionName,
+		Description: cmd.FlagVersionDesc,
+		Dst:         &c.serviceVersion.Value,
+		Required:    true,
+	})
+	c.RegisterAutoCloneFlag(cmd.AutoCloneFlagOpts{
+		Action: c.autoClone.Set,
+		Dst:    &c.autoClone.Value,
+	})
+	c.CmdClause.Flag("name", "Name of director").Short('n').Required().StringVar(&c.input.Name)
+	c.CmdClause.Flag("type", "Type of load balancing. Accepts a string value: random, hash, client").Default("random").EnumVar(&c.directorType, directorTypeNames...)
+	c.CmdClause.Flag("comment", "A freeform descriptive note").StringVar(&c.input.Comment)
+	c.CmdClause.Flag("shield", "Selected POP to serve as a shield for the backends").StringVar(&c.input.Shield)
+	c.CmdClause.Flag("quorum", "The percentage of capacity that needs to be up for the director to be considered up").Action(c.quorum.Set).UintVar(&c.quorum.Value)
+	c.CmdClause.Flag("retries", "How many backends to search if it fails").Action(c.retries.Set).UintVar(&c.retries.Value)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *CreateCommand) Exec(_ io.Reader, out io.Writer) error {
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AutoCloneFlag:      c.autoClone,
+		APIClient:          c.Globals.APIClient,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceNameFlag:    c.serviceName,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	c.input.ServiceID = serviceID
+	c.input.ServiceVersion = serviceVersion.Number
+	c.input.Type = directorTypes[c.directorType]
+
+	if c.quorum.WasSet {
+		c.input.Quorum = fastly.Uint(c.quorum.Value)
+	}
+
+	if c.retries.WasSet {
+		c.input.Retries = fastly.Uint(c.retries.Value)
+	}
+
+	d, err := c.Globals.APIClient.CreateDirector(&c.input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	text.Success(out, "Created director %s (service %s version %d)", d.Name, d.ServiceID, d.ServiceVersion)
+	return nil
+}
@@ -0,0 +1,391 @@
+package director_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v6/fastly"
+)
+
+func TestDirectorCreate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name:      "validate missing --name flag",
+			Args:      args("director create --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Name:      "validate invalid --type flag",
+			Args:      args("director create --service-id 123 --version 1 --name origin --type round-robin"),
+			WantError: "enum value must be one of random,hash,client",
+		},
+		{
+			Name: "validate CreateDirector API error",
+			API: mock.API{
+				ListVersionsFn:   testutil.ListVersions,
+				CloneVersionFn:   testutil.CloneVersionResult(4),
+				CreateDirectorFn: createDirectorError,
+			},
+			Args:      args("director create --service-id 123 --version 1 --name origin --autoclone"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate CreateDirector API success",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				CreateDirectorFn: func(i *fastly.CreateDirectorInput) (*fastly.Director, error) {
+					if i.Type != fastly.DirectorTypeHash || *i.Quorum != 50 {
+						return nil, errors.New("unexpected input")
+					}
+					return &fastly.Director{
+						ServiceID:      i.ServiceID,
+						ServiceVersion: i.ServiceVersion,
+						Name:           i.Name,
+					}, nil
+				},
+			},
+			Args:       args("director create --service-id 123 --version 1 --name origin --type hash --quorum 50 --autoclone"),
+			WantOutput: "Created director origin (service 123 version 4)",
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestDirectorList(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name: "validate ListDirectors API error",
+			API: mock.API{
+				ListVersionsFn:  testutil.ListVersions,
+				ListDirectorsFn: listDirectorsError,
+			},
+			Args:      args("director list --service-id 123 --version 1"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate ListDirectors API success",
+			API: mock.API{
+				ListVersionsFn:  testutil.ListVersions,
+				ListDirectorsFn: listDirectorsOK,
+			},
+			Args:       args("director list --service-id 123 --version 1"),
+			WantOutput: listDirectorsShortOutput,
+		},
+		{
+			Name: "validate --verbose flag",
+			API: mock.API{
+				ListVersionsFn:  testutil.ListVersions,
+				ListDirectorsFn: listDirectorsOK,
+			},
+			Args:       args("director list --service-id 123 --version 1 --verbose"),
+			WantOutput: listDirectorsVerboseOutput,
+		},
+		{
+			Name: "validate --json flag",
+			API: mock.API{
+				ListVersionsFn:  testutil.ListVersions,
+				ListDirectorsFn: listDirectorsOK,
+			},
+			Args:       args("director list --service-id 123 --version 1 --json"),
+			WantOutput: `"Name":"origin","Backends":["a","b"]`,
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestDirectorDescribe(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name:      "validate missing --name flag",
+			Args:      args("director describe --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Name: "validate GetDirector API success",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetDirectorFn: func(i *fastly.GetDirectorInput) (*fastly.Director, error) {
+					return &fastly.Director{
+						ServiceID:      i.ServiceID,
+						ServiceVersion: i.ServiceVersion,
+						Name:           i.Name,
+						Type:           fastly.DirectorTypeRandom,
+						Quorum:         75,
+						Retries:        5,
+						Backends:       []string{"a", "b"},
+					}, nil
+				},
+			},
+			Args: args("director describe --service-id 123 --version 1 --name origin"),
+			WantOutput: "\n" + strings.Join([]string{
+				"Service ID: 123",
+				"Version: 1",
+				"Name: origin",
+				"Comment: ",
+				"Type: random",
+				"Quorum: 75",
+				"Retries: 5",
+				"Shield: ",
+				"Backends: a, b",
+			}, "\n") + "\n",
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestDirectorUpdate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name: "validate UpdateDirector API error",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				UpdateDirectorFn: func(i *fastly.UpdateDirectorInput) (*fastly.Director, error) {
+					return nil, testutil.Err
+				},
+			},
+			Args:      args("director update --service-id 123 --version 1 --name origin --retries 3 --autoclone"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate UpdateDirector API success",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				UpdateDirectorFn: func(i *fastly.UpdateDirectorInput) (*fastly.Director, error) {
+					if i.Type != fastly.DirectorTypeClient {
+						return nil, errors.New("unexpected input")
+					}
+					return &fastly.Director{
+						ServiceID:      i.ServiceID,
+						ServiceVersion: i.ServiceVersion,
+						Name:           *i.NewName,
+					}, nil
+				},
+			},
+			Args:       args("director update --service-id 123 --version 1 --name origin --new-name primary --type client --autoclone"),
+			WantOutput: "Updated director primary (service 123 version 4)",
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestDirectorDelete(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name: "validate DeleteDirector API error",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				DeleteDirectorFn: func(i *fastly.DeleteDirectorInput) error {
+					return testutil.Err
+				},
+			},
+			Args:      args("director delete --service-id 123 --version 1 --name origin --autoclone"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate DeleteDirector API success",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				DeleteDirectorFn: func(i *fastly.DeleteDirectorInput) error {
+					return nil
+				},
+			},
+			Args:       args("director delete --service-id 123 --version 1 --name origin --autoclone"),
+			WantOutput: "Deleted director origin (service 123 version 4)",
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestDirectorBackends(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name:      "validate add-backend missing --backend flag",
+			Args:      args("director add-backend --service-id 123 --version 1 --name origin"),
+			WantError: "error parsing arguments: required flag --backend not provided",
+		},
+		{
+			Name: "validate CreateDirectorBackend API error",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CreateDirectorBackendFn: func(i *fastly.CreateDirectorBackendInput) (*fastly.DirectorBackend, error) {
+					return nil, testutil.Err
+				},
+			},
+			Args:      args("director add-backend --service-id 123 --version 3 --name origin --backend a"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate CreateDirectorBackend API success",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				CreateDirectorBackendFn: func(i *fastly.CreateDirectorBackendInput) (*fastly.DirectorBackend, error) {
+					return &fastly.DirectorBackend{
+						ServiceID:      i.ServiceID,
+						ServiceVersion: i.ServiceVersion,
+						Director:       i.Director,
+						Backend:        i.Backend,
+					}, nil
+				},
+			},
+			Args:       args("director add-backend --service-id 123 --version 1 --name origin --backend a --autoclone"),
+			WantOutput: "Added backend a to director origin (service 123 version 4)",
+		},
+		{
+			Name: "validate DeleteDirectorBackend API success",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				DeleteDirectorBackendFn: func(i *fastly.DeleteDirectorBackendInput) error {
+					return nil
+				},
+			},
+			Args:       args("director remove-backend --service-id 123 --version 1 --name origin --backend a --autoclone"),
+			WantOutput: "Removed backend a from director origin (service 123 version 4)",
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func createDirectorError(i *fastly.CreateDirectorInput) (*fastly.Director, error) {
+	return nil, testutil.Err
+}
+
+func listDirectorsOK(i *fastly.ListDirectorsInput) ([]*fastly.Director, error) {
+	return []*fastly.Director{
+		{
+			ServiceID:      i.ServiceID,
+			ServiceVersion: i.ServiceVersion,
+			Name:           "origin",
+			Backends:       []string{"a", "b"},
+			Type:           fastly.DirectorTypeRandom,
+			Quorum:         75,
+			Retries:        5,
+		},
+		{
+			ServiceID:      i.ServiceID,
+			ServiceVersion: i.ServiceVersion,
+			Name:           "sticky",
+			Backends:       []string{"c"},
+			Type:           fastly.DirectorTypeClient,
+			Quorum:         50,
+			Retries:        3,
+		},
+	}, nil
+}
+
+func listDirectorsError(i *fastly.ListDirectorsInput) ([]*fastly.Director, error) {
+	return nil, testutil.Err
+}
+
+var listDirectorsShortOutput = strings.TrimSpace(`
+SERVICE  VERSION  NAME    TYPE    QUORUM  RETRIES  BACKENDS
+123      1        origin  random  75      5        a, b
+123      1        sticky  client  50      3        c
+`) + "\n"
+
+var listDirectorsVerboseOutput = strings.Join([]string{
+	"Fastly API token not provided",
+	"Fastly API endpoint: https://api.fastly.com",
+	"Service ID (via --service-id): 123",
+	"",
+	"Version: 1",
+	"	Director 1/2",
+	"		Name: origin",
+	"		Comment: ",
+	"		Type: random",
+	"		Quorum: 75",
+	"		Retries: 5",
+	"		Shield: ",
+	"		Backends: a, b",
+	"	Director 2/2",
+	"		Name: sticky",
+	"		Comment: ",
+	"		Type: client",
+	"		Quorum: 50",
+	"		Retries: 3",
+	"		Shield: ",
+	"		Backends: c",
+}, "\n") + "\n\n"
@@ -0,0 +1,126 @@
+package director
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v6/fastly"
+)
+
+// UpdateCommand calls the Fastly API to update directors.
+type UpdateCommand struct {
+	cmd.Base
+	manifest       manifest.Data
+	input          fastly.UpdateDirectorInput
+	serviceName    cmd.OptionalServiceNameID
+	serviceVersion cmd.OptionalServiceVersion
+	autoClone      cmd.OptionalAutoClone
+
+	NewName cmd.OptionalString
+	Type    cmd.OptionalString
+	Comment cmd.OptionalString
+	Shield  cmd.OptionalString
+	Quorum  cmd.OptionalUint
+	Retries cmd.OptionalUint
+}
+
+// NewUpdateCommand returns a usable command registered under the parent.
+func NewUpdateCommand(parent cmd.Registerer, globals *config.Data, data manifest.Data) *UpdateCommand {
+	var c UpdateCommand
+	c.Globals = globals
+	c.manifest = data
+	c.CmdClause = parent.Command("update", "Update a director on a Fastly service version")
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Name:        cmd.FlagServiceIDName,
+		Description: cmd.FlagServiceIDDesc,
+		Dst:         &c.manifest.Flag.ServiceID,
+		Short:       's',
+	})
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Action:      c.serviceName.Set,
+		Name:        cmd.FlagServiceName,
+		Description: cmd.FlagServiceDesc,
+		Dst:         &c.serviceName.Value,
+	})
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Name:        cmd.FlagVersionName,
+		Description: cmd.FlagVersionDesc,
+		Dst:         &c.serviceVersion.Value,
+		Required:    true,
+	})
+	c.RegisterAutoCloneFlag(cmd.AutoCloneFlagOpts{
+		Action: c.autoClone.Set,
+		Dst:    &c.autoClone.Value,
+	})
+	c.CmdClause.Flag("name", "Name of director").Short('n').Required().StringVar(&c.input.Name)
+	c.CmdClause.Flag("new-name", "New name of director").Action(c.NewName.Set).StringVar(&c.NewName.Value)
+	c.CmdClause.Flag("type", "Type of load balancing. Accepts a string value: random, hash, client").Action(c.Type.Set).EnumVar(&c.Type.Value, directorTypeNames...)
+	c.CmdClause.Flag("comment", "A freeform descriptive note").Action(c.Comment.Set).StringVar(&c.Comment.Value)
+	c.CmdClause.Flag("shield", "Selected POP to serve as a shield for the backends").Action(c.Shield.Set).StringVar(&c.Shield.Value)
+	c.CmdClause.Flag("quorum", "The percentage of capacity that needs to be up for the director to be considered up").Action(c.Quorum.Set).UintVar(&c.Quorum.Value)
+	c.CmdClause.Flag("retries", "How many backends to search if it fails").Action(c.Retries.Set).UintVar(&c.Retries.Value)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *UpdateCommand) Exec(_ io.Reader, out io.Writer) error {
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AutoCloneFlag:      c.autoClone,
+		APIClient:          c.Globals.APIClient,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceNameFlag:    c.serviceName,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	c.input.ServiceID = serviceID
+	c.input.ServiceVersion = serviceVersion.Number
+
+	if c.NewName.WasSet {
+		c.input.NewName = fastly.String(c.NewName.Value)
+	}
+
+	if c.Type.WasSet {
+		c.input.Type = directorTypes[c.Type.Value]
+	}
+
+	if c.Comment.WasSet {
+		c.input.Comment = fastly.String(c.Comment.Value)
+	}
+
+	if c.Shield.WasSet {
+		c.input.Shield = fastly.String(c.Shield.Value)
+	}
+
+	if c.Quorum.WasSet {
+		c.input.Quorum = fastly.Uint(c.Quorum.Value)
+	}
+
+	if c.Retries.WasSet {
+		c.input.Retries = fastly.Uint(c.Retries.Value)
+	}
+
+	d, err := c.Globals.APIClient.UpdateDirector(&c.input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	text.Success(out, "Updated director %s (service %s version %d)", d.Name, d.ServiceID, d.ServiceVersion)
+	return nil
+}
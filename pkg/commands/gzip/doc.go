@@ -0,0 +1,3 @@
+// Package gzip contains commands to inspect and manipulate Fastly service
+// gzip configurations.
+package gzip
@@ -0,0 +1,333 @@
+package gzip_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v6/fastly"
+)
+
+func TestGzipCreate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name:      "validate missing --name flag",
+			Args:      args("gzip create --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Name: "validate CreateGzip API error",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				CreateGzipFn:   createGzipError,
+			},
+			Args:      args("gzip create --service-id 123 --version 1 --name compress --autoclone"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate CreateGzip API success",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				CreateGzipFn:   createGzipOK,
+			},
+			Args:       args("gzip create --service-id 123 --version 1 --name compress --content-types text/html --extensions html --autoclone"),
+			WantOutput: "Created gzip configuration compress (service 123 version 4)",
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestGzipList(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name: "validate ListGzips API error",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListGzipsFn:    listGzipsError,
+			},
+			Args:      args("gzip list --service-id 123 --version 1"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate ListGzips API success",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListGzipsFn:    listGzipsOK,
+			},
+			Args:       args("gzip list --service-id 123 --version 1"),
+			WantOutput: listGzipsShortOutput,
+		},
+		{
+			Name: "validate --verbose flag",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListGzipsFn:    listGzipsOK,
+			},
+			Args:       args("gzip list --service-id 123 --version 1 --verbose"),
+			WantOutput: listGzipsVerboseOutput,
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestGzipDescribe(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name:      "validate missing --name flag",
+			Args:      args("gzip describe --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Name: "validate GetGzip API error",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetGzipFn:      getGzipError,
+			},
+			Args:      args("gzip describe --service-id 123 --version 1 --name compress"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate GetGzip API success",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetGzipFn:      getGzipOK,
+			},
+			Args:       args("gzip describe --service-id 123 --version 1 --name compress"),
+			WantOutput: describeGzipOutput,
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestGzipUpdate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name:      "validate missing --name flag",
+			Args:      args("gzip update --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Name: "validate UpdateGzip API error",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				UpdateGzipFn:   updateGzipError,
+			},
+			Args:      args("gzip update --service-id 123 --version 1 --name compress --extensions css --autoclone"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate UpdateGzip API success",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				UpdateGzipFn:   updateGzipOK,
+			},
+			Args:       args("gzip update --service-id 123 --version 1 --name compress --new-name squash --autoclone"),
+			WantOutput: "Updated gzip configuration squash (service 123 version 4)",
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestGzipDelete(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name:      "validate missing --name flag",
+			Args:      args("gzip delete --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Name: "validate DeleteGzip API error",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				DeleteGzipFn:   deleteGzipError,
+			},
+			Args:      args("gzip delete --service-id 123 --version 1 --name compress --autoclone"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate DeleteGzip API success",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				DeleteGzipFn:   deleteGzipOK,
+			},
+			Args:       args("gzip delete --service-id 123 --version 1 --name compress --autoclone"),
+			WantOutput: "Deleted gzip configuration compress (service 123 version 4)",
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func createGzipOK(i *fastly.CreateGzipInput) (*fastly.Gzip, error) {
+	return &fastly.Gzip{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           i.Name,
+		ContentTypes:   i.ContentTypes,
+		Extensions:     i.Extensions,
+	}, nil
+}
+
+func createGzipError(i *fastly.CreateGzipInput) (*fastly.Gzip, error) {
+	return nil, testutil.Err
+}
+
+func listGzipsOK(i *fastly.ListGzipsInput) ([]*fastly.Gzip, error) {
+	return []*fastly.Gzip{
+		{
+			ServiceID:      i.ServiceID,
+			ServiceVersion: i.ServiceVersion,
+			Name:           "compress",
+			ContentTypes:   "text/html text/css",
+			Extensions:     "html css",
+			CacheCondition: "always",
+		},
+		{
+			ServiceID:      i.ServiceID,
+			ServiceVersion: i.ServiceVersion,
+			Name:           "scripts",
+			ContentTypes:   "application/javascript",
+			Extensions:     "js",
+			CacheCondition: "is_static",
+		},
+	}, nil
+}
+
+func listGzipsError(i *fastly.ListGzipsInput) ([]*fastly.Gzip, error) {
+	return nil, testutil.Err
+}
+
+var listGzipsShortOutput = strings.TrimSpace(`
+SERVICE  VERSION  NAME      CONTENT TYPES           EXTENSIONS  CACHE CONDITION
+123      1        compress  text/html text/css      html css    always
+123      1        scripts   application/javascript  js          is_static
+`) + "\n"
+
+var listGzipsVerboseOutput = strings.Join([]string{
+	"Fastly API token not provided",
+	"Fastly API endpoint: https://api.fastly.com",
+	"Service ID (via --service-id): 123",
+	"",
+	"Version: 1",
+	"	Gzip 1/2",
+	"		Name: compress",
+	"		Content types: text/html text/css",
+	"		Extensions: html css",
+	"		Cache condition: always",
+	"	Gzip 2/2",
+	"		Name: scripts",
+	"		Content types: application/javascript",
+	"		Extensions: js",
+	"		Cache condition: is_static",
+}, "\n") + "\n\n"
+
+func getGzipOK(i *fastly.GetGzipInput) (*fastly.Gzip, error) {
+	return &fastly.Gzip{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           i.Name,
+		ContentTypes:   "text/html text/css",
+		Extensions:     "html css",
+	}, nil
+}
+
+func getGzipError(i *fastly.GetGzipInput) (*fastly.Gzip, error) {
+	return nil, testutil.Err
+}
+
+var describeGzipOutput = "\n" + strings.Join([]string{
+	"Service ID: 123",
+	"Version: 1",
+	"Name: compress",
+	"Content types: text/html text/css",
+	"Extensions: html css",
+	"Cache condition: ",
+}, "\n") + "\n"
+
+func updateGzipOK(i *fastly.UpdateGzipInput) (*fastly.Gzip, error) {
+	return &fastly.Gzip{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           *i.NewName,
+	}, nil
+}
+
+func updateGzipError(i *fastly.UpdateGzipInput) (*fastly.Gzip, error) {
+	return nil, testutil.Err
+}
+
+func deleteGzipOK(i *fastly.DeleteGzipInput) error {
+	return nil
+}
+
+func deleteGzipError(i *fastly.DeleteGzipInput) error {
+	return testutil.Err
+}
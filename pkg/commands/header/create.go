@@ -0,0 +1,128 @@
+package header
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v6/fastly"
+)
+
+// headerActions is the list of supported header actions.
+var headerActions = []string{
+	string(fastly.HeaderActionSet),
+	string(fastly.HeaderActionAppend),
+	string(fastly.HeaderActionDelete),
+	string(fastly.HeaderActionRegex),
+	string(fastly.HeaderActionRegexRepeat),
+}
+
+// headerTypes is the list of supported header types.
+var headerTypes = []string{
+	string(fastly.HeaderTypeRequest),
+	string(fastly.HeaderTypeFetch),
+	string(fastly.HeaderTypeCache),
+	string(fastly.HeaderTypeResponse),
+}
+
+// CreateCommand calls the Fastly API to create headers.
+type CreateCommand struct {
+	cmd.Base
+	input          fastly.CreateHeaderInput
+	action         string
+	autoClone      cmd.OptionalAutoClone
+	headerType     string
+	ignoreIfSet    bool
+	manifest       manifest.Data
+	priority       cmd.OptionalUint
+	serviceName    cmd.OptionalServiceNameID
+	serviceVersion cmd.OptionalServiceVersion
+}
+
+// NewCreateCommand returns a usable command registered under the parent.
+func NewCreateCommand(parent cmd.Registerer, globals *config.Data, data manifest.Data) *CreateCommand {
+	var c CreateCommand
+	c.Globals = globals
+	c.manifest = data
+	c.CmdClause = parent.Command("create", "Create a header on a Fastly service version").Alias("add")
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Name:        cmd.FlagServiceIDName,
+		Description: cmd.FlagServiceIDDesc,
+		Dst:         &c.manifest.Flag.ServiceID,
+		Short:       's',
+	})
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Action:      c.serviceName.Set,
+		Name:        cmd.FlagServiceName,
+		Description: cmd.FlagServiceDesc,
+		Dst:         &c.serviceName.Value,
+	})
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Name:        cmd.FlagVersionName,
+		Description: cmd.FlagVersionDesc,
+		Dst:         &c.serviceVersion.Value,
+		Required:    true,
+	})
+	c.RegisterAutoCloneFlag(cmd.AutoCloneFlagOpts{
+		Action: c.autoClone.Set,
+		Dst:    &c.autoClone.Value,
+	})
+	c.CmdClause.Flag("name", "Header name").Short('n').Required().StringVar(&c.input.Name)
+	c.CmdClause.Flag("action", "Accepts a string value: set, append, delete, regex, regex_repeat").Required().EnumVar(&c.action, headerActions...)
+	c.CmdClause.Flag("type", "Accepts a string value: request, fetch, cache, response").Required().EnumVar(&c.headerType, headerTypes...)
+	c.CmdClause.Flag("dst", "Header to set, e.g. http.X-Example").Required().StringVar(&c.input.Destination)
+	c.CmdClause.Flag("src", "Variable to be used as a source for the header content. Does not apply to delete action").StringVar(&c.input.Source)
+	c.CmdClause.Flag("regex", "Regular expression to use. Only applies to regex and regex_repeat actions").StringVar(&c.input.Regex)
+	c.CmdClause.Flag("substitution", "Value to substitute in place of regular expression. Only applies to regex and regex_repeat actions").StringVar(&c.input.Substitution)
+	c.CmdClause.Flag("ignore-if-set", "Don't add the header if it is added already. Only applies to set action").BoolVar(&c.ignoreIfSet)
+	c.CmdClause.Flag("priority", "Priority determines execution order. Lower numbers execute first").Action(c.priority.Set).UintVar(&c.priority.Value)
+	c.CmdClause.Flag("request-condition", "Name of a request condition to apply").StringVar(&c.input.RequestCondition)
+	c.CmdClause.Flag("cache-condition", "Name of a cache condition to apply").StringVar(&c.input.CacheCondition)
+	c.CmdClause.Flag("response-condition", "Name of a response condition to apply").StringVar(&c.input.ResponseCondition)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *CreateCommand) Exec(_ io.Reader, out io.Writer) error {
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AutoCloneFlag:      c.autoClone,
+		APIClient:          c.Globals.APIClient,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceNameFlag:    c.serviceName,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	c.input.ServiceID = serviceID
+	c.input.ServiceVersion = serviceVersion.Number
+	c.input.Action = fastly.HeaderAction(c.action)
+	c.input.Type = fastly.HeaderType(c.headerType)
+	c.input.IgnoreIfSet = fastly.Compatibool(c.ignoreIfSet)
+
+	if c.priority.WasSet {
+		c.input.Priority = fastly.Uint(c.priority.Value)
+	}
+
+	h, err := c.Globals.APIClient.CreateHeader(&c.input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	text.Success(out, "Created header %s (service %s version %d)", h.Name, h.ServiceID, h.ServiceVersion)
+	return nil
+}
@@ -0,0 +1,378 @@
+package header_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v6/fastly"
+)
+
+func TestHeaderCreate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name:      "validate missing --name flag",
+			Args:      args("header create --service-id 123 --version 1 --action set --type request --dst http.X-Foo"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Name:      "validate invalid --action flag",
+			Args:      args("header create --service-id 123 --version 1 --name foo --action nope --type request --dst http.X-Foo"),
+			WantError: "enum value must be one of set,append,delete,regex,regex_repeat",
+		},
+		{
+			Name: "validate CreateHeader API error",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				CreateHeaderFn: createHeaderError,
+			},
+			Args:      args("header create --service-id 123 --version 1 --name foo --action set --type request --dst http.X-Foo --autoclone"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate CreateHeader API success",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				CreateHeaderFn: func(i *fastly.CreateHeaderInput) (*fastly.Header, error) {
+					if i.Action != fastly.HeaderActionSet || i.Type != fastly.HeaderTypeRequest || !bool(i.IgnoreIfSet) || i.Priority == nil || *i.Priority != 10 || i.RequestCondition != "is_api" {
+						return nil, testutil.Err
+					}
+					return &fastly.Header{
+						ServiceID:      i.ServiceID,
+						ServiceVersion: i.ServiceVersion,
+						Name:           i.Name,
+					}, nil
+				},
+			},
+			Args:       args(`header create --service-id 123 --version 1 --name foo --action set --type request --dst http.X-Foo --src "bar" --ignore-if-set --priority 10 --request-condition is_api --autoclone`),
+			WantOutput: "Created header foo (service 123 version 4)",
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestHeaderList(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name: "validate ListHeaders API error",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListHeadersFn:  listHeadersError,
+			},
+			Args:      args("header list --service-id 123 --version 1"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate ListHeaders API success",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListHeadersFn:  listHeadersOK,
+			},
+			Args:       args("header list --service-id 123 --version 1"),
+			WantOutput: listHeadersShortOutput,
+		},
+		{
+			Name: "validate --verbose flag",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListHeadersFn:  listHeadersOK,
+			},
+			Args:       args("header list --service-id 123 --version 1 --verbose"),
+			WantOutput: listHeadersVerboseOutput,
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestHeaderDescribe(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name:      "validate missing --name flag",
+			Args:      args("header describe --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Name: "validate GetHeader API error",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetHeaderFn:    getHeaderError,
+			},
+			Args:      args("header describe --service-id 123 --version 1 --name foo"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate GetHeader API success",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetHeaderFn:    getHeaderOK,
+			},
+			Args:       args("header describe --service-id 123 --version 1 --name foo"),
+			WantOutput: describeHeaderOutput,
+		},
+		{
+			Name: "validate --json flag",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetHeaderFn:    getHeaderOK,
+			},
+			Args:       args("header describe --service-id 123 --version 1 --name foo --json"),
+			WantOutput: `"Name":"foo","Action":"set","IgnoreIfSet":false,"Type":"response","Destination":"http.X-Foo"`,
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestHeaderUpdate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name:      "validate missing --name flag",
+			Args:      args("header update --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Name: "validate UpdateHeader API error",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				UpdateHeaderFn: updateHeaderError,
+			},
+			Args:      args("header update --service-id 123 --version 1 --name foo --new-name bar --autoclone"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate UpdateHeader API success",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				UpdateHeaderFn: func(i *fastly.UpdateHeaderInput) (*fastly.Header, error) {
+					if i.Action == nil || *i.Action != fastly.HeaderActionRegex || i.Regex == nil || i.Source != nil {
+						return nil, testutil.Err
+					}
+					return &fastly.Header{
+						ServiceID:      i.ServiceID,
+						ServiceVersion: i.ServiceVersion,
+						Name:           *i.NewName,
+					}, nil
+				},
+			},
+			Args:       args("header update --service-id 123 --version 1 --name foo --new-name bar --action regex --regex ^a --substitution b --autoclone"),
+			WantOutput: "Updated header bar (service 123 version 4)",
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestHeaderDelete(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name:      "validate missing --name flag",
+			Args:      args("header delete --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Name: "validate DeleteHeader API error",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				DeleteHeaderFn: deleteHeaderError,
+			},
+			Args:      args("header delete --service-id 123 --version 1 --name foo --autoclone"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate DeleteHeader API success",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				DeleteHeaderFn: deleteHeaderOK,
+			},
+			Args:       args("header delete --service-id 123 --version 1 --name foo --autoclone"),
+			WantOutput: "Deleted header foo (service 123 version 4)",
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func createHeaderError(i *fastly.CreateHeaderInput) (*fastly.Header, error) {
+	return nil, testutil.Err
+}
+
+func listHeadersOK(i *fastly.ListHeadersInput) ([]*fastly.Header, error) {
+	return []*fastly.Header{
+		{
+			ServiceID:      i.ServiceID,
+			ServiceVersion: i.ServiceVersion,
+			Name:           "foo",
+			Action:         fastly.HeaderActionSet,
+			Type:           fastly.HeaderTypeResponse,
+			Destination:    "http.X-Foo",
+			Source:         `"bar"`,
+			Priority:       10,
+		},
+		{
+			ServiceID:        i.ServiceID,
+			ServiceVersion:   i.ServiceVersion,
+			Name:             "strip",
+			Action:           fastly.HeaderActionDelete,
+			Type:             fastly.HeaderTypeRequest,
+			Destination:      "http.Cookie",
+			Priority:         100,
+			RequestCondition: "is_api",
+		},
+	}, nil
+}
+
+func listHeadersError(i *fastly.ListHeadersInput) ([]*fastly.Header, error) {
+	return nil, testutil.Err
+}
+
+var listHeadersShortOutput = strings.TrimSpace(`
+SERVICE  VERSION  NAME   ACTION  TYPE      DESTINATION  PRIORITY
+123      1        foo    set     response  http.X-Foo   10
+123      1        strip  delete  request   http.Cookie  100
+`) + "\n"
+
+var listHeadersVerboseOutput = strings.Join([]string{
+	"Fastly API token not provided",
+	"Fastly API endpoint: https://api.fastly.com",
+	"Service ID (via --service-id): 123",
+	"",
+	"Version: 1",
+	"	Header 1/2",
+	"		Name: foo",
+	"		Action: set",
+	"		Type: response",
+	"		Destination: http.X-Foo",
+	`		Source: "bar"`,
+	"		Regex: ",
+	"		Substitution: ",
+	"		Ignore if set: false",
+	"		Priority: 10",
+	"		Request condition: ",
+	"		Cache condition: ",
+	"		Response condition: ",
+	"	Header 2/2",
+	"		Name: strip",
+	"		Action: delete",
+	"		Type: request",
+	"		Destination: http.Cookie",
+	"		Source: ",
+	"		Regex: ",
+	"		Substitution: ",
+	"		Ignore if set: false",
+	"		Priority: 100",
+	"		Request condition: is_api",
+	"		Cache condition: ",
+	"		Response condition: ",
+}, "\n") + "\n\n"
+
+func getHeaderOK(i *fastly.GetHeaderInput) (*fastly.Header, error) {
+	return &fastly.Header{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           "foo",
+		Action:         fastly.HeaderActionSet,
+		Type:           fastly.HeaderTypeResponse,
+		Destination:    "http.X-Foo",
+		Source:         `"bar"`,
+		Priority:       10,
+	}, nil
+}
+
+func getHeaderError(i *fastly.GetHeaderInput) (*fastly.Header, error) {
+	return nil, testutil.Err
+}
+
+var describeHeaderOutput = "\n" + strings.Join([]string{
+	"Service ID: 123",
+	"Version: 1",
+	"Name: foo",
+	"Action: set",
+	"Type: response",
+	"Destination: http.X-Foo",
+	`Source: "bar"`,
+	"Regex: ",
+	"Substitution: ",
+	"Ignore if set: false",
+	"Priority: 10",
+	"Request condition: ",
+	"Cache condition: ",
+	"Response condition: ",
+}, "\n") + "\n"
+
+func updateHeaderError(i *fastly.UpdateHeaderInput) (*fastly.Header, error) {
+	return nil, testutil.Err
+}
+
+func deleteHeaderOK(i *fastly.DeleteHeaderInput) error {
+	return nil
+}
+
+func deleteHeaderError(i *fastly.DeleteHeaderInput) error {
+	return testutil.Err
+}
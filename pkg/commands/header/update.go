@@ -0,0 +1,164 @@
+package header
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v6/fastly"
+)
+
+// UpdateCommand calls the Fastly API to update headers.
+type UpdateCommand struct {
+	cmd.Base
+	manifest       manifest.Data
+	input          fastly.UpdateHeaderInput
+	serviceName    cmd.OptionalServiceNameID
+	serviceVersion cmd.OptionalServiceVersion
+	autoClone      cmd.OptionalAutoClone
+
+	NewName           cmd.OptionalString
+	Action            cmd.OptionalString
+	Type              cmd.OptionalString
+	Destination       cmd.OptionalString
+	Source            cmd.OptionalString
+	Regex             cmd.OptionalString
+	Substitution      cmd.OptionalString
+	IgnoreIfSet       cmd.OptionalBool
+	Priority          cmd.OptionalUint
+	RequestCondition  cmd.OptionalString
+	CacheCondition    cmd.OptionalString
+	ResponseCondition cmd.OptionalString
+}
+
+// NewUpdateCommand returns a usable command registered under the parent.
+func NewUpdateCommand(parent cmd.Registerer, globals *config.Data, data manifest.Data) *UpdateCommand {
+	var c UpdateCommand
+	c.Globals = globals
+	c.manifest = data
+	c.CmdClause = parent.Command("update", "Update a header on a Fastly service version")
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Name:        cmd.FlagServiceIDName,
+		Description: cmd.FlagServiceIDDesc,
+		Dst:         &c.manifest.Flag.ServiceID,
+		Short:       's',
+	})
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Action:      c.serviceName.Set,
+		Name:        cmd.FlagServiceName,
+		Description: cmd.FlagServiceDesc,
+		Dst:         &c.serviceName.Value,
+	})
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Name:        cmd.FlagVersionName,
+		Description: cmd.FlagVersionDesc,
+		Dst:         &c.serviceVersion.Value,
+		Required:    true,
+	})
+	c.RegisterAutoCloneFlag(cmd.AutoCloneFlagOpts{
+		Action: c.autoClone.Set,
+		Dst:    &c.autoClone.Value,
+	})
+	c.CmdClause.Flag("name", "Header name").Short('n').Required().StringVar(&c.input.Name)
+	c.CmdClause.Flag("new-name", "New header name").Action(c.NewName.Set).StringVar(&c.NewName.Value)
+	c.CmdClause.Flag("action", "Accepts a string value: set, append, delete, regex, regex_repeat").Action(c.Action.Set).EnumVar(&c.Action.Value, headerActions...)
+	c.CmdClause.Flag("type", "Accepts a string value: request, fetch, cache, response").Action(c.Type.Set).EnumVar(&c.Type.Value, headerTypes...)
+	c.CmdClause.Flag("dst", "Header to set, e.g. http.X-Example").Action(c.Destination.Set).StringVar(&c.Destination.Value)
+	c.CmdClause.Flag("src", "Variable to be used as a source for the header content. Does not apply to delete action").Action(c.Source.Set).StringVar(&c.Source.Value)
+	c.CmdClause.Flag("regex", "Regular expression to use. Only applies to regex and regex_repeat actions").Action(c.Regex.Set).StringVar(&c.Regex.Value)
+	c.CmdClause.Flag("substitution", "Value to substitute in place of regular expression. Only applies to regex and regex_repeat actions").Action(c.Substitution.Set).StringVar(&c.Substitution.Value)
+	c.CmdClause.Flag("ignore-if-set", "Don't add the header if it is added already. Only applies to set action").Action(c.IgnoreIfSet.Set).BoolVar(&c.IgnoreIfSet.Value)
+	c.CmdClause.Flag("priority", "Priority determines execution order. Lower numbers execute first").Action(c.Priority.Set).UintVar(&c.Priority.Value)
+	c.CmdClause.Flag("request-condition", "Name of a request condition to apply").Action(c.RequestCondition.Set).StringVar(&c.RequestCondition.Value)
+	c.CmdClause.Flag("cache-condition", "Name of a cache condition to apply").Action(c.CacheCondition.Set).StringVar(&c.CacheCondition.Value)
+	c.CmdClause.Flag("response-condition", "Name of a response condition to apply").Action(c.ResponseCondition.Set).StringVar(&c.ResponseCondition.Value)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *UpdateCommand) Exec(_ io.Reader, out io.Writer) error {
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AutoCloneFlag:      c.autoClone,
+		APIClient:          c.Globals.APIClient,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceNameFlag:    c.serviceName,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	c.input.ServiceID = serviceID
+	c.input.ServiceVersion = serviceVersion.Number
+
+	if c.NewName.WasSet {
+		c.input.NewName = fastly.String(c.NewName.Value)
+	}
+
+	if c.Action.WasSet {
+		action := fastly.HeaderAction(c.Action.Value)
+		c.input.Action = &action
+	}
+
+	if c.Type.WasSet {
+		headerType := fastly.HeaderType(c.Type.Value)
+		c.input.Type = &headerType
+	}
+
+	if c.Destination.WasSet {
+		c.input.Destination = fastly.String(c.Destination.Value)
+	}
+
+	if c.Source.WasSet {
+		c.input.Source = fastly.String(c.Source.Value)
+	}
+
+	if c.Regex.WasSet {
+		c.input.Regex = fastly.String(c.Regex.Value)
+	}
+
+	if c.Substitution.WasSet {
+		c.input.Substitution = fastly.String(c.Substitution.Value)
+	}
+
+	if c.IgnoreIfSet.WasSet {
+		c.input.IgnoreIfSet = fastly.CBool(c.IgnoreIfSet.Value)
+	}
+
+	if c.Priority.WasSet {
+		c.input.Priority = fastly.Uint(c.Priority.Value)
+	}
+
+	if c.RequestCondition.WasSet {
+		c.input.RequestCondition = fastly.String(c.RequestCondition.Value)
+	}
+
+	if c.CacheCondition.WasSet {
+		c.input.CacheCondition = fastly.String(c.CacheCondition.Value)
+	}
+
+	if c.ResponseCondition.WasSet {
+		c.input.ResponseCondition = fastly.String(c.ResponseCondition.Value)
+	}
+
+	h, err := c.Globals.APIClient.UpdateHeader(&c.input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	text.Success(out, "Updated header %s (service %s version %d)", h.Name, h.ServiceID, h.ServiceVersion)
+	return nil
+}
@@ -21,11 +21,16 @@ func NewRootCommand(parent cmd.Registerer, globals *config.Data) *RootCommand {
 	var c RootCommand
 	c.Globals = globals
 	c.CmdClause = parent.Command("ip-list", "List Fastly's public IPs")
+	c.RegisterOutputFlags()
 	return &c
 }
 
 // Exec implements the command interface.
 func (c *RootCommand) Exec(_ io.Reader, out io.Writer) error {
+	if err := c.CheckOutputFlags(); err != nil {
+		return err
+	}
+
 	_, s := c.Globals.Token()
 	if s == config.SourceUndefined {
 		return errors.ErrNoToken
@@ -37,6 +42,14 @@ func (c *RootCommand) Exec(_ io.Reader, out io.Writer) error {
 		return err
 	}
 
+	ips := struct {
+		IPv4 []string `json:"ipv4"`
+		IPv6 []string `json:"ipv6"`
+	}{ipv4, ipv6}
+	if ok, err := c.WriteOutput(out, ips); ok {
+		return err
+	}
+
 	text.Break(out)
 	fmt.Fprintf(out, "%s\n", text.Bold("IPv4"))
 	for _, ip := range ipv4 {
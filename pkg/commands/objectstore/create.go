@@ -0,0 +1,46 @@
+package objectstore
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v6/fastly"
+)
+
+// NewCreateCommand returns a usable command registered under the parent.
+func NewCreateCommand(parent cmd.Registerer, globals *config.Data, data manifest.Data) *CreateCommand {
+	var c CreateCommand
+	c.CmdClause = parent.Command("create", "Create a Fastly object store").Alias("add")
+	c.Globals = globals
+	c.manifest = data
+
+	// Required flags
+	c.CmdClause.Flag("name", "Name of the object store").Short('n').Required().StringVar(&c.input.Name)
+
+	return &c
+}
+
+// CreateCommand calls the Fastly API to create an object store.
+type CreateCommand struct {
+	cmd.Base
+
+	input    fastly.CreateObjectStoreInput
+	manifest manifest.Data
+}
+
+// Exec invokes the application logic for the command.
+func (c *CreateCommand) Exec(_ io.Reader, out io.Writer) error {
+	store, err := c.Globals.APIClient.CreateObjectStore(&c.input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Name": c.input.Name,
+		})
+		return err
+	}
+
+	text.Success(out, "Created object store %s (id %s)", store.Name, store.ID)
+	return nil
+}
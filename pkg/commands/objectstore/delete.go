@@ -0,0 +1,46 @@
+package objectstore
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v6/fastly"
+)
+
+// NewDeleteCommand returns a usable command registered under the parent.
+func NewDeleteCommand(parent cmd.Registerer, globals *config.Data, data manifest.Data) *DeleteCommand {
+	var c DeleteCommand
+	c.CmdClause = parent.Command("delete", "Delete a Fastly object store").Alias("remove")
+	c.Globals = globals
+	c.manifest = data
+
+	// Required flags
+	c.CmdClause.Flag("id", "ID of the object store").Required().StringVar(&c.input.ID)
+
+	return &c
+}
+
+// DeleteCommand calls the Fastly API to delete an object store.
+type DeleteCommand struct {
+	cmd.Base
+
+	input    fastly.DeleteObjectStoreInput
+	manifest manifest.Data
+}
+
+// Exec invokes the application logic for the command.
+func (c *DeleteCommand) Exec(_ io.Reader, out io.Writer) error {
+	err := c.Globals.APIClient.DeleteObjectStore(&c.input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Object Store ID": c.input.ID,
+		})
+		return err
+	}
+
+	text.Success(out, "Deleted object store %s", c.input.ID)
+	return nil
+}
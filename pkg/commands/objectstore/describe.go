@@ -0,0 +1,57 @@
+package objectstore
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v6/fastly"
+)
+
+// NewDescribeCommand returns a usable command registered under the parent.
+func NewDescribeCommand(parent cmd.Registerer, globals *config.Data, data manifest.Data) *DescribeCommand {
+	var c DescribeCommand
+	c.CmdClause = parent.Command("describe", "Show detailed information about a Fastly object store").Alias("get")
+	c.Globals = globals
+	c.manifest = data
+
+	// Required flags
+	c.CmdClause.Flag("id", "ID of the object store").Required().StringVar(&c.input.ID)
+
+	// Optional flags
+	c.RegisterOutputFlags()
+
+	return &c
+}
+
+// DescribeCommand calls the Fastly API to describe an object store.
+type DescribeCommand struct {
+	cmd.Base
+
+	input    fastly.GetObjectStoreInput
+	manifest manifest.Data
+}
+
+// Exec invokes the application logic for the command.
+func (c *DescribeCommand) Exec(_ io.Reader, out io.Writer) error {
+	if err := c.CheckOutputFlags(); err != nil {
+		return err
+	}
+
+	store, err := c.Globals.APIClient.GetObjectStore(&c.input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Object Store ID": c.input.ID,
+		})
+		return err
+	}
+
+	if ok, err := c.WriteOutput(out, store); ok {
+		return err
+	}
+
+	text.PrintObjectStore(out, "", store)
+	return nil
+}
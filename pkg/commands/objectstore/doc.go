@@ -0,0 +1,3 @@
+// Package objectstore contains commands to inspect and manipulate Fastly
+// object stores.
+package objectstore
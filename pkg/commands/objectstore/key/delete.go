@@ -0,0 +1,48 @@
+package key
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v6/fastly"
+)
+
+// NewDeleteCommand returns a usable command registered under the parent.
+func NewDeleteCommand(parent cmd.Registerer, globals *config.Data, data manifest.Data) *DeleteCommand {
+	var c DeleteCommand
+	c.CmdClause = parent.Command("delete", "Delete a key from a Fastly object store").Alias("remove")
+	c.Globals = globals
+	c.manifest = data
+
+	// Required flags
+	c.CmdClause.Flag("id", "ID of the object store").Required().StringVar(&c.input.ID)
+	c.CmdClause.Flag("key", "Key to delete").Short('k').Required().StringVar(&c.input.Key)
+
+	return &c
+}
+
+// DeleteCommand calls the Fastly API to delete an object store key.
+type DeleteCommand struct {
+	cmd.Base
+
+	input    fastly.DeleteObjectStoreKeyInput
+	manifest manifest.Data
+}
+
+// Exec invokes the application logic for the command.
+func (c *DeleteCommand) Exec(_ io.Reader, out io.Writer) error {
+	err := c.Globals.APIClient.DeleteObjectStoreKey(&c.input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Object Store ID": c.input.ID,
+			"Key":             c.input.Key,
+		})
+		return err
+	}
+
+	text.Success(out, "Deleted key %s from object store %s", c.input.Key, c.input.ID)
+	return nil
+}
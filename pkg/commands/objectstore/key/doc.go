@@ -0,0 +1,3 @@
+// Package key contains commands to inspect and manipulate the keys of a
+// Fastly object store.
+package key
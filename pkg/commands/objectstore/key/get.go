@@ -0,0 +1,52 @@
+package key
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/go-fastly/v6/fastly"
+)
+
+// NewGetCommand returns a usable command registered under the parent.
+func NewGetCommand(parent cmd.Registerer, globals *config.Data, data manifest.Data) *GetCommand {
+	var c GetCommand
+	c.CmdClause = parent.Command("get", "Print the value of a key in a Fastly object store")
+	c.Globals = globals
+	c.manifest = data
+
+	// Required flags
+	c.CmdClause.Flag("id", "ID of the object store").Required().StringVar(&c.input.ID)
+	c.CmdClause.Flag("key", "Key to fetch").Short('k').Required().StringVar(&c.input.Key)
+
+	return &c
+}
+
+// GetCommand calls the Fastly API to fetch the value of an object store key.
+type GetCommand struct {
+	cmd.Base
+
+	input    fastly.GetObjectStoreKeyInput
+	manifest manifest.Data
+}
+
+// Exec invokes the application logic for the command.
+func (c *GetCommand) Exec(_ io.Reader, out io.Writer) error {
+	value, err := c.Globals.APIClient.GetObjectStoreKey(&c.input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Object Store ID": c.input.ID,
+			"Key":             c.input.Key,
+		})
+		return err
+	}
+
+	_, err = io.WriteString(out, value)
+	if err != nil {
+		c.Globals.ErrLog.Add(err)
+		return fmt.Errorf("error: unable to write data to stdout: %w", err)
+	}
+	return nil
+}
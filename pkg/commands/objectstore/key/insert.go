@@ -0,0 +1,166 @@
+package key
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v6/fastly"
+)
+
+// NewInsertCommand returns a usable command registered under the parent.
+func NewInsertCommand(parent cmd.Registerer, globals *config.Data, data manifest.Data) *InsertCommand {
+	var c InsertCommand
+	c.CmdClause = parent.Command("insert", "Insert one or more keys into a Fastly object store").Alias("add")
+	c.Globals = globals
+	c.manifest = data
+
+	// Required flags
+	c.CmdClause.Flag("id", "ID of the object store").Required().StringVar(&c.id)
+
+	// Optional flags
+	c.CmdClause.Flag("dir", "Insert every file below this directory, keyed by its path relative to the directory").StringVar(&c.dir)
+	c.CmdClause.Flag("file", "Path to a file whose contents is the value of --key").StringVar(&c.file)
+	c.CmdClause.Flag("key", "Key to insert").Short('k').StringVar(&c.key)
+	c.CmdClause.Flag("local-store", "Insert the entries of the named store in the [local_server.object_stores] section of the fastly.toml manifest").StringVar(&c.localStore)
+	c.CmdClause.Flag("value", "Value of --key").StringVar(&c.value)
+
+	return &c
+}
+
+// InsertCommand calls the Fastly API to insert keys into an object store.
+type InsertCommand struct {
+	cmd.Base
+
+	dir        string
+	file       string
+	id         string
+	key        string
+	localStore string
+	manifest   manifest.Data
+	value      string
+}
+
+// Exec invokes the application logic for the command.
+func (c *InsertCommand) Exec(_ io.Reader, out io.Writer) error {
+	entries, err := c.entries()
+	if err != nil {
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+
+	for _, e := range entries {
+		value, err := entryValue(e)
+		if err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]any{
+				"Key":  e.Key,
+				"Path": e.Path,
+			})
+			return err
+		}
+
+		err = c.Globals.APIClient.InsertObjectStoreKey(&fastly.InsertObjectStoreKeyInput{
+			ID:    c.id,
+			Key:   e.Key,
+			Value: value,
+		})
+		if err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]any{
+				"Object Store ID": c.id,
+				"Key":             e.Key,
+			})
+			return err
+		}
+
+		if c.Globals.Verbose() {
+			text.Output(out, "Inserted key %s", e.Key)
+		}
+	}
+
+	if len(entries) == 1 {
+		text.Success(out, "Inserted key %s into object store %s", entries[0].Key, c.id)
+		return nil
+	}
+	text.Success(out, "Inserted %d keys into object store %s", len(entries), c.id)
+	return nil
+}
+
+// entries returns the object store entries described by the command flags,
+// using the same key/path/data shape as [local_server.object_stores].
+func (c *InsertCommand) entries() ([]manifest.LocalObjectStore, error) {
+	var sources int
+	for _, set := range []bool{c.key != "", c.dir != "", c.localStore != ""} {
+		if set {
+			sources++
+		}
+	}
+	if sources != 1 {
+		return nil, fmt.Errorf("error parsing arguments: exactly one of --key, --dir or --local-store must be provided")
+	}
+
+	switch {
+	case c.key != "":
+		if (c.file == "") == (c.value == "") {
+			return nil, fmt.Errorf("error parsing arguments: --key requires exactly one of --value or --file")
+		}
+		return []manifest.LocalObjectStore{{Key: c.key, Path: c.file, Data: c.value}}, nil
+	case c.file != "" || c.value != "":
+		return nil, fmt.Errorf("error parsing arguments: --value and --file can only be used with --key")
+	case c.dir != "":
+		return dirEntries(c.dir)
+	}
+
+	entries, ok := c.manifest.File.LocalServer.ObjectStore[c.localStore]
+	if !ok {
+		return nil, fmt.Errorf("error reading manifest: no [local_server.object_stores.%s] found in %s", c.localStore, manifest.Filename)
+	}
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("error reading manifest: [local_server.object_stores.%s] has no entries", c.localStore)
+	}
+	return entries, nil
+}
+
+// dirEntries returns an entry for every regular file below dir. Keys are the
+// slash-separated file paths relative to dir.
+func dirEntries(dir string) ([]manifest.LocalObjectStore, error) {
+	var entries []manifest.LocalObjectStore
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		entries = append(entries, manifest.LocalObjectStore{Key: filepath.ToSlash(rel), Path: path})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading directory '%s': %w", dir, err)
+	}
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("error reading directory '%s': no files found", dir)
+	}
+	return entries, nil
+}
+
+// entryValue returns the value of e, reading it from disk when a path is set.
+func entryValue(e manifest.LocalObjectStore) (string, error) {
+	if e.Path == "" {
+		return e.Data, nil
+	}
+	data, err := os.ReadFile(filepath.Clean(e.Path))
+	if err != nil {
+		return "", fmt.Errorf("error reading file '%s' for key %s: %w", e.Path, e.Key, err)
+	}
+	return string(data), nil
+}
@@ -0,0 +1,277 @@
+package key_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v6/fastly"
+)
+
+func TestKeyList(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name:      "validate missing --id flag",
+			Args:      args("object-store key list"),
+			WantError: "error parsing arguments: required flag --id not provided",
+		},
+		{
+			Name: "validate ListObjectStoreKeys API error",
+			API: mock.API{
+				ListObjectStoreKeysFn: func(i *fastly.ListObjectStoreKeysInput) (*fastly.ListObjectStoreKeysResponse, error) {
+					return nil, testutil.Err
+				},
+			},
+			Args:      args("object-store key list --id abc"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate ListObjectStoreKeys follows the cursor",
+			API: mock.API{
+				ListObjectStoreKeysFn: func(i *fastly.ListObjectStoreKeysInput) (*fastly.ListObjectStoreKeysResponse, error) {
+					if i.Cursor == "" {
+						return &fastly.ListObjectStoreKeysResponse{
+							Data: []string{"css/main.css", "index.html"},
+							Meta: map[string]string{"next_cursor": "page2"},
+						}, nil
+					}
+					return &fastly.ListObjectStoreKeysResponse{Data: []string{"robots.txt"}}, nil
+				},
+			},
+			Args:       args("object-store key list --id abc"),
+			WantOutput: "css/main.css\nindex.html\nrobots.txt\n",
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestKeyGet(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name:      "validate missing --key flag",
+			Args:      args("object-store key get --id abc"),
+			WantError: "error parsing arguments: required flag --key not provided",
+		},
+		{
+			Name: "validate GetObjectStoreKey API success",
+			API: mock.API{
+				GetObjectStoreKeyFn: func(i *fastly.GetObjectStoreKeyInput) (string, error) {
+					return "<h1>hello</h1>", nil
+				},
+			},
+			Args:       args("object-store key get --id abc --key index.html"),
+			WantOutput: "<h1>hello</h1>",
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestKeyDelete(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name: "validate DeleteObjectStoreKey API error",
+			API: mock.API{
+				DeleteObjectStoreKeyFn: func(i *fastly.DeleteObjectStoreKeyInput) error {
+					return testutil.Err
+				},
+			},
+			Args:      args("object-store key delete --id abc --key index.html"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate DeleteObjectStoreKey API success",
+			API: mock.API{
+				DeleteObjectStoreKeyFn: func(i *fastly.DeleteObjectStoreKeyInput) error {
+					return nil
+				},
+			},
+			Args:       args("object-store key delete --id abc --key index.html"),
+			WantOutput: "Deleted key index.html from object store abc",
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestKeyInsert(t *testing.T) {
+	args := testutil.Args
+	scenarios := []struct {
+		testutil.TestScenario
+		wantInserted map[string]string
+	}{
+		{
+			TestScenario: testutil.TestScenario{
+				Name:      "validate no source",
+				Args:      args("object-store key insert --id abc"),
+				WantError: "exactly one of --key, --dir or --local-store must be provided",
+			},
+		},
+		{
+			TestScenario: testutil.TestScenario{
+				Name:      "validate multiple sources",
+				Args:      args("object-store key insert --id abc --key foo --value bar --dir testdata/assets"),
+				WantError: "exactly one of --key, --dir or --local-store must be provided",
+			},
+		},
+		{
+			TestScenario: testutil.TestScenario{
+				Name:      "validate --key without a value",
+				Args:      args("object-store key insert --id abc --key foo"),
+				WantError: "--key requires exactly one of --value or --file",
+			},
+		},
+		{
+			TestScenario: testutil.TestScenario{
+				Name:      "validate --value without --key",
+				Args:      args("object-store key insert --id abc --dir testdata/assets --value bar"),
+				WantError: "--value and --file can only be used with --key",
+			},
+		},
+		{
+			TestScenario: testutil.TestScenario{
+				Name:       "validate --key and --value",
+				Args:       args("object-store key insert --id abc --key greeting --value hello"),
+				WantOutput: "Inserted key greeting into object store abc",
+			},
+			wantInserted: map[string]string{"greeting": "hello"},
+		},
+		{
+			TestScenario: testutil.TestScenario{
+				Name:       "validate --key and --file",
+				Args:       args("object-store key insert --id abc --key index.html --file testdata/assets/index.html"),
+				WantOutput: "Inserted key index.html into object store abc",
+			},
+			wantInserted: map[string]string{"index.html": "<h1>hello</h1>\n"},
+		},
+		{
+			TestScenario: testutil.TestScenario{
+				Name:       "validate --dir",
+				Args:       args("object-store key insert --id abc --dir testdata/assets"),
+				WantOutput: "Inserted 2 keys into object store abc",
+			},
+			wantInserted: map[string]string{
+				"css/main.css": "body {}\n",
+				"index.html":   "<h1>hello</h1>\n",
+			},
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			inserted := make(map[string]string)
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(mock.API{
+				InsertObjectStoreKeyFn: func(i *fastly.InsertObjectStoreKeyInput) error {
+					inserted[i.Key] = i.Value
+					return nil
+				},
+			})
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+			if testcase.wantInserted != nil {
+				testutil.AssertEqual(t, testcase.wantInserted, inserted)
+			}
+		})
+	}
+}
+
+// TestKeyInsertLocalStore validates that the entries of a store defined in
+// the [local_server.object_stores] section of the manifest are uploaded.
+func TestKeyInsertLocalStore(t *testing.T) {
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rootdir := testutil.NewEnv(testutil.EnvOpts{
+		T: t,
+		Write: []testutil.FileIO{
+			{Src: "body {}\n", Dst: filepath.Join("static", "main.css")},
+			{Src: `manifest_version = 2
+name = "test"
+
+[local_server]
+  [local_server.object_stores]
+    assets = [
+      { key = "greeting", data = "hello" },
+      { key = "main.css", path = "static/main.css" },
+    ]
+`, Dst: manifest.Filename},
+		},
+	})
+	defer os.RemoveAll(rootdir)
+
+	if err := os.Chdir(rootdir); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+
+	inserted := make(map[string]string)
+	api := mock.API{
+		InsertObjectStoreKeyFn: func(i *fastly.InsertObjectStoreKeyInput) error {
+			inserted[i.Key] = i.Value
+			return nil
+		},
+	}
+
+	var stdout bytes.Buffer
+	opts := testutil.NewRunOpts(testutil.Args("object-store key insert --id abc --local-store missing"), &stdout)
+	opts.APIClient = mock.APIClient(api)
+	err = app.Run(opts)
+	testutil.AssertErrorContains(t, err, "no [local_server.object_stores.missing] found in fastly.toml")
+
+	stdout.Reset()
+	opts = testutil.NewRunOpts(testutil.Args("object-store key insert --id abc --local-store assets"), &stdout)
+	opts.APIClient = mock.APIClient(api)
+	err = app.Run(opts)
+	testutil.AssertNoError(t, err)
+	testutil.AssertStringContains(t, stdout.String(), "Inserted 2 keys into object store abc")
+
+	testutil.AssertEqual(t, map[string]string{
+		"greeting": "hello",
+		"main.css": "body {}\n",
+	}, inserted)
+}
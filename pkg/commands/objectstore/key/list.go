@@ -0,0 +1,67 @@
+package key
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/go-fastly/v6/fastly"
+)
+
+// NewListCommand returns a usable command registered under the parent.
+func NewListCommand(parent cmd.Registerer, globals *config.Data, data manifest.Data) *ListCommand {
+	var c ListCommand
+	c.CmdClause = parent.Command("list", "List the keys of a Fastly object store")
+	c.Globals = globals
+	c.manifest = data
+
+	// Required flags
+	c.CmdClause.Flag("id", "ID of the object store").Required().StringVar(&c.id)
+
+	// Optional flags
+	c.RegisterOutputFlags()
+
+	return &c
+}
+
+// ListCommand calls the Fastly API to list the keys of an object store.
+type ListCommand struct {
+	cmd.Base
+
+	id       string
+	manifest manifest.Data
+}
+
+// Exec invokes the application logic for the command.
+func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
+	if err := c.CheckOutputFlags(); err != nil {
+		return err
+	}
+
+	var keys []string
+	input := fastly.ListObjectStoreKeysInput{ID: c.id}
+	for {
+		o, err := c.Globals.APIClient.ListObjectStoreKeys(&input)
+		if err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]any{
+				"Object Store ID": c.id,
+			})
+			return err
+		}
+		keys = append(keys, o.Data...)
+		if input.Cursor = o.Meta["next_cursor"]; input.Cursor == "" {
+			break
+		}
+	}
+
+	if ok, err := c.WriteOutput(out, keys); ok {
+		return err
+	}
+
+	for _, k := range keys {
+		fmt.Fprintln(out, k)
+	}
+	return nil
+}
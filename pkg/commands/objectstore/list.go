@@ -0,0 +1,75 @@
+package objectstore
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v6/fastly"
+)
+
+// NewListCommand returns a usable command registered under the parent.
+func NewListCommand(parent cmd.Registerer, globals *config.Data, data manifest.Data) *ListCommand {
+	var c ListCommand
+	c.CmdClause = parent.Command("list", "List all Fastly object stores")
+	c.Globals = globals
+	c.manifest = data
+
+	// Optional flags
+	c.RegisterOutputFlags()
+
+	return &c
+}
+
+// ListCommand calls the Fastly API to list object stores.
+type ListCommand struct {
+	cmd.Base
+
+	manifest manifest.Data
+}
+
+// Exec invokes the application logic for the command.
+func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
+	if err := c.CheckOutputFlags(); err != nil {
+		return err
+	}
+
+	var stores []fastly.ObjectStore
+	input := fastly.ListObjectStoresInput{}
+	for {
+		o, err := c.Globals.APIClient.ListObjectStores(&input)
+		if err != nil {
+			c.Globals.ErrLog.Add(err)
+			return err
+		}
+		stores = append(stores, o.Data...)
+		if input.Cursor = o.Meta["next_cursor"]; input.Cursor == "" {
+			break
+		}
+	}
+
+	if ok, err := c.WriteOutput(out, stores); ok {
+		return err
+	}
+
+	if !c.Globals.Verbose() {
+		tw := text.NewTable(out)
+		tw.AddHeader("ID", "NAME")
+		for _, store := range stores {
+			tw.AddLine(store.ID, store.Name)
+		}
+		tw.Print()
+		return nil
+	}
+
+	for i := range stores {
+		fmt.Fprintf(out, "Object store %d/%d\n", i+1, len(stores))
+		text.PrintObjectStore(out, "\t", &stores[i])
+	}
+	fmt.Fprintln(out)
+
+	return nil
+}
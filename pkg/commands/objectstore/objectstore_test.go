@@ -0,0 +1,214 @@
+package objectstore_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v6/fastly"
+)
+
+func TestObjectStoreCreate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name:      "validate missing --name flag",
+			Args:      args("object-store create"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Name: "validate CreateObjectStore API error",
+			API: mock.API{
+				CreateObjectStoreFn: func(i *fastly.CreateObjectStoreInput) (*fastly.ObjectStore, error) {
+					return nil, testutil.Err
+				},
+			},
+			Args:      args("object-store create --name assets"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate CreateObjectStore API success",
+			API: mock.API{
+				CreateObjectStoreFn: func(i *fastly.CreateObjectStoreInput) (*fastly.ObjectStore, error) {
+					return &fastly.ObjectStore{ID: "abc", Name: i.Name}, nil
+				},
+			},
+			Args:       args("object-store create --name assets"),
+			WantOutput: "Created object store assets (id abc)",
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestObjectStoreList(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name: "validate ListObjectStores API error",
+			API: mock.API{
+				ListObjectStoresFn: func(i *fastly.ListObjectStoresInput) (*fastly.ListObjectStoresResponse, error) {
+					return nil, testutil.Err
+				},
+			},
+			Args:      args("object-store list"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate ListObjectStores follows the cursor",
+			API: mock.API{
+				ListObjectStoresFn: listObjectStoresOK,
+			},
+			Args: args("object-store list"),
+			WantOutput: strings.TrimSpace(`
+ID   NAME
+abc  assets
+def  config
+`) + "\n",
+		},
+		{
+			Name: "validate --verbose flag",
+			API: mock.API{
+				ListObjectStoresFn: listObjectStoresOK,
+			},
+			Args: args("object-store list --verbose"),
+			WantOutput: strings.Join([]string{
+				"Object store 1/2",
+				"	ID: abc",
+				"	Name: assets",
+				"Object store 2/2",
+				"	ID: def",
+				"	Name: config",
+			}, "\n") + "\n\n",
+		},
+		{
+			Name: "validate --json flag",
+			API: mock.API{
+				ListObjectStoresFn: listObjectStoresOK,
+			},
+			Args:       args("object-store list --json"),
+			WantOutput: `[{"Name":"assets","ID":"abc","CreatedAt":null,"UpdatedAt":null},{"Name":"config","ID":"def","CreatedAt":null,"UpdatedAt":null}]`,
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestObjectStoreDescribe(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name:      "validate missing --id flag",
+			Args:      args("object-store describe"),
+			WantError: "error parsing arguments: required flag --id not provided",
+		},
+		{
+			Name: "validate GetObjectStore API success",
+			API: mock.API{
+				GetObjectStoreFn: func(i *fastly.GetObjectStoreInput) (*fastly.ObjectStore, error) {
+					return &fastly.ObjectStore{
+						ID:        i.ID,
+						Name:      "assets",
+						CreatedAt: testutil.MustParseTimeRFC3339("2021-06-15T23:00:00Z"),
+					}, nil
+				},
+			},
+			Args: args("object-store describe --id abc"),
+			WantOutput: strings.Join([]string{
+				"ID: abc",
+				"Name: assets",
+				"Created (UTC): 2021-06-15 23:00",
+			}, "\n") + "\n",
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestObjectStoreDelete(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name:      "validate missing --id flag",
+			Args:      args("object-store delete"),
+			WantError: "error parsing arguments: required flag --id not provided",
+		},
+		{
+			Name: "validate DeleteObjectStore API error",
+			API: mock.API{
+				DeleteObjectStoreFn: func(i *fastly.DeleteObjectStoreInput) error {
+					return testutil.Err
+				},
+			},
+			Args:      args("object-store delete --id abc"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate DeleteObjectStore API success",
+			API: mock.API{
+				DeleteObjectStoreFn: func(i *fastly.DeleteObjectStoreInput) error {
+					return nil
+				},
+			},
+			Args:       args("object-store delete --id abc"),
+			WantOutput: "Deleted object store abc",
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func listObjectStoresOK(i *fastly.ListObjectStoresInput) (*fastly.ListObjectStoresResponse, error) {
+	if i.Cursor == "" {
+		return &fastly.ListObjectStoresResponse{
+			Data: []fastly.ObjectStore{{ID: "abc", Name: "assets"}},
+			Meta: map[string]string{"next_cursor": "page2"},
+		}, nil
+	}
+	return &fastly.ListObjectStoresResponse{
+		Data: []fastly.ObjectStore{{ID: "def", Name: "config"}},
+	}, nil
+}
@@ -0,0 +1,122 @@
+package pool
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v6/fastly"
+)
+
+// poolTypes is the list of supported pool types.
+var poolTypes = []string{
+	string(fastly.PoolTypeRandom),
+	string(fastly.PoolTypeHash),
+	string(fastly.PoolTypeClient),
+}
+
+// CreateCommand calls the Fastly API to create pools.
+type CreateCommand struct {
+	cmd.Base
+	input          fastly.CreatePoolInput
+	autoClone      cmd.OptionalAutoClone
+	manifest       manifest.Data
+	poolType       string
+	serviceName    cmd.OptionalServiceNameID
+	serviceVersion cmd.OptionalServiceVersion
+	tlsCheckCert   bool
+	useTLS         bool
+}
+
+// NewCreateCommand returns a usable command registered under the parent.
+func NewCreateCommand(parent cmd.Registerer, globals *config.Data, data manifest.Data) *CreateCommand {
+	var c CreateCommand
+	c.Globals = globals
+	c.manifest = data
+	c.CmdClause = parent.Command("create", "Create a pool on a Fastly service version").Alias("add")
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Name:        cmd.FlagServiceIDName,
+		Description: cmd.FlagServiceIDDesc,
+		Dst:         &c.manifest.Flag.ServiceID,
+		Short:       's',
+	})
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Action:      c.serviceName.Set,
+		Name:        cmd.FlagServiceName,
+		Description: cmd.FlagServiceDesc,
+		Dst:         &c.serviceName.Value,
+	})
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Name:        cmd.FlagVersionName,
+		Description: cmd.FlagVersionDesc,
+		Dst:         &c.serviceVersion.Value,
+		Required:    true,
+	})
+	c.RegisterAutoCloneFlag(cmd.AutoCloneFlagOpts{
+		Action: c.autoClone.Set,
+		Dst:    &c.autoClone.Value,
+	})
+	c.CmdClause.Flag("name", "Name of pool").Short('n').Required().StringVar(&c.input.Name)
+	c.CmdClause.Flag("comment", "A descriptive note").StringVar(&c.input.Comment)
+	c.CmdClause.Flag("type", "Type of load balancing. Accepts a string value: random, hash, client").EnumVar(&c.poolType, poolTypes...)
+	c.CmdClause.Flag("quorum", "Percentage of capacity that needs to be up for the pool to be considered up").UintVar(&c.input.Quorum)
+	c.CmdClause.Flag("max-conn-default", "Maximum number of connections to each server in the pool, unless overridden by the server").UintVar(&c.input.MaxConnDefault)
+	c.CmdClause.Flag("connect-timeout", "How long to wait for a timeout in milliseconds").UintVar(&c.input.ConnectTimeout)
+	c.CmdClause.Flag("first-byte-timeout", "How long to wait for the first bytes in milliseconds").UintVar(&c.input.FirstByteTimeout)
+	c.CmdClause.Flag("override-host", "The hostname to override the Host header").StringVar(&c.input.OverrideHost)
+	c.CmdClause.Flag("request-condition", "Condition, which if met, will select this pool during a request").StringVar(&c.input.RequestCondition)
+	c.CmdClause.Flag("healthcheck", "The name of the healthcheck to use with this pool").StringVar(&c.input.Healthcheck)
+	c.CmdClause.Flag("shield", "The shield POP designated to reduce inbound load on the servers").StringVar(&c.input.Shield)
+	c.CmdClause.Flag("use-tls", "Whether or not to use TLS to reach the servers").BoolVar(&c.useTLS)
+	c.CmdClause.Flag("tls-check-cert", "Be strict on checking TLS certs").BoolVar(&c.tlsCheckCert)
+	c.CmdClause.Flag("tls-ca-cert", "CA certificate attached to origin").StringVar(&c.input.TLSCACert)
+	c.CmdClause.Flag("tls-client-cert", "Client certificate attached to origin").StringVar(&c.input.TLSClientCert)
+	c.CmdClause.Flag("tls-client-key", "Client key attached to origin").StringVar(&c.input.TLSClientKey)
+	c.CmdClause.Flag("tls-cert-hostname", "Overrides the hostname used for cert verification. Does not affect SNI").StringVar(&c.input.TLSCertHostname)
+	c.CmdClause.Flag("tls-sni-hostname", "Overrides the hostname used for SNI in the handshake. Does not affect cert validation").StringVar(&c.input.TLSSNIHostname)
+	c.CmdClause.Flag("min-tls-version", "Minimum allowed TLS version on connections to the servers").StringVar(&c.input.MinTLSVersion)
+	c.CmdClause.Flag("max-tls-version", "Maximum allowed TLS version on connections to the servers").StringVar(&c.input.MaxTLSVersion)
+	c.CmdClause.Flag("tls-ciphers", "List of OpenSSL ciphers (https://www.openssl.org/docs/man1.0.2/man1/ciphers)").StringVar(&c.input.TLSCiphers)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *CreateCommand) Exec(_ io.Reader, out io.Writer) error {
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AutoCloneFlag:      c.autoClone,
+		APIClient:          c.Globals.APIClient,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceNameFlag:    c.serviceName,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	c.input.ServiceID = serviceID
+	c.input.ServiceVersion = serviceVersion.Number
+	c.input.Type = fastly.PoolType(c.poolType)
+	c.input.UseTLS = fastly.Compatibool(c.useTLS)
+	c.input.TLSCheckCert = fastly.Compatibool(c.tlsCheckCert)
+
+	p, err := c.Globals.APIClient.CreatePool(&c.input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	text.Success(out, "Created pool %s (service %s version %d)", p.Name, p.ServiceID, p.ServiceVersion)
+	return nil
+}
@@ -0,0 +1,275 @@
+package pool_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v6/fastly"
+)
+
+func TestPoolCreate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name:      "validate missing --name flag",
+			Args:      args("pool create --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Name:      "validate invalid --type flag",
+			Args:      args("pool create --service-id 123 --version 1 --name origins --type foo"),
+			WantError: "enum value must be one of random,hash,client",
+		},
+		{
+			Name: "validate CreatePool API error",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				CreatePoolFn: func(i *fastly.CreatePoolInput) (*fastly.Pool, error) {
+					return nil, testutil.Err
+				},
+			},
+			Args:      args("pool create --service-id 123 --version 1 --name origins --autoclone"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate CreatePool API success",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				CreatePoolFn: func(i *fastly.CreatePoolInput) (*fastly.Pool, error) {
+					if i.Type != fastly.PoolTypeHash || !bool(i.UseTLS) || i.Quorum != 50 {
+						return nil, errors.New("unexpected input")
+					}
+					return &fastly.Pool{
+						ServiceID:      i.ServiceID,
+						ServiceVersion: i.ServiceVersion,
+						Name:           i.Name,
+					}, nil
+				},
+			},
+			Args:       args("pool create --service-id 123 --version 1 --name origins --type hash --use-tls --quorum 50 --autoclone"),
+			WantOutput: "Created pool origins (service 123 version 4)",
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestPoolList(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name: "validate ListPools API error",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListPoolsFn: func(i *fastly.ListPoolsInput) ([]*fastly.Pool, error) {
+					return nil, testutil.Err
+				},
+			},
+			Args:      args("pool list --service-id 123 --version 1"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate ListPools API success",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListPoolsFn:    listPoolsOK,
+			},
+			Args: args("pool list --service-id 123 --version 1"),
+			WantOutput: strings.TrimSpace(`
+SERVICE  VERSION  NAME     ID   TYPE    QUORUM  REQUEST CONDITION
+123      1        origins  abc  random  75      always
+123      1        sticky   def  client  50      is_api
+`) + "\n",
+		},
+		{
+			Name: "validate --json flag",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListPoolsFn:    listPoolsOK,
+			},
+			Args:       args("pool list --service-id 123 --version 1 --json"),
+			WantOutput: `"ID":"abc","Name":"origins"`,
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestPoolDescribe(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name:      "validate missing --name flag",
+			Args:      args("pool describe --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Name: "validate GetPool API success",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetPoolFn: func(i *fastly.GetPoolInput) (*fastly.Pool, error) {
+					return &fastly.Pool{
+						ServiceID:      i.ServiceID,
+						ServiceVersion: i.ServiceVersion,
+						ID:             "abc",
+						Name:           i.Name,
+						Type:           fastly.PoolTypeRandom,
+						Quorum:         75,
+						UseTLS:         true,
+					}, nil
+				},
+			},
+			Args: args("pool describe --service-id 123 --version 1 --name origins"),
+			WantOutput: "\n" + strings.Join([]string{
+				"Service ID: 123",
+				"Version: 1",
+				"ID: abc",
+				"Name: origins",
+				"Comment: ",
+				"Type: random",
+				"Quorum: 75",
+			}, "\n") + "\n",
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestPoolUpdate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name: "validate UpdatePool API success",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				UpdatePoolFn: func(i *fastly.UpdatePoolInput) (*fastly.Pool, error) {
+					if *i.Type != fastly.PoolTypeClient || !bool(*i.TLSCheckCert) {
+						return nil, errors.New("unexpected input")
+					}
+					return &fastly.Pool{
+						ServiceID:      i.ServiceID,
+						ServiceVersion: i.ServiceVersion,
+						Name:           *i.NewName,
+					}, nil
+				},
+			},
+			Args:       args("pool update --service-id 123 --version 1 --name origins --new-name primary --type client --tls-check-cert --autoclone"),
+			WantOutput: "Updated pool primary (service 123 version 4)",
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestPoolDelete(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name: "validate DeletePool API error",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				DeletePoolFn: func(i *fastly.DeletePoolInput) error {
+					return testutil.Err
+				},
+			},
+			Args:      args("pool delete --service-id 123 --version 1 --name origins --autoclone"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate DeletePool API success",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				DeletePoolFn: func(i *fastly.DeletePoolInput) error {
+					return nil
+				},
+			},
+			Args:       args("pool delete --service-id 123 --version 1 --name origins --autoclone"),
+			WantOutput: "Deleted pool origins (service 123 version 4)",
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func listPoolsOK(i *fastly.ListPoolsInput) ([]*fastly.Pool, error) {
+	return []*fastly.Pool{
+		{
+			ServiceID:        i.ServiceID,
+			ServiceVersion:   i.ServiceVersion,
+			ID:               "abc",
+			Name:             "origins",
+			Type:             fastly.PoolTypeRandom,
+			Quorum:           75,
+			RequestCondition: "always",
+		},
+		{
+			ServiceID:        i.ServiceID,
+			ServiceVersion:   i.ServiceVersion,
+			ID:               "def",
+			Name:             "sticky",
+			Type:             fastly.PoolTypeClient,
+			Quorum:           50,
+			RequestCondition: "is_api",
+		},
+	}, nil
+}
@@ -0,0 +1,73 @@
+package server
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v6/fastly"
+)
+
+// CreateCommand calls the Fastly API to create a pool server.
+type CreateCommand struct {
+	cmd.Base
+	input       fastly.CreateServerInput
+	manifest    manifest.Data
+	serviceName cmd.OptionalServiceNameID
+}
+
+// NewCreateCommand returns a usable command registered under the parent.
+func NewCreateCommand(parent cmd.Registerer, globals *config.Data, data manifest.Data) *CreateCommand {
+	var c CreateCommand
+	c.Globals = globals
+	c.manifest = data
+	c.CmdClause = parent.Command("create", "Add a server to a Fastly load balancing pool").Alias("add")
+	c.CmdClause.Flag("pool-id", "Pool ID").Required().StringVar(&c.input.PoolID)
+	c.CmdClause.Flag("address", "A hostname, IPv4, or IPv6 address for the server").Required().StringVar(&c.input.Address)
+	c.CmdClause.Flag("comment", "A descriptive note").StringVar(&c.input.Comment)
+	c.CmdClause.Flag("port", "Port number of the address").UintVar(&c.input.Port)
+	c.CmdClause.Flag("weight", "Weight (1-100) used to load balance this server against others").UintVar(&c.input.Weight)
+	c.CmdClause.Flag("max-conn", "Maximum number of connections. If unset, the pool's max-conn-default is used").UintVar(&c.input.MaxConn)
+	c.CmdClause.Flag("override-host", "The hostname to override the Host header").StringVar(&c.input.OverrideHost)
+	c.CmdClause.Flag("disabled", "Whether the server is disabled").BoolVar(&c.input.Disabled)
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Name:        cmd.FlagServiceIDName,
+		Description: cmd.FlagServiceIDDesc,
+		Dst:         &c.manifest.Flag.ServiceID,
+		Short:       's',
+	})
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Action:      c.serviceName.Set,
+		Name:        cmd.FlagServiceName,
+		Description: cmd.FlagServiceDesc,
+		Dst:         &c.serviceName.Value,
+	})
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *CreateCommand) Exec(_ io.Reader, out io.Writer) error {
+	serviceID, source, flag, err := cmd.ServiceID(c.serviceName, c.manifest, c.Globals.APIClient, c.Globals.ErrLog)
+	if err != nil {
+		return err
+	}
+	if c.Globals.Verbose() {
+		cmd.DisplayServiceID(serviceID, flag, source, out)
+	}
+
+	c.input.ServiceID = serviceID
+
+	s, err := c.Globals.APIClient.CreateServer(&c.input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID": serviceID,
+			"Pool ID":    c.input.PoolID,
+		})
+		return err
+	}
+
+	text.Success(out, "Created server %s (address %s, pool %s, service %s)", s.ID, s.Address, s.PoolID, s.ServiceID)
+	return nil
+}
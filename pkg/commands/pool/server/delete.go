@@ -0,0 +1,67 @@
+package server
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v6/fastly"
+)
+
+// DeleteCommand calls the Fastly API to delete a pool server.
+type DeleteCommand struct {
+	cmd.Base
+	input       fastly.DeleteServerInput
+	manifest    manifest.Data
+	serviceName cmd.OptionalServiceNameID
+}
+
+// NewDeleteCommand returns a usable command registered under the parent.
+func NewDeleteCommand(parent cmd.Registerer, globals *config.Data, data manifest.Data) *DeleteCommand {
+	var c DeleteCommand
+	c.Globals = globals
+	c.manifest = data
+	c.CmdClause = parent.Command("delete", "Remove a server from a Fastly load balancing pool").Alias("remove")
+	c.CmdClause.Flag("pool-id", "Pool ID").Required().StringVar(&c.input.PoolID)
+	c.CmdClause.Flag("server-id", "Server ID").Required().StringVar(&c.input.Server)
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Name:        cmd.FlagServiceIDName,
+		Description: cmd.FlagServiceIDDesc,
+		Dst:         &c.manifest.Flag.ServiceID,
+		Short:       's',
+	})
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Action:      c.serviceName.Set,
+		Name:        cmd.FlagServiceName,
+		Description: cmd.FlagServiceDesc,
+		Dst:         &c.serviceName.Value,
+	})
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *DeleteCommand) Exec(_ io.Reader, out io.Writer) error {
+	serviceID, source, flag, err := cmd.ServiceID(c.serviceName, c.manifest, c.Globals.APIClient, c.Globals.ErrLog)
+	if err != nil {
+		return err
+	}
+	if c.Globals.Verbose() {
+		cmd.DisplayServiceID(serviceID, flag, source, out)
+	}
+
+	c.input.ServiceID = serviceID
+
+	if err := c.Globals.APIClient.DeleteServer(&c.input); err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID": serviceID,
+			"Pool ID":    c.input.PoolID,
+			"Server ID":  c.input.Server,
+		})
+		return err
+	}
+
+	text.Success(out, "Deleted server %s (pool %s, service %s)", c.input.Server, c.input.PoolID, serviceID)
+	return nil
+}
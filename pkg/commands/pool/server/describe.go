@@ -0,0 +1,83 @@
+package server
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v6/fastly"
+)
+
+// DescribeCommand calls the Fastly API to describe a pool server.
+type DescribeCommand struct {
+	cmd.Base
+	input       fastly.GetServerInput
+	manifest    manifest.Data
+	serviceName cmd.OptionalServiceNameID
+}
+
+// NewDescribeCommand returns a usable command registered under the parent.
+func NewDescribeCommand(parent cmd.Registerer, globals *config.Data, data manifest.Data) *DescribeCommand {
+	var c DescribeCommand
+	c.Globals = globals
+	c.manifest = data
+	c.CmdClause = parent.Command("describe", "Show detailed information about a server in a Fastly load balancing pool").Alias("get")
+	c.CmdClause.Flag("pool-id", "Pool ID").Required().StringVar(&c.input.PoolID)
+	c.CmdClause.Flag("server-id", "Server ID").Required().StringVar(&c.input.Server)
+	c.RegisterOutputFlags()
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Name:        cmd.FlagServiceIDName,
+		Description: cmd.FlagServiceIDDesc,
+		Dst:         &c.manifest.Flag.ServiceID,
+		Short:       's',
+	})
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Action:      c.serviceName.Set,
+		Name:        cmd.FlagServiceName,
+		Description: cmd.FlagServiceDesc,
+		Dst:         &c.serviceName.Value,
+	})
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *DescribeCommand) Exec(_ io.Reader, out io.Writer) error {
+	if err := c.CheckOutputFlags(); err != nil {
+		return err
+	}
+
+	serviceID, source, flag, err := cmd.ServiceID(c.serviceName, c.manifest, c.Globals.APIClient, c.Globals.ErrLog)
+	if err != nil {
+		return err
+	}
+	if c.Globals.Verbose() {
+		cmd.DisplayServiceID(serviceID, flag, source, out)
+	}
+
+	c.input.ServiceID = serviceID
+
+	s, err := c.Globals.APIClient.GetServer(&c.input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID": serviceID,
+			"Pool ID":    c.input.PoolID,
+			"Server ID":  c.input.Server,
+		})
+		return err
+	}
+
+	if ok, err := c.WriteOutput(out, s); ok {
+		return err
+	}
+
+	if !c.Globals.Verbose() {
+		fmt.Fprintf(out, "\nService ID: %s\n", s.ServiceID)
+	}
+	fmt.Fprintf(out, "Pool ID: %s\n", s.PoolID)
+	text.PrintServer(out, "", s)
+
+	return nil
+}
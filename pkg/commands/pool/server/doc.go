@@ -0,0 +1,3 @@
+// Package server contains commands to inspect and manipulate the servers
+// within a Fastly load balancing pool.
+package server
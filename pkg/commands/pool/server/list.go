@@ -0,0 +1,92 @@
+package server
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v6/fastly"
+)
+
+// ListCommand calls the Fastly API to list the servers in a pool.
+type ListCommand struct {
+	cmd.Base
+	input       fastly.ListServersInput
+	manifest    manifest.Data
+	serviceName cmd.OptionalServiceNameID
+}
+
+// NewListCommand returns a usable command registered under the parent.
+func NewListCommand(parent cmd.Registerer, globals *config.Data, data manifest.Data) *ListCommand {
+	var c ListCommand
+	c.Globals = globals
+	c.manifest = data
+	c.CmdClause = parent.Command("list", "List the servers in a Fastly load balancing pool")
+	c.CmdClause.Flag("pool-id", "Pool ID").Required().StringVar(&c.input.PoolID)
+	c.RegisterOutputFlags()
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Name:        cmd.FlagServiceIDName,
+		Description: cmd.FlagServiceIDDesc,
+		Dst:         &c.manifest.Flag.ServiceID,
+		Short:       's',
+	})
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Action:      c.serviceName.Set,
+		Name:        cmd.FlagServiceName,
+		Description: cmd.FlagServiceDesc,
+		Dst:         &c.serviceName.Value,
+	})
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
+	if err := c.CheckOutputFlags(); err != nil {
+		return err
+	}
+
+	serviceID, source, flag, err := cmd.ServiceID(c.serviceName, c.manifest, c.Globals.APIClient, c.Globals.ErrLog)
+	if err != nil {
+		return err
+	}
+	if c.Globals.Verbose() {
+		cmd.DisplayServiceID(serviceID, flag, source, out)
+	}
+
+	c.input.ServiceID = serviceID
+
+	servers, err := c.Globals.APIClient.ListServers(&c.input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID": serviceID,
+			"Pool ID":    c.input.PoolID,
+		})
+		return err
+	}
+
+	if !c.Globals.Verbose() {
+		if ok, err := c.WriteOutput(out, servers); ok {
+			return err
+		}
+
+		tw := text.NewTable(out)
+		tw.AddHeader("ID", "ADDRESS", "PORT", "WEIGHT", "MAX CONN", "DISABLED")
+		for _, s := range servers {
+			tw.AddLine(s.ID, s.Address, s.Port, s.Weight, s.MaxConn, s.Disabled)
+		}
+		tw.Print()
+		return nil
+	}
+
+	fmt.Fprintf(out, "Pool ID: %s\n", c.input.PoolID)
+	for i, s := range servers {
+		fmt.Fprintf(out, "\tServer %d/%d\n", i+1, len(servers))
+		text.PrintServer(out, "\t\t", s)
+	}
+	fmt.Fprintln(out)
+
+	return nil
+}
@@ -0,0 +1,281 @@
+package server_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v6/fastly"
+)
+
+func TestServerCreate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name:      "validate missing --pool-id flag",
+			Args:      args("pool server create --service-id 123 --address 127.0.0.1"),
+			WantError: "error parsing arguments: required flag --pool-id not provided",
+		},
+		{
+			Name:      "validate missing --address flag",
+			Args:      args("pool server create --service-id 123 --pool-id abc"),
+			WantError: "error parsing arguments: required flag --address not provided",
+		},
+		{
+			Name: "validate CreateServer API error",
+			API: mock.API{
+				CreateServerFn: func(i *fastly.CreateServerInput) (*fastly.Server, error) {
+					return nil, testutil.Err
+				},
+			},
+			Args:      args("pool server create --service-id 123 --pool-id abc --address 127.0.0.1"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate CreateServer API success",
+			API: mock.API{
+				CreateServerFn: func(i *fastly.CreateServerInput) (*fastly.Server, error) {
+					return &fastly.Server{
+						ServiceID: i.ServiceID,
+						PoolID:    i.PoolID,
+						ID:        "xyz",
+						Address:   i.Address,
+						Weight:    i.Weight,
+					}, nil
+				},
+			},
+			Args:       args("pool server create --service-id 123 --pool-id abc --address 127.0.0.1 --weight 50"),
+			WantOutput: "Created server xyz (address 127.0.0.1, pool abc, service 123)",
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestServerList(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name: "validate ListServers API error",
+			API: mock.API{
+				ListServersFn: func(i *fastly.ListServersInput) ([]*fastly.Server, error) {
+					return nil, testutil.Err
+				},
+			},
+			Args:      args("pool server list --service-id 123 --pool-id abc"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate ListServers API success",
+			API: mock.API{
+				ListServersFn: listServersOK,
+			},
+			Args: args("pool server list --service-id 123 --pool-id abc"),
+			WantOutput: strings.TrimSpace(`
+ID   ADDRESS    PORT  WEIGHT  MAX CONN  DISABLED
+xyz  127.0.0.1  80    100     200       false
+uvw  127.0.0.2  443   50      0         true
+`) + "\n",
+		},
+		{
+			Name: "validate --verbose flag",
+			API: mock.API{
+				ListServersFn: listServersOK,
+			},
+			Args: args("pool server list --service-id 123 --pool-id abc --verbose"),
+			WantOutput: strings.Join([]string{
+				"Fastly API token not provided",
+				"Fastly API endpoint: https://api.fastly.com",
+				"Service ID (via --service-id): 123",
+				"",
+				"Pool ID: abc",
+				"	Server 1/2",
+				"		ID: xyz",
+				"		Address: 127.0.0.1",
+				"		Port: 80",
+				"		Comment: ",
+				"		Weight: 100",
+				"		Max connections: 200",
+				"		Override host: ",
+				"		Disabled: false",
+				"	Server 2/2",
+				"		ID: uvw",
+				"		Address: 127.0.0.2",
+				"		Port: 443",
+				"		Comment: ",
+				"		Weight: 50",
+				"		Max connections: 0",
+				"		Override host: ",
+				"		Disabled: true",
+			}, "\n") + "\n\n",
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestServerDescribe(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name:      "validate missing --server-id flag",
+			Args:      args("pool server describe --service-id 123 --pool-id abc"),
+			WantError: "error parsing arguments: required flag --server-id not provided",
+		},
+		{
+			Name: "validate GetServer API success",
+			API: mock.API{
+				GetServerFn: func(i *fastly.GetServerInput) (*fastly.Server, error) {
+					return &fastly.Server{
+						ServiceID: i.ServiceID,
+						PoolID:    i.PoolID,
+						ID:        i.Server,
+						Address:   "127.0.0.1",
+						Port:      80,
+					}, nil
+				},
+			},
+			Args: args("pool server describe --service-id 123 --pool-id abc --server-id xyz"),
+			WantOutput: "\n" + strings.Join([]string{
+				"Service ID: 123",
+				"Pool ID: abc",
+				"ID: xyz",
+				"Address: 127.0.0.1",
+				"Port: 80",
+			}, "\n") + "\n",
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestServerUpdate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name: "validate UpdateServer API success",
+			API: mock.API{
+				UpdateServerFn: func(i *fastly.UpdateServerInput) (*fastly.Server, error) {
+					if !*i.Disabled || i.Address != nil {
+						return nil, errors.New("unexpected input")
+					}
+					return &fastly.Server{
+						ServiceID: i.ServiceID,
+						PoolID:    i.PoolID,
+						ID:        i.Server,
+						Address:   "127.0.0.1",
+					}, nil
+				},
+			},
+			Args:       args("pool server update --service-id 123 --pool-id abc --server-id xyz --disabled"),
+			WantOutput: "Updated server xyz (address 127.0.0.1, pool abc, service 123)",
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestServerDelete(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name: "validate DeleteServer API error",
+			API: mock.API{
+				DeleteServerFn: func(i *fastly.DeleteServerInput) error {
+					return testutil.Err
+				},
+			},
+			Args:      args("pool server delete --service-id 123 --pool-id abc --server-id xyz"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate DeleteServer API success",
+			API: mock.API{
+				DeleteServerFn: func(i *fastly.DeleteServerInput) error {
+					return nil
+				},
+			},
+			Args:       args("pool server delete --service-id 123 --pool-id abc --server-id xyz"),
+			WantOutput: "Deleted server xyz (pool abc, service 123)",
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func listServersOK(i *fastly.ListServersInput) ([]*fastly.Server, error) {
+	return []*fastly.Server{
+		{
+			ServiceID: i.ServiceID,
+			PoolID:    i.PoolID,
+			ID:        "xyz",
+			Address:   "127.0.0.1",
+			Port:      80,
+			Weight:    100,
+			MaxConn:   200,
+		},
+		{
+			ServiceID: i.ServiceID,
+			PoolID:    i.PoolID,
+			ID:        "uvw",
+			Address:   "127.0.0.2",
+			Port:      443,
+			Weight:    50,
+			Disabled:  true,
+		},
+	}, nil
+}
@@ -0,0 +1,111 @@
+package server
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v6/fastly"
+)
+
+// UpdateCommand calls the Fastly API to update a pool server.
+type UpdateCommand struct {
+	cmd.Base
+	input       fastly.UpdateServerInput
+	manifest    manifest.Data
+	serviceName cmd.OptionalServiceNameID
+
+	Address      cmd.OptionalString
+	Comment      cmd.OptionalString
+	Port         cmd.OptionalUint
+	Weight       cmd.OptionalUint
+	MaxConn      cmd.OptionalUint
+	OverrideHost cmd.OptionalString
+	Disabled     cmd.OptionalBool
+}
+
+// NewUpdateCommand returns a usable command registered under the parent.
+func NewUpdateCommand(parent cmd.Registerer, globals *config.Data, data manifest.Data) *UpdateCommand {
+	var c UpdateCommand
+	c.Globals = globals
+	c.manifest = data
+	c.CmdClause = parent.Command("update", "Update a server in a Fastly load balancing pool")
+	c.CmdClause.Flag("pool-id", "Pool ID").Required().StringVar(&c.input.PoolID)
+	c.CmdClause.Flag("server-id", "Server ID").Required().StringVar(&c.input.Server)
+	c.CmdClause.Flag("address", "A hostname, IPv4, or IPv6 address for the server").Action(c.Address.Set).StringVar(&c.Address.Value)
+	c.CmdClause.Flag("comment", "A descriptive note").Action(c.Comment.Set).StringVar(&c.Comment.Value)
+	c.CmdClause.Flag("port", "Port number of the address").Action(c.Port.Set).UintVar(&c.Port.Value)
+	c.CmdClause.Flag("weight", "Weight (1-100) used to load balance this server against others").Action(c.Weight.Set).UintVar(&c.Weight.Value)
+	c.CmdClause.Flag("max-conn", "Maximum number of connections. If unset, the pool's max-conn-default is used").Action(c.MaxConn.Set).UintVar(&c.MaxConn.Value)
+	c.CmdClause.Flag("override-host", "The hostname to override the Host header").Action(c.OverrideHost.Set).StringVar(&c.OverrideHost.Value)
+	c.CmdClause.Flag("disabled", "Whether the server is disabled").Action(c.Disabled.Set).BoolVar(&c.Disabled.Value)
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Name:        cmd.FlagServiceIDName,
+		Description: cmd.FlagServiceIDDesc,
+		Dst:         &c.manifest.Flag.ServiceID,
+		Short:       's',
+	})
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Action:      c.serviceName.Set,
+		Name:        cmd.FlagServiceName,
+		Description: cmd.FlagServiceDesc,
+		Dst:         &c.serviceName.Value,
+	})
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *UpdateCommand) Exec(_ io.Reader, out io.Writer) error {
+	serviceID, source, flag, err := cmd.ServiceID(c.serviceName, c.manifest, c.Globals.APIClient, c.Globals.ErrLog)
+	if err != nil {
+		return err
+	}
+	if c.Globals.Verbose() {
+		cmd.DisplayServiceID(serviceID, flag, source, out)
+	}
+
+	c.input.ServiceID = serviceID
+
+	if c.Address.WasSet {
+		c.input.Address = fastly.String(c.Address.Value)
+	}
+
+	if c.Comment.WasSet {
+		c.input.Comment = fastly.String(c.Comment.Value)
+	}
+
+	if c.Port.WasSet {
+		c.input.Port = fastly.Uint(c.Port.Value)
+	}
+
+	if c.Weight.WasSet {
+		c.input.Weight = fastly.Uint(c.Weight.Value)
+	}
+
+	if c.MaxConn.WasSet {
+		c.input.MaxConn = fastly.Uint(c.MaxConn.Value)
+	}
+
+	if c.OverrideHost.WasSet {
+		c.input.OverrideHost = fastly.String(c.OverrideHost.Value)
+	}
+
+	if c.Disabled.WasSet {
+		c.input.Disabled = fastly.Bool(c.Disabled.Value)
+	}
+
+	s, err := c.Globals.APIClient.UpdateServer(&c.input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID": serviceID,
+			"Pool ID":    c.input.PoolID,
+			"Server ID":  c.input.Server,
+		})
+		return err
+	}
+
+	text.Success(out, "Updated server %s (address %s, pool %s, service %s)", s.ID, s.Address, s.PoolID, s.ServiceID)
+	return nil
+}
@@ -21,11 +21,16 @@ func NewRootCommand(parent cmd.Registerer, globals *config.Data) *RootCommand {
 	var c RootCommand
 	c.Globals = globals
 	c.CmdClause = parent.Command("pops", "List Fastly datacenters")
+	c.RegisterOutputFlags()
 	return &c
 }
 
 // Exec implements the command interface.
 func (c *RootCommand) Exec(_ io.Reader, out io.Writer) error {
+	if err := c.CheckOutputFlags(); err != nil {
+		return err
+	}
+
 	_, s := c.Globals.Token()
 	if s == config.SourceUndefined {
 		return errors.ErrNoToken
@@ -37,6 +42,10 @@ func (c *RootCommand) Exec(_ io.Reader, out io.Writer) error {
 		return err
 	}
 
+	if ok, err := c.WriteOutput(out, dcs); ok {
+		return err
+	}
+
 	text.Break(out)
 	t := text.NewTable(out)
 	t.AddHeader("NAME", "CODE", "GROUP", "SHIELD", "COORDINATES")
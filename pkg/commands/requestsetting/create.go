@@ -0,0 +1,134 @@
+package requestsetting
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v6/fastly"
+)
+
+// requestSettingActions is the list of supported request setting actions.
+var requestSettingActions = []string{
+	string(fastly.RequestSettingActionLookup),
+	string(fastly.RequestSettingActionPass),
+}
+
+// requestSettingXFFs is the list of supported X-Forwarded-For behaviours.
+var requestSettingXFFs = []string{
+	string(fastly.RequestSettingXFFClear),
+	string(fastly.RequestSettingXFFLeave),
+	string(fastly.RequestSettingXFFAppend),
+	string(fastly.RequestSettingXFFAppendAll),
+	string(fastly.RequestSettingXFFOverwrite),
+}
+
+// CreateCommand calls the Fastly API to create request settings.
+type CreateCommand struct {
+	cmd.Base
+	input          fastly.CreateRequestSettingInput
+	action         string
+	autoClone      cmd.OptionalAutoClone
+	bypassBusyWait bool
+	forceMiss      bool
+	forceSSL       bool
+	geoHeaders     bool
+	manifest       manifest.Data
+	maxStaleAge    cmd.OptionalUint
+	serviceName    cmd.OptionalServiceNameID
+	serviceVersion cmd.OptionalServiceVersion
+	timerSupport   bool
+	xff            string
+}
+
+// NewCreateCommand returns a usable command registered under the parent.
+func NewCreateCommand(parent cmd.Registerer, globals *config.Data, data manifest.Data) *CreateCommand {
+	var c CreateCommand
+	c.Globals = globals
+	c.manifest = data
+	c.CmdClause = parent.Command("create", "Create a request setting on a Fastly service version").Alias("add")
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Name:        cmd.FlagServiceIDName,
+		Description: cmd.FlagServiceIDDesc,
+		Dst:         &c.manifest.Flag.ServiceID,
+		Short:       's',
+	})
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Action:      c.serviceName.Set,
+		Name:        cmd.FlagServiceName,
+		Description: cmd.FlagServiceDesc,
+		Dst:         &c.serviceName.Value,
+	})
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Name:        cmd.FlagVersionName,
+		Description: cmd.FlagVersionDesc,
+		Dst:         &c.serviceVersion.Value,
+		Required:    true,
+	})
+	c.RegisterAutoCloneFlag(cmd.AutoCloneFlagOpts{
+		Action: c.autoClone.Set,
+		Dst:    &c.autoClone.Value,
+	})
+	c.CmdClause.Flag("name", "Name of request setting").Short('n').Required().StringVar(&c.input.Name)
+	c.CmdClause.Flag("action", "Allows you to terminate request handling and immediately perform an action. Accepts a string value: lookup, pass").EnumVar(&c.action, requestSettingActions...)
+	c.CmdClause.Flag("bypass-busy-wait", "Disable collapsed forwarding, so you don't wait for other objects to origin").BoolVar(&c.bypassBusyWait)
+	c.CmdClause.Flag("default-host", "Sets the host header").StringVar(&c.input.DefaultHost)
+	c.CmdClause.Flag("force-miss", "Allows you to force a cache miss for the request. Replaces the item in the cache if the content is cacheable").BoolVar(&c.forceMiss)
+	c.CmdClause.Flag("force-ssl", "Forces the request use SSL (redirects a non-SSL to SSL)").BoolVar(&c.forceSSL)
+	c.CmdClause.Flag("geo-headers", "Injects Fastly-Geo-Country, Fastly-Geo-City, and Fastly-Geo-Region into the request headers").BoolVar(&c.geoHeaders)
+	c.CmdClause.Flag("hash-keys", "Comma separated list of varnish request object fields that should be in the hash key").StringVar(&c.input.HashKeys)
+	c.CmdClause.Flag("max-stale-age", "How old an object is allowed to be to serve stale-if-error or stale-while-revalidate").Action(c.maxStaleAge.Set).UintVar(&c.maxStaleAge.Value)
+	c.CmdClause.Flag("request-condition", "Name of a request condition controlling when this configuration applies").StringVar(&c.input.RequestCondition)
+	c.CmdClause.Flag("timer-support", "Injects the X-Timer info into the request for viewing origin fetch durations").BoolVar(&c.timerSupport)
+	c.CmdClause.Flag("xff", "Short for X-Forwarded-For. Accepts a string value: clear, leave, append, append_all, overwrite").EnumVar(&c.xff, requestSettingXFFs...)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *CreateCommand) Exec(_ io.Reader, out io.Writer) error {
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AutoCloneFlag:      c.autoClone,
+		APIClient:          c.Globals.APIClient,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceNameFlag:    c.serviceName,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	c.input.ServiceID = serviceID
+	c.input.ServiceVersion = serviceVersion.Number
+	c.input.Action = fastly.RequestSettingAction(c.action)
+	c.input.XForwardedFor = fastly.RequestSettingXFF(c.xff)
+	c.input.BypassBusyWait = fastly.Compatibool(c.bypassBusyWait)
+	c.input.ForceMiss = fastly.Compatibool(c.forceMiss)
+	c.input.ForceSSL = fastly.Compatibool(c.forceSSL)
+	c.input.GeoHeaders = fastly.Compatibool(c.geoHeaders)
+	c.input.TimerSupport = fastly.Compatibool(c.timerSupport)
+
+	if c.maxStaleAge.WasSet {
+		c.input.MaxStaleAge = fastly.Uint(c.maxStaleAge.Value)
+	}
+
+	rs, err := c.Globals.APIClient.CreateRequestSetting(&c.input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	text.Success(out, "Created request setting %s (service %s version %d)", rs.Name, rs.ServiceID, rs.ServiceVersion)
+	return nil
+}
@@ -0,0 +1,3 @@
+// Package requestsetting contains commands to inspect and manipulate Fastly service
+// request settings.
+package requestsetting
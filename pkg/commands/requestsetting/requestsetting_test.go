@@ -0,0 +1,378 @@
+package requestsetting_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v6/fastly"
+)
+
+func TestRequestSettingCreate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name:      "validate missing --name flag",
+			Args:      args("request-setting create --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Name:      "validate invalid --xff flag",
+			Args:      args("request-setting create --service-id 123 --version 1 --name force_ssl --xff foo"),
+			WantError: "enum value must be one of clear,leave,append,append_all,overwrite",
+		},
+		{
+			Name: "validate CreateRequestSetting API error",
+			API: mock.API{
+				ListVersionsFn:         testutil.ListVersions,
+				CloneVersionFn:         testutil.CloneVersionResult(4),
+				CreateRequestSettingFn: createRequestSettingError,
+			},
+			Args:      args("request-setting create --service-id 123 --version 1 --name force_ssl --autoclone"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate CreateRequestSetting API success",
+			API: mock.API{
+				ListVersionsFn:         testutil.ListVersions,
+				CloneVersionFn:         testutil.CloneVersionResult(4),
+				CreateRequestSettingFn: createRequestSettingOK,
+			},
+			Args:       args("request-setting create --service-id 123 --version 1 --name force_ssl --force-ssl --xff append --max-stale-age 30 --autoclone"),
+			WantOutput: "Created request setting force_ssl (service 123 version 4)",
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestRequestSettingList(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name: "validate ListRequestSettings API error",
+			API: mock.API{
+				ListVersionsFn:        testutil.ListVersions,
+				ListRequestSettingsFn: listRequestSettingsError,
+			},
+			Args:      args("request-setting list --service-id 123 --version 1"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate ListRequestSettings API success",
+			API: mock.API{
+				ListVersionsFn:        testutil.ListVersions,
+				ListRequestSettingsFn: listRequestSettingsOK,
+			},
+			Args:       args("request-setting list --service-id 123 --version 1"),
+			WantOutput: listRequestSettingsShortOutput,
+		},
+		{
+			Name: "validate --verbose flag",
+			API: mock.API{
+				ListVersionsFn:        testutil.ListVersions,
+				ListRequestSettingsFn: listRequestSettingsOK,
+			},
+			Args:       args("request-setting list --service-id 123 --version 1 --verbose"),
+			WantOutput: listRequestSettingsVerboseOutput,
+		},
+		{
+			Name: "validate --json flag",
+			API: mock.API{
+				ListVersionsFn:        testutil.ListVersions,
+				ListRequestSettingsFn: listRequestSettingsOK,
+			},
+			Args:       args("request-setting list --service-id 123 --version 1 --json"),
+			WantOutput: `"Name":"force_ssl","ForceMiss":false,"ForceSSL":true`,
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestRequestSettingDescribe(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name:      "validate missing --name flag",
+			Args:      args("request-setting describe --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Name: "validate GetRequestSetting API error",
+			API: mock.API{
+				ListVersionsFn:      testutil.ListVersions,
+				GetRequestSettingFn: getRequestSettingError,
+			},
+			Args:      args("request-setting describe --service-id 123 --version 1 --name force_ssl"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate GetRequestSetting API success",
+			API: mock.API{
+				ListVersionsFn:      testutil.ListVersions,
+				GetRequestSettingFn: getRequestSettingOK,
+			},
+			Args:       args("request-setting describe --service-id 123 --version 1 --name force_ssl"),
+			WantOutput: describeRequestSettingOutput,
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestRequestSettingUpdate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name:      "validate missing --name flag",
+			Args:      args("request-setting update --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Name: "validate UpdateRequestSetting API error",
+			API: mock.API{
+				ListVersionsFn:         testutil.ListVersions,
+				CloneVersionFn:         testutil.CloneVersionResult(4),
+				UpdateRequestSettingFn: updateRequestSettingError,
+			},
+			Args:      args("request-setting update --service-id 123 --version 1 --name force_ssl --force-miss --autoclone"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate UpdateRequestSetting API success",
+			API: mock.API{
+				ListVersionsFn:         testutil.ListVersions,
+				CloneVersionFn:         testutil.CloneVersionResult(4),
+				UpdateRequestSettingFn: updateRequestSettingOK,
+			},
+			Args:       args("request-setting update --service-id 123 --version 1 --name force_ssl --new-name secure --action lookup --autoclone"),
+			WantOutput: "Updated request setting secure (service 123 version 4)",
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestRequestSettingDelete(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name:      "validate missing --name flag",
+			Args:      args("request-setting delete --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Name: "validate DeleteRequestSetting API error",
+			API: mock.API{
+				ListVersionsFn:         testutil.ListVersions,
+				CloneVersionFn:         testutil.CloneVersionResult(4),
+				DeleteRequestSettingFn: deleteRequestSettingError,
+			},
+			Args:      args("request-setting delete --service-id 123 --version 1 --name force_ssl --autoclone"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate DeleteRequestSetting API success",
+			API: mock.API{
+				ListVersionsFn:         testutil.ListVersions,
+				CloneVersionFn:         testutil.CloneVersionResult(4),
+				DeleteRequestSettingFn: deleteRequestSettingOK,
+			},
+			Args:       args("request-setting delete --service-id 123 --version 1 --name force_ssl --autoclone"),
+			WantOutput: "Deleted request setting force_ssl (service 123 version 4)",
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func createRequestSettingOK(i *fastly.CreateRequestSettingInput) (*fastly.RequestSetting, error) {
+	return &fastly.RequestSetting{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           i.Name,
+		ForceSSL:       bool(i.ForceSSL),
+		XForwardedFor:  i.XForwardedFor,
+	}, nil
+}
+
+func createRequestSettingError(i *fastly.CreateRequestSettingInput) (*fastly.RequestSetting, error) {
+	return nil, testutil.Err
+}
+
+func listRequestSettingsOK(i *fastly.ListRequestSettingsInput) ([]*fastly.RequestSetting, error) {
+	return []*fastly.RequestSetting{
+		{
+			ServiceID:        i.ServiceID,
+			ServiceVersion:   i.ServiceVersion,
+			Name:             "force_ssl",
+			Action:           fastly.RequestSettingActionLookup,
+			ForceSSL:         true,
+			XForwardedFor:    fastly.RequestSettingXFFAppend,
+			RequestCondition: "always",
+		},
+		{
+			ServiceID:        i.ServiceID,
+			ServiceVersion:   i.ServiceVersion,
+			Name:             "bypass",
+			Action:           fastly.RequestSettingActionPass,
+			ForceMiss:        true,
+			XForwardedFor:    fastly.RequestSettingXFFLeave,
+			RequestCondition: "is_api",
+		},
+	}, nil
+}
+
+func listRequestSettingsError(i *fastly.ListRequestSettingsInput) ([]*fastly.RequestSetting, error) {
+	return nil, testutil.Err
+}
+
+var listRequestSettingsShortOutput = strings.TrimSpace(`
+SERVICE  VERSION  NAME       ACTION  FORCE MISS  FORCE SSL  XFF     REQUEST CONDITION
+123      1        force_ssl  lookup  false       true       append  always
+123      1        bypass     pass    true        false      leave   is_api
+`) + "\n"
+
+var listRequestSettingsVerboseOutput = strings.Join([]string{
+	"Fastly API token not provided",
+	"Fastly API endpoint: https://api.fastly.com",
+	"Service ID (via --service-id): 123",
+	"",
+	"Version: 1",
+	"	Request setting 1/2",
+	"		Name: force_ssl",
+	"		Action: lookup",
+	"		Bypass busy wait: false",
+	"		Default host: ",
+	"		Force miss: false",
+	"		Force SSL: true",
+	"		Geo headers: false",
+	"		Hash keys: ",
+	"		Max stale age: 0",
+	"		Request condition: always",
+	"		Timer support: false",
+	"		X-Forwarded-For: append",
+	"	Request setting 2/2",
+	"		Name: bypass",
+	"		Action: pass",
+	"		Bypass busy wait: false",
+	"		Default host: ",
+	"		Force miss: true",
+	"		Force SSL: false",
+	"		Geo headers: false",
+	"		Hash keys: ",
+	"		Max stale age: 0",
+	"		Request condition: is_api",
+	"		Timer support: false",
+	"		X-Forwarded-For: leave",
+}, "\n") + "\n\n"
+
+func getRequestSettingOK(i *fastly.GetRequestSettingInput) (*fastly.RequestSetting, error) {
+	return &fastly.RequestSetting{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           "force_ssl",
+		Action:         fastly.RequestSettingActionLookup,
+		DefaultHost:    "www.example.com",
+		ForceSSL:       true,
+		HashKeys:       "req.url,req.http.host",
+		MaxStaleAge:    30,
+		XForwardedFor:  fastly.RequestSettingXFFAppend,
+	}, nil
+}
+
+func getRequestSettingError(i *fastly.GetRequestSettingInput) (*fastly.RequestSetting, error) {
+	return nil, testutil.Err
+}
+
+var describeRequestSettingOutput = "\n" + strings.Join([]string{
+	"Service ID: 123",
+	"Version: 1",
+	"Name: force_ssl",
+	"Action: lookup",
+	"Bypass busy wait: false",
+	"Default host: www.example.com",
+	"Force miss: false",
+	"Force SSL: true",
+	"Geo headers: false",
+	"Hash keys: req.url,req.http.host",
+	"Max stale age: 30",
+	"Request condition: ",
+	"Timer support: false",
+	"X-Forwarded-For: append",
+}, "\n") + "\n"
+
+func updateRequestSettingOK(i *fastly.UpdateRequestSettingInput) (*fastly.RequestSetting, error) {
+	return &fastly.RequestSetting{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           *i.NewName,
+		Action:         i.Action,
+	}, nil
+}
+
+func updateRequestSettingError(i *fastly.UpdateRequestSettingInput) (*fastly.RequestSetting, error) {
+	return nil, testutil.Err
+}
+
+func deleteRequestSettingOK(i *fastly.DeleteRequestSettingInput) error {
+	return nil
+}
+
+func deleteRequestSettingError(i *fastly.DeleteRequestSettingInput) error {
+	return testutil.Err
+}
@@ -0,0 +1,162 @@
+package requestsetting
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v6/fastly"
+)
+
+// UpdateCommand calls the Fastly API to update request settings.
+type UpdateCommand struct {
+	cmd.Base
+	manifest       manifest.Data
+	input          fastly.UpdateRequestSettingInput
+	serviceName    cmd.OptionalServiceNameID
+	serviceVersion cmd.OptionalServiceVersion
+	autoClone      cmd.OptionalAutoClone
+
+	NewName          cmd.OptionalString
+	Action           cmd.OptionalString
+	BypassBusyWait   cmd.OptionalBool
+	DefaultHost      cmd.OptionalString
+	ForceMiss        cmd.OptionalBool
+	ForceSSL         cmd.OptionalBool
+	GeoHeaders       cmd.OptionalBool
+	HashKeys         cmd.OptionalString
+	MaxStaleAge      cmd.OptionalUint
+	RequestCondition cmd.OptionalString
+	TimerSupport     cmd.OptionalBool
+	XForwardedFor    cmd.OptionalString
+}
+
+// NewUpdateCommand returns a usable command registered under the parent.
+func NewUpdateCommand(parent cmd.Registerer, globals *config.Data, data manifest.Data) *UpdateCommand {
+	var c UpdateCommand
+	c.Globals = globals
+	c.manifest = data
+	c.CmdClause = parent.Command("update", "Update a request setting on a Fastly service version")
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Name:        cmd.FlagServiceIDName,
+		Description: cmd.FlagServiceIDDesc,
+		Dst:         &c.manifest.Flag.ServiceID,
+		Short:       's',
+	})
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Action:      c.serviceName.Set,
+		Name:        cmd.FlagServiceName,
+		Description: cmd.FlagServiceDesc,
+		Dst:         &c.serviceName.Value,
+	})
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Name:        cmd.FlagVersionName,
+		Description: cmd.FlagVersionDesc,
+		Dst:         &c.serviceVersion.Value,
+		Required:    true,
+	})
+	c.RegisterAutoCloneFlag(cmd.AutoCloneFlagOpts{
+		Action: c.autoClone.Set,
+		Dst:    &c.autoClone.Value,
+	})
+	c.CmdClause.Flag("name", "Name of request setting").Short('n').Required().StringVar(&c.input.Name)
+	c.CmdClause.Flag("new-name", "New name of request setting").Action(c.NewName.Set).StringVar(&c.NewName.Value)
+	c.CmdClause.Flag("action", "Allows you to terminate request handling and immediately perform an action. Accepts a string value: lookup, pass").Action(c.Action.Set).EnumVar(&c.Action.Value, requestSettingActions...)
+	c.CmdClause.Flag("bypass-busy-wait", "Disable collapsed forwarding, so you don't wait for other objects to origin").Action(c.BypassBusyWait.Set).BoolVar(&c.BypassBusyWait.Value)
+	c.CmdClause.Flag("default-host", "Sets the host header").Action(c.DefaultHost.Set).StringVar(&c.DefaultHost.Value)
+	c.CmdClause.Flag("force-miss", "Allows you to force a cache miss for the request. Replaces the item in the cache if the content is cacheable").Action(c.ForceMiss.Set).BoolVar(&c.ForceMiss.Value)
+	c.CmdClause.Flag("force-ssl", "Forces the request use SSL (redirects a non-SSL to SSL)").Action(c.ForceSSL.Set).BoolVar(&c.ForceSSL.Value)
+	c.CmdClause.Flag("geo-headers", "Injects Fastly-Geo-Country, Fastly-Geo-City, and Fastly-Geo-Region into the request headers").Action(c.GeoHeaders.Set).BoolVar(&c.GeoHeaders.Value)
+	c.CmdClause.Flag("hash-keys", "Comma separated list of varnish request object fields that should be in the hash key").Action(c.HashKeys.Set).StringVar(&c.HashKeys.Value)
+	c.CmdClause.Flag("max-stale-age", "How old an object is allowed to be to serve stale-if-error or stale-while-revalidate").Action(c.MaxStaleAge.Set).UintVar(&c.MaxStaleAge.Value)
+	c.CmdClause.Flag("request-condition", "Name of a request condition controlling when this configuration applies").Action(c.RequestCondition.Set).StringVar(&c.RequestCondition.Value)
+	c.CmdClause.Flag("timer-support", "Injects the X-Timer info into the request for viewing origin fetch durations").Action(c.TimerSupport.Set).BoolVar(&c.TimerSupport.Value)
+	c.CmdClause.Flag("xff", "Short for X-Forwarded-For. Accepts a string value: clear, leave, append, append_all, overwrite").Action(c.XForwardedFor.Set).EnumVar(&c.XForwardedFor.Value, requestSettingXFFs...)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *UpdateCommand) Exec(_ io.Reader, out io.Writer) error {
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AutoCloneFlag:      c.autoClone,
+		APIClient:          c.Globals.APIClient,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceNameFlag:    c.serviceName,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	c.input.ServiceID = serviceID
+	c.input.ServiceVersion = serviceVersion.Number
+
+	if c.NewName.WasSet {
+		c.input.NewName = fastly.String(c.NewName.Value)
+	}
+
+	if c.Action.WasSet {
+		c.input.Action = fastly.RequestSettingAction(c.Action.Value)
+	}
+
+	if c.BypassBusyWait.WasSet {
+		c.input.BypassBusyWait = fastly.CBool(c.BypassBusyWait.Value)
+	}
+
+	if c.DefaultHost.WasSet {
+		c.input.DefaultHost = fastly.String(c.DefaultHost.Value)
+	}
+
+	if c.ForceMiss.WasSet {
+		c.input.ForceMiss = fastly.CBool(c.ForceMiss.Value)
+	}
+
+	if c.ForceSSL.WasSet {
+		c.input.ForceSSL = fastly.CBool(c.ForceSSL.Value)
+	}
+
+	if c.GeoHeaders.WasSet {
+		c.input.GeoHeaders = fastly.CBool(c.GeoHeaders.Value)
+	}
+
+	if c.HashKeys.WasSet {
+		c.input.HashKeys = fastly.String(c.HashKeys.Value)
+	}
+
+	if c.MaxStaleAge.WasSet {
+		c.input.MaxStaleAge = fastly.Uint(c.MaxStaleAge.Value)
+	}
+
+	if c.RequestCondition.WasSet {
+		c.input.RequestCondition = fastly.String(c.RequestCondition.Value)
+	}
+
+	if c.TimerSupport.WasSet {
+		c.input.TimerSupport = fastly.CBool(c.TimerSupport.Value)
+	}
+
+	if c.XForwardedFor.WasSet {
+		c.input.XForwardedFor = fastly.RequestSettingXFF(c.XForwardedFor.Value)
+	}
+
+	rs, err := c.Globals.APIClient.UpdateRequestSetting(&c.input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	text.Success(out, "Updated request setting %s (service %s version %d)", rs.Name, rs.ServiceID, rs.ServiceVersion)
+	return nil
+}
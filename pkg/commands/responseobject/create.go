@@ -0,0 +1,105 @@
+package responseobject
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v6/fastly"
+)
+
+// CreateCommand calls the Fastly API to create response objects.
+type CreateCommand struct {
+	cmd.Base
+	input          fastly.CreateResponseObjectInput
+	autoClone      cmd.OptionalAutoClone
+	content        string
+	manifest       manifest.Data
+	serviceName    cmd.OptionalServiceNameID
+	serviceVersion cmd.OptionalServiceVersion
+	status         cmd.OptionalUint
+}
+
+// NewCreateCommand returns a usable command registered under the parent.
+func NewCreateCommand(parent cmd.Registerer, globals *config.Data, data manifest.Data) *CreateCommand {
+	var c CreateCommand
+	c.Globals = globals
+	c.manifest = data
+	c.CmdClause = parent.Command("create", "Create a response object on a Fastly service version").Alias("add")
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Name:        cmd.FlagServiceIDName,
+		Description: cmd.FlagServiceIDDesc,
+		Dst:         &c.manifest.Flag.ServiceID,
+		Short:       's',
+	})
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Action:      c.serviceName.Set,
+		Name:        cmd.FlagServiceName,
+		Description: cmd.FlagServiceDesc,
+		Dst:         &c.serviceName.Value,
+	})
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Name:        cmd.FlagVersionName,
+		Description: cmd.FlagVersionDesc,
+		Dst:         &c.serviceVersion.Value,
+		Required:    true,
+	})
+	c.RegisterAutoCloneFlag(cmd.AutoCloneFlagOpts{
+		Action: c.autoClone.Set,
+		Dst:    &c.autoClone.Value,
+	})
+	c.CmdClause.Flag("name", "Name of response object").Short('n').Required().StringVar(&c.input.Name)
+	c.CmdClause.Flag("status", "The HTTP status code (defaults to 200)").Action(c.status.Set).UintVar(&c.status.Value)
+	c.CmdClause.Flag("response", "The HTTP response reason phrase (defaults to 'Ok')").StringVar(&c.input.Response)
+	c.CmdClause.Flag("content", "The content to deliver for the response object, passed as file path or content, e.g. $(< page.html)").StringVar(&c.content)
+	c.CmdClause.Flag("content-type", "The MIME type of the content").StringVar(&c.input.ContentType)
+	c.CmdClause.Flag("request-condition", "Name of a request condition controlling when this response object is served").StringVar(&c.input.RequestCondition)
+	c.CmdClause.Flag("cache-condition", "Name of a cache condition controlling when this response object is served").StringVar(&c.input.CacheCondition)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *CreateCommand) Exec(_ io.Reader, out io.Writer) error {
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AutoCloneFlag:      c.autoClone,
+		APIClient:          c.Globals.APIClient,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceNameFlag:    c.serviceName,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	c.input.ServiceID = serviceID
+	c.input.ServiceVersion = serviceVersion.Number
+
+	if c.content != "" {
+		c.input.Content = cmd.Content(c.content)
+	}
+
+	if c.status.WasSet {
+		c.input.Status = fastly.Uint(c.status.Value)
+	}
+
+	ro, err := c.Globals.APIClient.CreateResponseObject(&c.input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	text.Success(out, "Created response object %s (service %s version %d)", ro.Name, ro.ServiceID, ro.ServiceVersion)
+	return nil
+}
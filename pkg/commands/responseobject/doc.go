@@ -0,0 +1,3 @@
+// Package responseobject contains commands to inspect and manipulate Fastly service
+// response objects.
+package responseobject
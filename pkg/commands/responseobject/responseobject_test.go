@@ -0,0 +1,388 @@
+package responseobject_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v6/fastly"
+)
+
+func TestResponseObjectCreate(t *testing.T) {
+	var content string
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name:      "validate missing --name flag",
+			Args:      args("response-object create --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Name: "validate CreateResponseObject API error",
+			API: mock.API{
+				ListVersionsFn:         testutil.ListVersions,
+				CloneVersionFn:         testutil.CloneVersionResult(4),
+				CreateResponseObjectFn: createResponseObjectError,
+			},
+			Args:      args("response-object create --service-id 123 --version 1 --name maintenance --autoclone"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate CreateResponseObject API success with content from file",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				CreateResponseObjectFn: func(i *fastly.CreateResponseObjectInput) (*fastly.ResponseObject, error) {
+					// Track the contents parsed
+					content = i.Content
+					return createResponseObjectOK(i)
+				},
+			},
+			Args:       args("response-object create --service-id 123 --version 1 --name maintenance --status 503 --content ./testdata/maintenance.html --content-type text/html --autoclone"),
+			WantOutput: "Created response object maintenance (service 123 version 4)",
+		},
+		{
+			Name: "validate CreateResponseObject API success with inline content",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				CreateResponseObjectFn: func(i *fastly.CreateResponseObjectInput) (*fastly.ResponseObject, error) {
+					// Track the contents parsed
+					content = i.Content
+					return createResponseObjectOK(i)
+				},
+			},
+			Args:       args("response-object create --service-id 123 --version 1 --name maintenance --content unavailable --autoclone"),
+			WantOutput: "Created response object maintenance (service 123 version 4)",
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+			testutil.AssertPathContentFlag("content", testcase.WantError, testcase.Args, "maintenance.html", content, t)
+		})
+	}
+}
+
+func TestResponseObjectList(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name: "validate ListResponseObjects API error",
+			API: mock.API{
+				ListVersionsFn:        testutil.ListVersions,
+				ListResponseObjectsFn: listResponseObjectsError,
+			},
+			Args:      args("response-object list --service-id 123 --version 1"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate ListResponseObjects API success",
+			API: mock.API{
+				ListVersionsFn:        testutil.ListVersions,
+				ListResponseObjectsFn: listResponseObjectsOK,
+			},
+			Args:       args("response-object list --service-id 123 --version 1"),
+			WantOutput: listResponseObjectsShortOutput,
+		},
+		{
+			Name: "validate --verbose flag",
+			API: mock.API{
+				ListVersionsFn:        testutil.ListVersions,
+				ListResponseObjectsFn: listResponseObjectsOK,
+			},
+			Args:       args("response-object list --service-id 123 --version 1 --verbose"),
+			WantOutput: listResponseObjectsVerboseOutput,
+		},
+		{
+			Name: "validate --json flag",
+			API: mock.API{
+				ListVersionsFn:        testutil.ListVersions,
+				ListResponseObjectsFn: listResponseObjectsOK,
+			},
+			Args:       args("response-object list --service-id 123 --version 1 --json"),
+			WantOutput: `"Name":"maintenance","Status":503,"Response":"Service Unavailable"`,
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestResponseObjectDescribe(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name:      "validate missing --name flag",
+			Args:      args("response-object describe --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Name: "validate GetResponseObject API error",
+			API: mock.API{
+				ListVersionsFn:      testutil.ListVersions,
+				GetResponseObjectFn: getResponseObjectError,
+			},
+			Args:      args("response-object describe --service-id 123 --version 1 --name maintenance"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate GetResponseObject API success",
+			API: mock.API{
+				ListVersionsFn:      testutil.ListVersions,
+				GetResponseObjectFn: getResponseObjectOK,
+			},
+			Args:       args("response-object describe --service-id 123 --version 1 --name maintenance"),
+			WantOutput: describeResponseObjectOutput,
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestResponseObjectUpdate(t *testing.T) {
+	var content string
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name:      "validate missing --name flag",
+			Args:      args("response-object update --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Name: "validate UpdateResponseObject API error",
+			API: mock.API{
+				ListVersionsFn:         testutil.ListVersions,
+				CloneVersionFn:         testutil.CloneVersionResult(4),
+				UpdateResponseObjectFn: updateResponseObjectError,
+			},
+			Args:      args("response-object update --service-id 123 --version 1 --name maintenance --status 500 --autoclone"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate UpdateResponseObject API success",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				UpdateResponseObjectFn: func(i *fastly.UpdateResponseObjectInput) (*fastly.ResponseObject, error) {
+					// Track the contents parsed
+					content = *i.Content
+					return updateResponseObjectOK(i)
+				},
+			},
+			Args:       args("response-object update --service-id 123 --version 1 --name maintenance --new-name outage --content ./testdata/maintenance.html --autoclone"),
+			WantOutput: "Updated response object outage (service 123 version 4)",
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+			testutil.AssertPathContentFlag("content", testcase.WantError, testcase.Args, "maintenance.html", content, t)
+		})
+	}
+}
+
+func TestResponseObjectDelete(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name:      "validate missing --name flag",
+			Args:      args("response-object delete --service-id 123 --version 1"),
+			WantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			Name: "validate DeleteResponseObject API error",
+			API: mock.API{
+				ListVersionsFn:         testutil.ListVersions,
+				CloneVersionFn:         testutil.CloneVersionResult(4),
+				DeleteResponseObjectFn: deleteResponseObjectError,
+			},
+			Args:      args("response-object delete --service-id 123 --version 1 --name maintenance --autoclone"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate DeleteResponseObject API success",
+			API: mock.API{
+				ListVersionsFn:         testutil.ListVersions,
+				CloneVersionFn:         testutil.CloneVersionResult(4),
+				DeleteResponseObjectFn: deleteResponseObjectOK,
+			},
+			Args:       args("response-object delete --service-id 123 --version 1 --name maintenance --autoclone"),
+			WantOutput: "Deleted response object maintenance (service 123 version 4)",
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func createResponseObjectOK(i *fastly.CreateResponseObjectInput) (*fastly.ResponseObject, error) {
+	return &fastly.ResponseObject{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           i.Name,
+		Content:        i.Content,
+		ContentType:    i.ContentType,
+	}, nil
+}
+
+func createResponseObjectError(i *fastly.CreateResponseObjectInput) (*fastly.ResponseObject, error) {
+	return nil, testutil.Err
+}
+
+func listResponseObjectsOK(i *fastly.ListResponseObjectsInput) ([]*fastly.ResponseObject, error) {
+	return []*fastly.ResponseObject{
+		{
+			ServiceID:        i.ServiceID,
+			ServiceVersion:   i.ServiceVersion,
+			Name:             "maintenance",
+			Status:           503,
+			Response:         "Service Unavailable",
+			Content:          "unavailable",
+			ContentType:      "text/plain",
+			RequestCondition: "in_maintenance",
+			CacheCondition:   "never",
+		},
+		{
+			ServiceID:        i.ServiceID,
+			ServiceVersion:   i.ServiceVersion,
+			Name:             "robots",
+			Status:           200,
+			Response:         "OK",
+			Content:          "User-agent: *",
+			ContentType:      "text/plain",
+			RequestCondition: "is_robots",
+			CacheCondition:   "always",
+		},
+	}, nil
+}
+
+func listResponseObjectsError(i *fastly.ListResponseObjectsInput) ([]*fastly.ResponseObject, error) {
+	return nil, testutil.Err
+}
+
+var listResponseObjectsShortOutput = strings.TrimSpace(`
+SERVICE  VERSION  NAME         STATUS  RESPONSE             CONTENT TYPE  REQUEST CONDITION  CACHE CONDITION
+123      1        maintenance  503     Service Unavailable  text/plain    in_maintenance     never
+123      1        robots       200     OK                   text/plain    is_robots          always
+`) + "\n"
+
+var listResponseObjectsVerboseOutput = strings.Join([]string{
+	"Fastly API token not provided",
+	"Fastly API endpoint: https://api.fastly.com",
+	"Service ID (via --service-id): 123",
+	"",
+	"Version: 1",
+	"	Response object 1/2",
+	"		Name: maintenance",
+	"		Status: 503",
+	"		Response: Service Unavailable",
+	"		Content type: text/plain",
+	"		Content: ",
+	"		unavailable",
+	"		Request condition: in_maintenance",
+	"		Cache condition: never",
+	"	Response object 2/2",
+	"		Name: robots",
+	"		Status: 200",
+	"		Response: OK",
+	"		Content type: text/plain",
+	"		Content: ",
+	"		User-agent: *",
+	"		Request condition: is_robots",
+	"		Cache condition: always",
+}, "\n") + "\n\n"
+
+func getResponseObjectOK(i *fastly.GetResponseObjectInput) (*fastly.ResponseObject, error) {
+	return &fastly.ResponseObject{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           "maintenance",
+		Status:         503,
+		Response:       "Service Unavailable",
+		Content:        "unavailable",
+		ContentType:    "text/plain",
+	}, nil
+}
+
+func getResponseObjectError(i *fastly.GetResponseObjectInput) (*fastly.ResponseObject, error) {
+	return nil, testutil.Err
+}
+
+var describeResponseObjectOutput = "\n" + strings.Join([]string{
+	"Service ID: 123",
+	"Version: 1",
+	"Name: maintenance",
+	"Status: 503",
+	"Response: Service Unavailable",
+	"Content type: text/plain",
+	"Content: ",
+	"unavailable",
+	"Request condition: ",
+	"Cache condition: ",
+}, "\n") + "\n"
+
+func updateResponseObjectOK(i *fastly.UpdateResponseObjectInput) (*fastly.ResponseObject, error) {
+	return &fastly.ResponseObject{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           *i.NewName,
+	}, nil
+}
+
+func updateResponseObjectError(i *fastly.UpdateResponseObjectInput) (*fastly.ResponseObject, error) {
+	return nil, testutil.Err
+}
+
+func deleteResponseObjectOK(i *fastly.DeleteResponseObjectInput) error {
+	return nil
+}
+
+func deleteResponseObjectError(i *fastly.DeleteResponseObjectInput) error {
+	return testutil.Err
+}
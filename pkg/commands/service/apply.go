@@ -0,0 +1,227 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/service/state"
+	"github.com/fastly/cli/pkg/config"
+	fsterr "github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v6/fastly"
+)
+
+// NewApplyCommand returns a usable command registered under the parent.
+func NewApplyCommand(parent cmd.Registerer, globals *config.Data, data manifest.Data) *ApplyCommand {
+	var c ApplyCommand
+	c.CmdClause = parent.Command("apply", "Apply a declarative TOML or JSON document (see 'service export') to a clone of a Fastly service version")
+	c.Globals = globals
+	c.manifest = data
+
+	// Required flags
+	c.CmdClause.Flag("file", "Path to the document to apply. A .json extension indicates a JSON document, otherwise TOML is assumed").Short('f').Required().StringVar(&c.file)
+
+	// Optional flags
+	c.CmdClause.Flag("activate", "Activate the new service version once the changes have been applied").BoolVar(&c.activate)
+	c.CmdClause.Flag("dry-run", "Display the planned changes without applying them").BoolVar(&c.dryRun)
+	c.RegisterFlagBool(cmd.BoolFlagOpts{
+		Name:        cmd.FlagJSONName,
+		Description: "Render the planned changes as JSON (requires --dry-run)",
+		Dst:         &c.json,
+		Short:       'j',
+	})
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Name:        cmd.FlagServiceIDName,
+		Description: cmd.FlagServiceIDDesc,
+		Dst:         &c.manifest.Flag.ServiceID,
+		Short:       's',
+	})
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Action:      c.serviceName.Set,
+		Name:        cmd.FlagServiceName,
+		Description: cmd.FlagServiceDesc,
+		Dst:         &c.serviceName.Value,
+	})
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Name:        cmd.FlagVersionName,
+		Description: "The version to compare against and clone: 'latest', 'active', or the number of a specific version (defaults to the active version, otherwise the latest)",
+		Dst:         &c.serviceVersion.Value,
+	})
+
+	return &c
+}
+
+// ApplyCommand reconciles a service version with a declarative document.
+type ApplyCommand struct {
+	cmd.Base
+
+	activate       bool
+	dryRun         bool
+	file           string
+	json           bool
+	manifest       manifest.Data
+	serviceName    cmd.OptionalServiceNameID
+	serviceVersion cmd.OptionalServiceVersion
+}
+
+// Exec invokes the application logic for the command.
+func (c *ApplyCommand) Exec(in io.Reader, out io.Writer) error {
+	if c.Globals.Verbose() && c.json {
+		return fsterr.ErrInvalidVerboseJSONCombo
+	}
+	if c.json && !c.dryRun {
+		return fsterr.RemediationError{
+			Inner:       fmt.Errorf("--json requires --dry-run"),
+			Remediation: "Add --dry-run to display the planned changes as JSON.",
+		}
+	}
+
+	// gosec flagged this:
+	// G304 (CWE-22): Potential file inclusion via variable
+	//
+	// Disabling as we require the user to provide the path to the document.
+	/* #nosec */
+	data, err := os.ReadFile(c.file)
+	if err != nil {
+		c.Globals.ErrLog.Add(err)
+		return fmt.Errorf("error reading file '%s': %w", c.file, err)
+	}
+	desired, err := state.Parse(data, state.FormatFromPath(c.file))
+	if err != nil {
+		c.Globals.ErrLog.Add(err)
+		return fsterr.RemediationError{
+			Inner:       fmt.Errorf("error parsing '%s': %w", c.file, err),
+			Remediation: "Use 'fastly service export' to generate a valid document.",
+		}
+	}
+
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AllowActiveLocked:  true,
+		APIClient:          c.Globals.APIClient,
+		ErrLog:             c.Globals.ErrLog,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceNameFlag:    c.serviceName,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": fsterr.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+	if desired.ServiceID != "" && desired.ServiceID != serviceID {
+		text.Warning(out, "The document was exported from service %s but is being applied to service %s.", desired.ServiceID, serviceID)
+		text.Break(out)
+	}
+
+	current, err := state.Fetch(c.Globals.APIClient, serviceID, serviceVersion.Number)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	plan := state.Compare(current, desired)
+
+	if c.json {
+		data, err := json.Marshal(plan)
+		if err != nil {
+			return err
+		}
+		_, err = out.Write(data)
+		if err != nil {
+			c.Globals.ErrLog.Add(err)
+			return fmt.Errorf("error: unable to write data to stdout: %w", err)
+		}
+		return nil
+	}
+
+	if plan.Empty() {
+		text.Info(out, "No changes required: service %s version %d matches '%s'.", serviceID, serviceVersion.Number, c.file)
+		return nil
+	}
+
+	plan.Print(out)
+	text.Break(out)
+	text.Output(out, "Plan: %s (compared against version %d).", plan.Summary(), serviceVersion.Number)
+
+	if plan.Versionless() {
+		text.Break(out)
+		text.Warning(out, "Dictionary items and ACL entries are not versioned. Changes to them take effect immediately, regardless of whether the new service version is activated.")
+	}
+
+	if c.dryRun {
+		return nil
+	}
+
+	if !c.Globals.Flag.AutoYes && !c.Globals.Flag.NonInteractive {
+		text.Break(out)
+		cont, err := text.AskYesNo(out, text.BoldYellow("Apply these changes to a clone of the service version? [y/N] "), in)
+		if err != nil {
+			return err
+		}
+		if !cont {
+			return nil
+		}
+	}
+	text.Break(out)
+
+	progress := text.NewProgress(out, c.Globals.Verbose())
+
+	progress.Step(fmt.Sprintf("Cloning service version %d...", serviceVersion.Number))
+	clone, err := c.Globals.APIClient.CloneVersion(&fastly.CloneVersionInput{
+		ServiceID:      serviceID,
+		ServiceVersion: serviceVersion.Number,
+	})
+	if err != nil {
+		progress.Fail()
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return fmt.Errorf("error cloning service version: %w", err)
+	}
+
+	if err := plan.Apply(c.Globals.APIClient, serviceID, clone.Number, progress); err != nil {
+		progress.Fail()
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": clone.Number,
+		})
+		return err
+	}
+
+	if c.activate {
+		progress.Step(fmt.Sprintf("Activating service version %d...", clone.Number))
+		_, err = c.Globals.APIClient.ActivateVersion(&fastly.ActivateVersionInput{
+			ServiceID:      serviceID,
+			ServiceVersion: clone.Number,
+		})
+		if err != nil {
+			progress.Fail()
+			c.Globals.ErrLog.AddWithContext(err, map[string]any{
+				"Service ID":      serviceID,
+				"Service Version": clone.Number,
+			})
+			return fmt.Errorf("error activating service version: %w", err)
+		}
+	}
+
+	progress.Done()
+
+	if c.activate {
+		text.Success(out, "Applied '%s' to service %s and activated version %d", c.file, serviceID, clone.Number)
+	} else {
+		text.Success(out, "Applied '%s' to service %s version %d", c.file, serviceID, clone.Number)
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package service
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/service/state"
+	"github.com/fastly/cli/pkg/config"
+	fsterr "github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// NewExportCommand returns a usable command registered under the parent.
+func NewExportCommand(parent cmd.Registerer, globals *config.Data, data manifest.Data) *ExportCommand {
+	var c ExportCommand
+	c.CmdClause = parent.Command("export", "Export the configuration of a Fastly service version as a declarative TOML or JSON document")
+	c.Globals = globals
+	c.manifest = data
+
+	// Optional flags
+	c.CmdClause.Flag("file", "Path to write the document to (defaults to stdout). A .json extension implies --json").Short('f').StringVar(&c.file)
+	c.RegisterFlagBool(cmd.BoolFlagOpts{
+		Name:        cmd.FlagJSONName,
+		Description: "Render the document as JSON instead of TOML",
+		Dst:         &c.json,
+		Short:       'j',
+	})
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Name:        cmd.FlagServiceIDName,
+		Description: cmd.FlagServiceIDDesc,
+		Dst:         &c.manifest.Flag.ServiceID,
+		Short:       's',
+	})
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Action:      c.serviceName.Set,
+		Name:        cmd.FlagServiceName,
+		Description: cmd.FlagServiceDesc,
+		Dst:         &c.serviceName.Value,
+	})
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Name:        cmd.FlagVersionName,
+		Description: "'latest', 'active', or the number of a specific version (defaults to the active version, otherwise the latest)",
+		Dst:         &c.serviceVersion.Value,
+	})
+
+	return &c
+}
+
+// ExportCommand calls the Fastly API to export the configuration of a service
+// version.
+type ExportCommand struct {
+	cmd.Base
+
+	file           string
+	json           bool
+	manifest       manifest.Data
+	serviceName    cmd.OptionalServiceNameID
+	serviceVersion cmd.OptionalServiceVersion
+}
+
+// Exec invokes the application logic for the command.
+func (c *ExportCommand) Exec(_ io.Reader, out io.Writer) error {
+	if c.Globals.Verbose() && c.file == "" {
+		return fsterr.RemediationError{
+			Inner:       fmt.Errorf("--verbose cannot be used when writing the document to stdout"),
+			Remediation: "Use --file to write the document to disk, or remove --verbose.",
+		}
+	}
+
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AllowActiveLocked:  true,
+		APIClient:          c.Globals.APIClient,
+		ErrLog:             c.Globals.ErrLog,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceNameFlag:    c.serviceName,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": fsterr.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	snapshot, err := state.Fetch(c.Globals.APIClient, serviceID, serviceVersion.Number)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	format := state.FormatFromPath(c.file)
+	if c.json {
+		format = state.FormatJSON
+	}
+	data, err := snapshot.Marshal(format)
+	if err != nil {
+		c.Globals.ErrLog.Add(err)
+		return fmt.Errorf("error encoding service configuration: %w", err)
+	}
+
+	if c.file == "" {
+		_, err = out.Write(data)
+		if err != nil {
+			c.Globals.ErrLog.Add(err)
+			return fmt.Errorf("error: unable to write data to stdout: %w", err)
+		}
+		return nil
+	}
+
+	if err := os.WriteFile(c.file, data, 0o600); err != nil {
+		c.Globals.ErrLog.Add(err)
+		return fmt.Errorf("error writing file '%s': %w", c.file, err)
+	}
+	text.Success(out, "Exported service %s version %d to %s", serviceID, serviceVersion.Number, c.file)
+	return nil
+}
@@ -1,13 +1,11 @@
 package service
 
 import (
-	"encoding/json"
 	"fmt"
 	"io"
 
 	"github.com/fastly/cli/pkg/cmd"
 	"github.com/fastly/cli/pkg/config"
-	fsterr "github.com/fastly/cli/pkg/errors"
 	"github.com/fastly/cli/pkg/text"
 	"github.com/fastly/cli/pkg/time"
 	"github.com/fastly/go-fastly/v6/fastly"
@@ -17,7 +15,6 @@ import (
 type ListCommand struct {
 	cmd.Base
 	input fastly.ListServicesInput
-	json  bool
 }
 
 // NewListCommand returns a usable command registered under the parent.
@@ -26,12 +23,7 @@ func NewListCommand(parent cmd.Registerer, globals *config.Data) *ListCommand {
 	c.Globals = globals
 	c.CmdClause = parent.Command("list", "List Fastly services")
 	c.CmdClause.Flag("direction", "Direction in which to sort results").Default(cmd.PaginationDirection[0]).HintOptions(cmd.PaginationDirection...).EnumVar(&c.input.Direction, cmd.PaginationDirection...)
-	c.RegisterFlagBool(cmd.BoolFlagOpts{
-		Name:        cmd.FlagJSONName,
-		Description: cmd.FlagJSONDesc,
-		Dst:         &c.json,
-		Short:       'j',
-	})
+	c.RegisterOutputFlags()
 	c.CmdClause.Flag("page", "Page number of data set to fetch").IntVar(&c.input.Page)
 	c.CmdClause.Flag("per-page", "Number of records per page").IntVar(&c.input.PerPage)
 	c.CmdClause.Flag("sort", "Field on which to sort").Default("created").StringVar(&c.input.Sort)
@@ -40,8 +32,8 @@ func NewListCommand(parent cmd.Registerer, globals *config.Data) *ListCommand {
 
 // Exec invokes the application logic for the command.
 func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
-	if c.Globals.Verbose() && c.json {
-		return fsterr.ErrInvalidVerboseJSONCombo
+	if err := c.CheckOutputFlags(); err != nil {
+		return err
 	}
 
 	paginator := c.Globals.APIClient.NewListServicesPaginator(&c.input)
@@ -59,17 +51,8 @@ func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
 	}
 
 	if !c.Globals.Verbose() {
-		if c.json {
-			data, err := json.Marshal(ss)
-			if err != nil {
-				return err
-			}
-			_, err = out.Write(data)
-			if err != nil {
-				c.Globals.ErrLog.Add(err)
-				return fmt.Errorf("error: unable to write data to stdout: %w", err)
-			}
-			return nil
+		if ok, err := c.WriteOutput(out, ss); ok {
+			return err
 		}
 
 		tw := text.NewTable(out)
@@ -24,11 +24,16 @@ func NewSearchCommand(parent cmd.Registerer, globals *config.Data, data manifest
 	c.manifest = data
 	c.CmdClause = parent.Command("search", "Search for a Fastly service by name")
 	c.CmdClause.Flag("name", "Service name").Short('n').Required().StringVar(&c.Input.Name)
+	c.RegisterOutputFlags()
 	return &c
 }
 
 // Exec invokes the application logic for the command.
 func (c *SearchCommand) Exec(_ io.Reader, out io.Writer) error {
+	if err := c.CheckOutputFlags(); err != nil {
+		return err
+	}
+
 	service, err := c.Globals.APIClient.SearchService(&c.Input)
 	if err != nil {
 		c.Globals.ErrLog.AddWithContext(err, map[string]any{
@@ -37,6 +42,10 @@ func (c *SearchCommand) Exec(_ io.Reader, out io.Writer) error {
 		return err
 	}
 
+	if ok, err := c.WriteOutput(out, service); ok {
+		return err
+	}
+
 	text.PrintService(out, "", service)
 	return nil
 }
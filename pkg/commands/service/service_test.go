@@ -836,3 +836,206 @@ func deleteServiceOK(*fastly.DeleteServiceInput) error {
 func deleteServiceError(*fastly.DeleteServiceInput) error {
 	return errTest
 }
+
+func TestServiceExport(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name:      "validate missing --service-id flag",
+			Args:      args("service export"),
+			WantError: "error reading service: no service ID found",
+		},
+		{
+			Name: "validate ListBackends API error",
+			API: testutil.EmptyLists(mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListBackendsFn: func(i *fastly.ListBackendsInput) ([]*fastly.Backend, error) {
+					return nil, testutil.Err
+				},
+			}),
+			Args:      args("service export --service-id 123"),
+			WantError: "error listing backend resources: test error",
+		},
+		{
+			Name: "validate TOML output",
+			API: testutil.EmptyLists(mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListBackendsFn: listBackendsOK,
+				ListDomainsFn:  listDomainsOK,
+			}),
+			Args: args("service export --service-id 123"),
+			WantOutputs: []string{
+				"service_id = \"123\"",
+				"version = 1",
+				"[[backend]]",
+				"address = \"example.com\"",
+				"[[domain]]",
+				"name = \"www.example.com\"",
+			},
+		},
+		{
+			Name: "validate JSON output",
+			API: testutil.EmptyLists(mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListBackendsFn: listBackendsOK,
+				ListDomainsFn:  listDomainsOK,
+			}),
+			Args: args("service export --service-id 123 --version 3 --json"),
+			WantOutputs: []string{
+				"\"service_id\": \"123\"",
+				"\"version\": 3",
+				"\"address\": \"example.com\"",
+			},
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			for _, s := range testcase.WantOutputs {
+				testutil.AssertStringContains(t, stdout.String(), s)
+			}
+		})
+	}
+}
+
+func TestServiceApply(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name:      "validate missing --file flag",
+			Args:      args("service apply --service-id 123"),
+			WantError: "error parsing arguments: required flag --file not provided",
+		},
+		{
+			Name:      "validate --json requires --dry-run",
+			Args:      args("service apply --service-id 123 --file ./testdata/apply/service.toml --json"),
+			WantError: "--json requires --dry-run",
+		},
+		{
+			Name:      "validate unrecognised field",
+			Args:      args("service apply --service-id 123 --file ./testdata/apply/invalid.toml"),
+			WantError: "unrecognised field 'adress' for backend resource 'new'",
+		},
+		{
+			Name: "validate --dry-run displays plan",
+			API: testutil.EmptyLists(mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListBackendsFn: listBackendsOK,
+				ListDomainsFn:  listDomainsOK,
+			}),
+			Args: args("service apply --service-id 123 --file ./testdata/apply/service.toml --dry-run"),
+			WantOutputs: []string{
+				"~ backend 'new'",
+				"port: 80 => 443",
+				"~ domain 'www.example.com'",
+				"comment: \"\" => \"updated\"",
+				"- domain 'old.example.com'",
+				"Plan: 0 to create, 2 to update, 1 to delete (compared against version 1).",
+			},
+		},
+		{
+			Name: "validate --dry-run --json displays plan",
+			API: testutil.EmptyLists(mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListBackendsFn: listBackendsOK,
+				ListDomainsFn:  listDomainsOK,
+			}),
+			Args:       args("service apply --service-id 123 --file ./testdata/apply/service.toml --dry-run --json"),
+			WantOutput: `{"action":"delete","kind":"domain","name":"old.example.com"}`,
+		},
+		{
+			Name: "validate changes are applied to a cloned version",
+			API: testutil.EmptyLists(mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListBackendsFn: listBackendsOK,
+				ListDomainsFn:  listDomainsOK,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				UpdateBackendFn: func(i *fastly.UpdateBackendInput) (*fastly.Backend, error) {
+					if i.ServiceVersion != 4 || i.Port == nil || *i.Port != 443 || i.Address != nil {
+						return nil, testutil.Err
+					}
+					return &fastly.Backend{}, nil
+				},
+				UpdateDomainFn: func(i *fastly.UpdateDomainInput) (*fastly.Domain, error) {
+					if i.Comment == nil || *i.Comment != "updated" {
+						return nil, testutil.Err
+					}
+					return &fastly.Domain{}, nil
+				},
+				DeleteDomainFn: func(i *fastly.DeleteDomainInput) error {
+					if i.Name != "old.example.com" {
+						return testutil.Err
+					}
+					return nil
+				},
+				ActivateVersionFn: func(i *fastly.ActivateVersionInput) (*fastly.Version, error) {
+					return &fastly.Version{Number: i.ServiceVersion}, nil
+				},
+			}),
+			Args:       args("service apply --service-id 123 --file ./testdata/apply/service.toml --activate --auto-yes"),
+			WantOutput: "Applied './testdata/apply/service.toml' to service 123 and activated version 4",
+		},
+		{
+			Name: "validate no changes",
+			API: testutil.EmptyLists(mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListBackendsFn: func(i *fastly.ListBackendsInput) ([]*fastly.Backend, error) {
+					return []*fastly.Backend{{Name: "new", Address: "example.com", Port: 443}}, nil
+				},
+				ListDomainsFn: func(i *fastly.ListDomainsInput) ([]*fastly.Domain, error) {
+					return []*fastly.Domain{{Name: "www.example.com", Comment: "updated"}}, nil
+				},
+			}),
+			Args:       args("service apply --service-id 123 --file ./testdata/apply/service.toml"),
+			WantOutput: "No changes required",
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+			for _, s := range testcase.WantOutputs {
+				testutil.AssertStringContains(t, stdout.String(), s)
+			}
+		})
+	}
+}
+
+func listBackendsOK(i *fastly.ListBackendsInput) ([]*fastly.Backend, error) {
+	return []*fastly.Backend{
+		{
+			ServiceID:      i.ServiceID,
+			ServiceVersion: i.ServiceVersion,
+			Name:           "new",
+			Address:        "example.com",
+			Port:           80,
+		},
+	}, nil
+}
+
+func listDomainsOK(i *fastly.ListDomainsInput) ([]*fastly.Domain, error) {
+	return []*fastly.Domain{
+		{
+			ServiceID:      i.ServiceID,
+			ServiceVersion: i.ServiceVersion,
+			Name:           "www.example.com",
+		},
+		{
+			ServiceID:      i.ServiceID,
+			ServiceVersion: i.ServiceVersion,
+			Name:           "old.example.com",
+		},
+	}, nil
+}
@@ -0,0 +1,322 @@
+package state
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/go-fastly/v6/fastly"
+)
+
+// NOTE: Dictionary items and ACL entries aren't versioned. They're attached to
+// a dictionary/ACL ID which is shared by every service version cloned from the
+// version the dictionary/ACL was created in. This means changes to them take
+// effect immediately, regardless of which version is activated.
+
+// withDictionaryItems extends the dictionary Kind so that an `items` table
+// is exported along with each dictionary and synchronised when applied.
+func withDictionaryItems(k *Kind) *Kind {
+	k.children = map[string]bool{"items": true}
+	k.versionless = true
+
+	k.expand = func(c api.Interface, model any, r Resource) error {
+		d := model.(*fastly.Dictionary)
+		items, err := c.ListDictionaryItems(&fastly.ListDictionaryItemsInput{
+			ServiceID:    d.ServiceID,
+			DictionaryID: d.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("error listing items for dictionary '%s': %w", d.Name, err)
+		}
+		m := make(map[string]any, len(items))
+		for _, item := range items {
+			m[item.ItemKey] = item.ItemValue
+		}
+		r["items"] = m
+		return nil
+	}
+
+	k.sync = func(c api.Interface, serviceID string, version int, name string, current, desired any) error {
+		d, err := c.GetDictionary(&fastly.GetDictionaryInput{
+			ServiceID:      serviceID,
+			ServiceVersion: version,
+			Name:           name,
+		})
+		if err != nil {
+			return err
+		}
+
+		have, _ := current.(map[string]any)
+		want, _ := desired.(map[string]any)
+
+		var ops []*fastly.BatchDictionaryItem
+		for _, key := range sortedKeys(want) {
+			value := fmt.Sprint(want[key])
+			if v, ok := have[key]; ok && fmt.Sprint(v) == value {
+				continue
+			}
+			ops = append(ops, &fastly.BatchDictionaryItem{
+				Operation: fastly.UpsertBatchOperation,
+				ItemKey:   key,
+				ItemValue: value,
+			})
+		}
+		for _, key := range sortedKeys(have) {
+			if _, ok := want[key]; !ok {
+				ops = append(ops, &fastly.BatchDictionaryItem{
+					Operation: fastly.DeleteBatchOperation,
+					ItemKey:   key,
+				})
+			}
+		}
+
+		for len(ops) > 0 {
+			n := min(len(ops), fastly.BatchModifyMaximumOperations)
+			err := c.BatchModifyDictionaryItems(&fastly.BatchModifyDictionaryItemsInput{
+				ServiceID:    serviceID,
+				DictionaryID: d.ID,
+				Items:        ops[:n],
+			})
+			if err != nil {
+				return err
+			}
+			ops = ops[n:]
+		}
+		return nil
+	}
+
+	return k
+}
+
+// withACLEntries extends the ACL Kind so that an `entries` list is exported
+// along with each ACL and synchronised when applied.
+//
+// Entries are identified by their IP and subnet.
+func withACLEntries(k *Kind) *Kind {
+	k.children = map[string]bool{"entries": true}
+	k.versionless = true
+
+	k.expand = func(c api.Interface, model any, r Resource) error {
+		a := model.(*fastly.ACL)
+		entries, err := c.ListACLEntries(&fastly.ListACLEntriesInput{
+			ServiceID: a.ServiceID,
+			ACLID:     a.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("error listing entries for ACL '%s': %w", a.Name, err)
+		}
+		list := make([]any, 0, len(entries))
+		for _, e := range entries {
+			list = append(list, aclEntryResource(e))
+		}
+		r["entries"] = list
+		return nil
+	}
+
+	k.canonical = func(r Resource) {
+		if list, ok := r["entries"].([]any); ok {
+			sort.SliceStable(list, func(i, j int) bool {
+				a, _ := list[i].(map[string]any)
+				b, _ := list[j].(map[string]any)
+				return aclEntryKey(a) < aclEntryKey(b)
+			})
+		}
+	}
+
+	k.sync = func(c api.Interface, serviceID string, version int, name string, _, desired any) error {
+		a, err := c.GetACL(&fastly.GetACLInput{
+			ServiceID:      serviceID,
+			ServiceVersion: version,
+			Name:           name,
+		})
+		if err != nil {
+			return err
+		}
+
+		// NOTE: We compare against the live entries, rather than the snapshot,
+		// as we need the entry IDs in order to update or delete them.
+		entries, err := c.ListACLEntries(&fastly.ListACLEntriesInput{
+			ServiceID: serviceID,
+			ACLID:     a.ID,
+		})
+		if err != nil {
+			return err
+		}
+		have := make(map[string]*fastly.ACLEntry, len(entries))
+		for _, e := range entries {
+			have[aclEntryKey(aclEntryResource(e))] = e
+		}
+
+		want := make(map[string]map[string]any)
+		list, _ := desired.([]any)
+		for _, v := range list {
+			e, ok := v.(map[string]any)
+			if !ok {
+				return fmt.Errorf("invalid entry for ACL '%s': %v", name, v)
+			}
+			want[aclEntryKey(e)] = e
+		}
+
+		var ops []*fastly.BatchACLEntry
+		for _, key := range sortedKeys(want) {
+			e := want[key]
+			op := &fastly.BatchACLEntry{Operation: fastly.CreateBatchOperation}
+			if existing, ok := have[key]; ok {
+				if equal(aclEntryResource(existing), e) {
+					continue
+				}
+				op.Operation = fastly.UpdateBatchOperation
+				op.ID = fastly.String(existing.ID)
+			}
+			if err := toBatchACLEntry(e, op); err != nil {
+				return fmt.Errorf("invalid entry for ACL '%s': %w", name, err)
+			}
+			ops = append(ops, op)
+		}
+		for _, key := range sortedKeys(have) {
+			if _, ok := want[key]; !ok {
+				ops = append(ops, &fastly.BatchACLEntry{
+					Operation: fastly.DeleteBatchOperation,
+					ID:        fastly.String(have[key].ID),
+				})
+			}
+		}
+
+		for len(ops) > 0 {
+			n := min(len(ops), fastly.BatchModifyMaximumOperations)
+			err := c.BatchModifyACLEntries(&fastly.BatchModifyACLEntriesInput{
+				ServiceID: serviceID,
+				ACLID:     a.ID,
+				Entries:   ops[:n],
+			})
+			if err != nil {
+				return err
+			}
+			ops = ops[n:]
+		}
+		return nil
+	}
+
+	return k
+}
+
+// withDirectorBackends extends the director Kind so that a `backends` list is
+// exported along with each director and synchronised when applied.
+//
+// Unlike dictionary items and ACL entries, director backends are versioned.
+func withDirectorBackends(k *Kind) *Kind {
+	k.children = map[string]bool{"backends": true}
+
+	k.expand = func(_ api.Interface, model any, r Resource) error {
+		d := model.(*fastly.Director)
+		list := make([]any, 0, len(d.Backends))
+		for _, b := range d.Backends {
+			list = append(list, b)
+		}
+		r["backends"] = list
+		return nil
+	}
+
+	k.canonical = func(r Resource) {
+		if list, ok := r["backends"].([]any); ok {
+			sort.SliceStable(list, func(i, j int) bool {
+				return fmt.Sprint(list[i]) < fmt.Sprint(list[j])
+			})
+		}
+	}
+
+	k.sync = func(c api.Interface, serviceID string, version int, name string, current, desired any) error {
+		have := make(map[string]bool)
+		if list, ok := current.([]any); ok {
+			for _, v := range list {
+				have[fmt.Sprint(v)] = true
+			}
+		}
+		want := make(map[string]bool)
+		if list, ok := desired.([]any); ok {
+			for _, v := range list {
+				want[fmt.Sprint(v)] = true
+			}
+		}
+
+		for _, backend := range sortedKeys(want) {
+			if have[backend] {
+				continue
+			}
+			_, err := c.CreateDirectorBackend(&fastly.CreateDirectorBackendInput{
+				ServiceID:      serviceID,
+				ServiceVersion: version,
+				Director:       name,
+				Backend:        backend,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		for _, backend := range sortedKeys(have) {
+			if want[backend] {
+				continue
+			}
+			err := c.DeleteDirectorBackend(&fastly.DeleteDirectorBackendInput{
+				ServiceID:      serviceID,
+				ServiceVersion: version,
+				Director:       name,
+				Backend:        backend,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return k
+}
+
+// aclEntryResource converts an ACL entry into its snapshot representation.
+func aclEntryResource(e *fastly.ACLEntry) map[string]any {
+	m := map[string]any{
+		"ip":      e.IP,
+		"negated": e.Negated,
+	}
+	if e.Subnet != nil {
+		m["subnet"] = int64(*e.Subnet)
+	}
+	if e.Comment != "" {
+		m["comment"] = e.Comment
+	}
+	return m
+}
+
+// aclEntryKey identifies an ACL entry.
+func aclEntryKey(e map[string]any) string {
+	if subnet, ok := e["subnet"]; ok {
+		return fmt.Sprintf("%v/%v", e["ip"], subnet)
+	}
+	return fmt.Sprint(e["ip"])
+}
+
+// toBatchACLEntry populates the batch operation from the snapshot entry.
+func toBatchACLEntry(e map[string]any, op *fastly.BatchACLEntry) error {
+	ip, ok := e["ip"].(string)
+	if !ok || ip == "" {
+		return fmt.Errorf("missing 'ip' field")
+	}
+	op.IP = fastly.String(ip)
+	if subnet, ok := e["subnet"].(int64); ok {
+		op.Subnet = fastly.Int(int(subnet))
+	}
+	negated, _ := e["negated"].(bool)
+	op.Negated = fastly.CBool(negated)
+	comment, _ := e["comment"].(string)
+	op.Comment = fastly.String(comment)
+	return nil
+}
+
+// min returns the smaller of two integers.
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
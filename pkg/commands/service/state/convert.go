@@ -0,0 +1,210 @@
+package state
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// inputFields returns the set of field names (taken from the `url` struct tag)
+// that the given go-fastly input type will send to the API.
+//
+// NOTE: The ServiceID and ServiceVersion fields don't define a `url` tag and
+// so are naturally excluded.
+func inputFields(t reflect.Type) map[string]bool {
+	fields := make(map[string]bool)
+	for i := 0; i < t.NumField(); i++ {
+		if name := tagName(t.Field(i).Tag.Get("url")); name != "" {
+			fields[name] = true
+		}
+	}
+	return fields
+}
+
+// tagName strips any options (e.g. `,omitempty`) from a struct tag value.
+func tagName(tag string) string {
+	name, _, _ := strings.Cut(tag, ",")
+	return name
+}
+
+// fromModel converts a go-fastly response model into a Resource.
+//
+// Only fields that can be set via the associated create input are kept. This
+// excludes read-only fields such as IDs and timestamps, which would otherwise
+// always differ between two service versions.
+func fromModel(m any, fields map[string]bool) Resource {
+	r := make(Resource)
+	v := reflect.Indirect(reflect.ValueOf(m))
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		name := tagName(t.Field(i).Tag.Get("mapstructure"))
+		if !fields[name] {
+			continue
+		}
+		fv := v.Field(i)
+		if fv.Kind() == reflect.Ptr {
+			if fv.IsNil() {
+				continue
+			}
+			fv = fv.Elem()
+		}
+		r[name] = normalize(fv.Interface())
+	}
+	return r
+}
+
+// normalize coerces a value into one of a small set of types (bool, int64,
+// float64, string, []any and map[string]any) so that values decoded from the
+// API, a TOML document or a JSON document can be compared reliably.
+func normalize(v any) any {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Invalid:
+		return nil
+	case reflect.Bool:
+		return rv.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(rv.Uint())
+	case reflect.Float32, reflect.Float64:
+		f := rv.Float()
+		if f == math.Trunc(f) && math.Abs(f) < math.MaxInt64 {
+			return int64(f)
+		}
+		return f
+	case reflect.String:
+		return rv.String()
+	case reflect.Ptr, reflect.Interface:
+		if rv.IsNil() {
+			return nil
+		}
+		return normalize(rv.Elem().Interface())
+	case reflect.Slice, reflect.Array:
+		out := make([]any, rv.Len())
+		for i := range out {
+			out[i] = normalize(rv.Index(i).Interface())
+		}
+		return out
+	case reflect.Map:
+		out := make(map[string]any, rv.Len())
+		iter := rv.MapRange()
+		for iter.Next() {
+			out[fmt.Sprint(iter.Key().Interface())] = normalize(iter.Value().Interface())
+		}
+		return out
+	}
+	return v
+}
+
+// isZero reports whether a normalized value is the zero value for its type.
+func isZero(v any) bool {
+	switch t := v.(type) {
+	case nil:
+		return true
+	case []any:
+		return len(t) == 0
+	case map[string]any:
+		return len(t) == 0
+	}
+	return reflect.ValueOf(v).IsZero()
+}
+
+// toInput copies the Resource values into the matching fields of a go-fastly
+// input struct. If only is non-nil, then only the named fields are copied.
+func toInput(r Resource, in any, only map[string]bool) error {
+	v := reflect.ValueOf(in).Elem()
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		name := tagName(t.Field(i).Tag.Get("url"))
+		if name == "" || (only != nil && !only[name]) {
+			continue
+		}
+		val, ok := r[name]
+		if !ok {
+			continue
+		}
+		if err := assign(v.Field(i), val); err != nil {
+			return fmt.Errorf("invalid value for '%s': %w", name, err)
+		}
+	}
+	return nil
+}
+
+// assign sets the field to the given normalized value, allocating pointers
+// and converting to named types (e.g. fastly.Compatibool) as required.
+func assign(f reflect.Value, val any) error {
+	if val == nil {
+		return nil
+	}
+	if f.Kind() == reflect.Ptr {
+		p := reflect.New(f.Type().Elem())
+		if err := assign(p.Elem(), val); err != nil {
+			return err
+		}
+		f.Set(p)
+		return nil
+	}
+
+	switch f.Kind() {
+	case reflect.String:
+		s, ok := val.(string)
+		if !ok {
+			return fmt.Errorf("expected a string, got %v", val)
+		}
+		f.SetString(s)
+	case reflect.Bool:
+		b, ok := val.(bool)
+		if !ok {
+			return fmt.Errorf("expected a boolean, got %v", val)
+		}
+		f.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, ok := val.(int64)
+		if !ok {
+			return fmt.Errorf("expected an integer, got %v", val)
+		}
+		f.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, ok := val.(int64)
+		if !ok || n < 0 {
+			return fmt.Errorf("expected a positive integer, got %v", val)
+		}
+		f.SetUint(uint64(n))
+	case reflect.Float32, reflect.Float64:
+		switch n := val.(type) {
+		case int64:
+			f.SetFloat(float64(n))
+		case float64:
+			f.SetFloat(n)
+		default:
+			return fmt.Errorf("expected a number, got %v", val)
+		}
+	default:
+		return fmt.Errorf("unsupported field type %s", f.Type())
+	}
+	return nil
+}
+
+// setField sets a named field on a go-fastly input struct if it exists.
+//
+// It's used for the fields common to all inputs (e.g. ServiceID) which aren't
+// sent as part of the request body and so have no `url` tag.
+func setField(in any, field string, val any) {
+	f := reflect.ValueOf(in).Elem().FieldByName(field)
+	if f.IsValid() && f.CanSet() {
+		f.Set(reflect.ValueOf(val).Convert(f.Type()))
+	}
+}
+
+// sortedKeys returns the keys of the map in alphabetical order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,4 @@
+// Package state models the declarative configuration of a Fastly service
+// version so that it can be exported to disk, compared against another version
+// and applied back to a service.
+package state
@@ -0,0 +1,173 @@
+package state
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/fastly/cli/pkg/api"
+)
+
+// Kind describes a type of versioned service resource and how to list,
+// create, update and delete it using the Fastly API.
+type Kind struct {
+	// Name is the key used for the resource type within a Snapshot document.
+	Name string
+
+	// fields is the set of fields that can be set on the resource.
+	fields map[string]bool
+	// children is the set of additional fields that are managed by sync.
+	children map[string]bool
+	// versionless indicates the children aren't versioned (see children.go).
+	versionless bool
+
+	list   func(c api.Interface, serviceID string, version int) ([]Resource, error)
+	create func(c api.Interface, serviceID string, version int, r Resource) error
+	update func(c api.Interface, serviceID string, version int, name string, r Resource, only map[string]bool) error
+	remove func(c api.Interface, serviceID string, version int, name string) error
+
+	// expand populates child fields (e.g. dictionary items) after listing.
+	expand func(c api.Interface, model any, r Resource) error
+	// sync reconciles child fields after a resource is created or updated.
+	sync func(c api.Interface, serviceID string, version int, name string, current, desired any) error
+	// canonical sorts child fields (e.g. ACL entries) into a stable order.
+	canonical func(r Resource)
+}
+
+// newKind constructs a Kind from the go-fastly API functions for a resource.
+//
+// The type parameters are inferred from the given api.Interface method
+// expressions, which means the input structs can be populated generically:
+// the `ServiceID`, `ServiceVersion` and `Name` fields are common to all of
+// them while every other field is mapped using its `url` struct tag.
+func newKind[L, M, C, U, D any](
+	name string,
+	list func(api.Interface, *L) ([]*M, error),
+	create func(api.Interface, *C) (*M, error),
+	update func(api.Interface, *U) (*M, error),
+	remove func(api.Interface, *D) error,
+) *Kind {
+	fields := inputFields(reflect.TypeOf((*C)(nil)).Elem())
+
+	k := &Kind{
+		Name:   name,
+		fields: fields,
+	}
+
+	k.list = func(c api.Interface, serviceID string, version int) ([]Resource, error) {
+		var in L
+		setField(&in, "ServiceID", serviceID)
+		setField(&in, "ServiceVersion", version)
+		ms, err := list(c, &in)
+		if err != nil {
+			return nil, err
+		}
+		rs := make([]Resource, 0, len(ms))
+		for _, m := range ms {
+			r := fromModel(m, fields)
+			if k.expand != nil {
+				if err := k.expand(c, m, r); err != nil {
+					return nil, err
+				}
+			}
+			if k.canonical != nil {
+				k.canonical(r)
+			}
+			rs = append(rs, r)
+		}
+		return rs, nil
+	}
+	k.create = func(c api.Interface, serviceID string, version int, r Resource) error {
+		var in C
+		setField(&in, "ServiceID", serviceID)
+		setField(&in, "ServiceVersion", version)
+		if err := toInput(r, &in, nil); err != nil {
+			return err
+		}
+		_, err := create(c, &in)
+		return err
+	}
+	k.update = func(c api.Interface, serviceID string, version int, name string, r Resource, only map[string]bool) error {
+		var in U
+		setField(&in, "ServiceID", serviceID)
+		setField(&in, "ServiceVersion", version)
+		setField(&in, "Name", name)
+		if err := toInput(r, &in, only); err != nil {
+			return err
+		}
+		_, err := update(c, &in)
+		return err
+	}
+	k.remove = func(c api.Interface, serviceID string, version int, name string) error {
+		var in D
+		setField(&in, "ServiceID", serviceID)
+		setField(&in, "ServiceVersion", version)
+		setField(&in, "Name", name)
+		return remove(c, &in)
+	}
+
+	return k
+}
+
+// Kinds is the list of supported resource types.
+//
+// NOTE: The order is significant. Resources are created and updated in this
+// order, and deleted in the reverse order, so that a resource is always
+// available before another resource references it (e.g. a backend that
+// references a healthcheck or a condition).
+var Kinds = []*Kind{
+	newKind("healthcheck", api.Interface.ListHealthChecks, api.Interface.CreateHealthCheck, api.Interface.UpdateHealthCheck, api.Interface.DeleteHealthCheck),
+	newKind("condition", api.Interface.ListConditions, api.Interface.CreateCondition, api.Interface.UpdateCondition, api.Interface.DeleteCondition),
+	newKind("domain", api.Interface.ListDomains, api.Interface.CreateDomain, api.Interface.UpdateDomain, api.Interface.DeleteDomain),
+	newKind("backend", api.Interface.ListBackends, api.Interface.CreateBackend, api.Interface.UpdateBackend, api.Interface.DeleteBackend),
+	withDirectorBackends(newKind("director", api.Interface.ListDirectors, api.Interface.CreateDirector, api.Interface.UpdateDirector, api.Interface.DeleteDirector)),
+	newKind("header", api.Interface.ListHeaders, api.Interface.CreateHeader, api.Interface.UpdateHeader, api.Interface.DeleteHeader),
+	newKind("cache_setting", api.Interface.ListCacheSettings, api.Interface.CreateCacheSetting, api.Interface.UpdateCacheSetting, api.Interface.DeleteCacheSetting),
+	newKind("request_setting", api.Interface.ListRequestSettings, api.Interface.CreateRequestSetting, api.Interface.UpdateRequestSetting, api.Interface.DeleteRequestSetting),
+	newKind("response_object", api.Interface.ListResponseObjects, api.Interface.CreateResponseObject, api.Interface.UpdateResponseObject, api.Interface.DeleteResponseObject),
+	newKind("gzip", api.Interface.ListGzips, api.Interface.CreateGzip, api.Interface.UpdateGzip, api.Interface.DeleteGzip),
+	withDictionaryItems(newKind("dictionary", api.Interface.ListDictionaries, api.Interface.CreateDictionary, api.Interface.UpdateDictionary, api.Interface.DeleteDictionary)),
+	withACLEntries(newKind("acl", api.Interface.ListACLs, api.Interface.CreateACL, api.Interface.UpdateACL, api.Interface.DeleteACL)),
+	newKind("snippet", api.Interface.ListSnippets, api.Interface.CreateSnippet, api.Interface.UpdateSnippet, api.Interface.DeleteSnippet),
+	newKind("vcl", api.Interface.ListVCLs, api.Interface.CreateVCL, api.Interface.UpdateVCL, api.Interface.DeleteVCL),
+	newKind("logging_azureblob", api.Interface.ListBlobStorages, api.Interface.CreateBlobStorage, api.Interface.UpdateBlobStorage, api.Interface.DeleteBlobStorage),
+	newKind("logging_bigquery", api.Interface.ListBigQueries, api.Interface.CreateBigQuery, api.Interface.UpdateBigQuery, api.Interface.DeleteBigQuery),
+	newKind("logging_cloudfiles", api.Interface.ListCloudfiles, api.Interface.CreateCloudfiles, api.Interface.UpdateCloudfiles, api.Interface.DeleteCloudfiles),
+	newKind("logging_datadog", api.Interface.ListDatadog, api.Interface.CreateDatadog, api.Interface.UpdateDatadog, api.Interface.DeleteDatadog),
+	newKind("logging_digitalocean", api.Interface.ListDigitalOceans, api.Interface.CreateDigitalOcean, api.Interface.UpdateDigitalOcean, api.Interface.DeleteDigitalOcean),
+	newKind("logging_elasticsearch", api.Interface.ListElasticsearch, api.Interface.CreateElasticsearch, api.Interface.UpdateElasticsearch, api.Interface.DeleteElasticsearch),
+	newKind("logging_ftp", api.Interface.ListFTPs, api.Interface.CreateFTP, api.Interface.UpdateFTP, api.Interface.DeleteFTP),
+	newKind("logging_gcs", api.Interface.ListGCSs, api.Interface.CreateGCS, api.Interface.UpdateGCS, api.Interface.DeleteGCS),
+	newKind("logging_googlepubsub", api.Interface.ListPubsubs, api.Interface.CreatePubsub, api.Interface.UpdatePubsub, api.Interface.DeletePubsub),
+	newKind("logging_heroku", api.Interface.ListHerokus, api.Interface.CreateHeroku, api.Interface.UpdateHeroku, api.Interface.DeleteHeroku),
+	newKind("logging_honeycomb", api.Interface.ListHoneycombs, api.Interface.CreateHoneycomb, api.Interface.UpdateHoneycomb, api.Interface.DeleteHoneycomb),
+	newKind("logging_https", api.Interface.ListHTTPS, api.Interface.CreateHTTPS, api.Interface.UpdateHTTPS, api.Interface.DeleteHTTPS),
+	newKind("logging_kafka", api.Interface.ListKafkas, api.Interface.CreateKafka, api.Interface.UpdateKafka, api.Interface.DeleteKafka),
+	newKind("logging_kinesis", api.Interface.ListKinesis, api.Interface.CreateKinesis, api.Interface.UpdateKinesis, api.Interface.DeleteKinesis),
+	newKind("logging_logentries", api.Interface.ListLogentries, api.Interface.CreateLogentries, api.Interface.UpdateLogentries, api.Interface.DeleteLogentries),
+	newKind("logging_loggly", api.Interface.ListLoggly, api.Interface.CreateLoggly, api.Interface.UpdateLoggly, api.Interface.DeleteLoggly),
+	newKind("logging_logshuttle", api.Interface.ListLogshuttles, api.Interface.CreateLogshuttle, api.Interface.UpdateLogshuttle, api.Interface.DeleteLogshuttle),
+	newKind("logging_newrelic", api.Interface.ListNewRelic, api.Interface.CreateNewRelic, api.Interface.UpdateNewRelic, api.Interface.DeleteNewRelic),
+	newKind("logging_openstack", api.Interface.ListOpenstack, api.Interface.CreateOpenstack, api.Interface.UpdateOpenstack, api.Interface.DeleteOpenstack),
+	newKind("logging_papertrail", api.Interface.ListPapertrails, api.Interface.CreatePapertrail, api.Interface.UpdatePapertrail, api.Interface.DeletePapertrail),
+	newKind("logging_s3", api.Interface.ListS3s, api.Interface.CreateS3, api.Interface.UpdateS3, api.Interface.DeleteS3),
+	newKind("logging_scalyr", api.Interface.ListScalyrs, api.Interface.CreateScalyr, api.Interface.UpdateScalyr, api.Interface.DeleteScalyr),
+	newKind("logging_sftp", api.Interface.ListSFTPs, api.Interface.CreateSFTP, api.Interface.UpdateSFTP, api.Interface.DeleteSFTP),
+	newKind("logging_splunk", api.Interface.ListSplunks, api.Interface.CreateSplunk, api.Interface.UpdateSplunk, api.Interface.DeleteSplunk),
+	newKind("logging_sumologic", api.Interface.ListSumologics, api.Interface.CreateSumologic, api.Interface.UpdateSumologic, api.Interface.DeleteSumologic),
+	newKind("logging_syslog", api.Interface.ListSyslogs, api.Interface.CreateSyslog, api.Interface.UpdateSyslog, api.Interface.DeleteSyslog),
+}
+
+// LookupKind returns the Kind with the given name.
+func LookupKind(name string) (*Kind, error) {
+	for _, k := range Kinds {
+		if k.Name == name {
+			return k, nil
+		}
+	}
+	return nil, fmt.Errorf("unrecognised resource type '%s'", name)
+}
+
+// hasField reports whether the named field is managed for this Kind.
+func (k *Kind) hasField(name string) bool {
+	return k.fields[name] || k.children[name]
+}
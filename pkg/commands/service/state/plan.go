@@ -0,0 +1,310 @@
+package state
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// Action is the type of change to be made to a resource.
+type Action string
+
+// Supported actions.
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+)
+
+// FieldChange is a change to a single field of a resource.
+type FieldChange struct {
+	Field string `json:"field"`
+	Old   any    `json:"old,omitempty"`
+	New   any    `json:"new,omitempty"`
+}
+
+// Change is a change to a single resource.
+type Change struct {
+	Action Action        `json:"action"`
+	Kind   string        `json:"kind"`
+	Name   string        `json:"name"`
+	Fields []FieldChange `json:"fields,omitempty"`
+
+	desired Resource
+}
+
+// Plan is the list of changes required to reconcile two Snapshots.
+type Plan struct {
+	Changes []Change `json:"changes"`
+}
+
+// Empty reports whether the plan has no changes.
+func (p Plan) Empty() bool {
+	return len(p.Changes) == 0
+}
+
+// Compare calculates the changes required to turn the current Snapshot into
+// the desired Snapshot.
+//
+// Fields that aren't specified on a desired resource are considered to be
+// unmanaged and are left as is, while resources that aren't specified at all
+// are deleted.
+func Compare(current, desired *Snapshot) Plan {
+	var p Plan
+	for _, k := range Kinds {
+		have := make(map[string]Resource)
+		for _, r := range current.Resources[k.Name] {
+			have[r.Name()] = r
+		}
+		want := make(map[string]bool)
+
+		for _, r := range desired.Resources[k.Name] {
+			name := r.Name()
+			want[name] = true
+
+			existing, ok := have[name]
+			if !ok {
+				c := Change{Action: ActionCreate, Kind: k.Name, Name: name, desired: r}
+				for _, field := range sortedKeys(r) {
+					if field != "name" && !isZero(r[field]) {
+						c.Fields = append(c.Fields, FieldChange{Field: field, New: r[field]})
+					}
+				}
+				p.Changes = append(p.Changes, c)
+				continue
+			}
+
+			c := Change{Action: ActionUpdate, Kind: k.Name, Name: name, desired: r}
+			for _, field := range sortedKeys(r) {
+				if !equal(existing[field], r[field]) {
+					c.Fields = append(c.Fields, FieldChange{Field: field, Old: existing[field], New: r[field]})
+				}
+			}
+			if len(c.Fields) > 0 {
+				p.Changes = append(p.Changes, c)
+			}
+		}
+
+		for _, r := range current.Resources[k.Name] {
+			if want[r.Name()] {
+				continue
+			}
+			c := Change{Action: ActionDelete, Kind: k.Name, Name: r.Name()}
+			for _, field := range sortedKeys(r) {
+				if field != "name" && !isZero(r[field]) {
+					c.Fields = append(c.Fields, FieldChange{Field: field, Old: r[field]})
+				}
+			}
+			p.Changes = append(p.Changes, c)
+		}
+	}
+	return p
+}
+
+// Apply makes the planned changes to the given (editable) service version.
+//
+// Resources are created and updated in dependency order, then deleted in the
+// reverse order.
+func (p Plan) Apply(c api.Interface, serviceID string, version int, progress text.Progress) error {
+	for _, change := range p.Changes {
+		if change.Action == ActionDelete {
+			continue
+		}
+		k, err := LookupKind(change.Kind)
+		if err != nil {
+			return err
+		}
+		progress.Step(fmt.Sprintf("%s %s '%s'...", actionVerb(change.Action), k.Name, change.Name))
+
+		// Child fields are managed separately via sync, and so must be
+		// excluded from the create/update request.
+		r := make(Resource, len(change.desired))
+		children := make(map[string]any)
+		for field, value := range change.desired {
+			if k.children[field] {
+				children[field] = value
+				continue
+			}
+			r[field] = value
+		}
+
+		old := make(map[string]any)
+		switch change.Action {
+		case ActionCreate:
+			if err := k.create(c, serviceID, version, r); err != nil {
+				return fmt.Errorf("error creating %s '%s': %w", k.Name, change.Name, err)
+			}
+		case ActionUpdate:
+			// Only the changed fields are sent so that unmanaged fields (and
+			// fields that only differ in their child resources) are left as is.
+			only := make(map[string]bool)
+			for _, fc := range change.Fields {
+				old[fc.Field] = fc.Old
+				if !k.children[fc.Field] {
+					only[fc.Field] = true
+				}
+			}
+			if len(only) > 0 {
+				if err := k.update(c, serviceID, version, change.Name, r, only); err != nil {
+					return fmt.Errorf("error updating %s '%s': %w", k.Name, change.Name, err)
+				}
+			}
+		}
+
+		for _, field := range sortedKeys(children) {
+			if _, changed := old[field]; change.Action == ActionUpdate && !changed {
+				continue
+			}
+			if err := k.sync(c, serviceID, version, change.Name, old[field], children[field]); err != nil {
+				return fmt.Errorf("error updating %s for %s '%s': %w", field, k.Name, change.Name, err)
+			}
+		}
+	}
+
+	for i := len(p.Changes) - 1; i >= 0; i-- {
+		change := p.Changes[i]
+		if change.Action != ActionDelete {
+			continue
+		}
+		k, err := LookupKind(change.Kind)
+		if err != nil {
+			return err
+		}
+		progress.Step(fmt.Sprintf("%s %s '%s'...", actionVerb(change.Action), k.Name, change.Name))
+		if err := k.remove(c, serviceID, version, change.Name); err != nil {
+			return fmt.Errorf("error deleting %s '%s': %w", k.Name, change.Name, err)
+		}
+	}
+	return nil
+}
+
+// Print displays the plan in a human readable format.
+func (p Plan) Print(out io.Writer) {
+	for _, change := range p.Changes {
+		switch change.Action {
+		case ActionCreate:
+			fmt.Fprintf(out, "+ %s '%s'\n", change.Kind, change.Name)
+			for _, fc := range change.Fields {
+				fmt.Fprintf(out, "    %s: %s\n", fc.Field, display(fc.New))
+			}
+		case ActionUpdate:
+			fmt.Fprintf(out, "~ %s '%s'\n", change.Kind, change.Name)
+			for _, fc := range change.Fields {
+				fmt.Fprintf(out, "    %s: %s => %s\n", fc.Field, display(fc.Old), display(fc.New))
+			}
+		case ActionDelete:
+			fmt.Fprintf(out, "- %s '%s'\n", change.Kind, change.Name)
+			for _, fc := range change.Fields {
+				fmt.Fprintf(out, "    %s: %s\n", fc.Field, display(fc.Old))
+			}
+		}
+	}
+}
+
+// Summary returns a count of each type of change (e.g. "1 to create, 0 to
+// update, 2 to delete").
+func (p Plan) Summary() string {
+	counts := make(map[Action]int)
+	for _, change := range p.Changes {
+		counts[change.Action]++
+	}
+	return fmt.Sprintf("%d to create, %d to update, %d to delete", counts[ActionCreate], counts[ActionUpdate], counts[ActionDelete])
+}
+
+// Versionless reports whether the plan modifies dictionary items or ACL
+// entries, which take effect immediately rather than on activation.
+func (p Plan) Versionless() bool {
+	for _, change := range p.Changes {
+		k, err := LookupKind(change.Kind)
+		if err != nil {
+			continue
+		}
+		for _, fc := range change.Fields {
+			if k.versionless && k.children[fc.Field] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// actionVerb returns the progress message verb for the action.
+func actionVerb(a Action) string {
+	switch a {
+	case ActionCreate:
+		return "Creating"
+	case ActionUpdate:
+		return "Updating"
+	default:
+		return "Deleting"
+	}
+}
+
+// display formats a value for the plan output.
+//
+// Multi-line strings (e.g. VCL content) are summarised, while lists and tables
+// are displayed as compact JSON.
+func display(v any) string {
+	switch t := v.(type) {
+	case nil:
+		return "(unset)"
+	case string:
+		if strings.Contains(t, "\n") {
+			return fmt.Sprintf("(%d lines)", strings.Count(strings.TrimRight(t, "\n"), "\n")+1)
+		}
+		return fmt.Sprintf("%q", t)
+	case []any, map[string]any:
+		data, err := json.Marshal(t)
+		if err != nil {
+			return fmt.Sprint(t)
+		}
+		return string(data)
+	}
+	return fmt.Sprint(v)
+}
+
+// equal reports whether two normalized values are equivalent.
+//
+// A missing value is treated as equal to a zero value, and tables are compared
+// field by field on the same basis.
+func equal(a, b any) bool {
+	a, b = normalize(a), normalize(b)
+	if isZero(a) && isZero(b) {
+		return true
+	}
+	switch x := a.(type) {
+	case map[string]any:
+		y, ok := b.(map[string]any)
+		if !ok {
+			return false
+		}
+		for k, v := range x {
+			if !equal(v, y[k]) {
+				return false
+			}
+		}
+		for k, v := range y {
+			if _, ok := x[k]; !ok && !isZero(v) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		y, ok := b.([]any)
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for i := range x {
+			if !equal(x[i], y[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	return reflect.DeepEqual(a, b)
+}
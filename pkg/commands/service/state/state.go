@@ -0,0 +1,190 @@
+package state
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/fastly/cli/pkg/api"
+	"github.com/pelletier/go-toml"
+)
+
+// Format is the encoding used for a Snapshot document.
+type Format string
+
+// Supported formats.
+const (
+	FormatTOML Format = "toml"
+	FormatJSON Format = "json"
+)
+
+// FormatFromPath infers the document format from the file extension,
+// defaulting to TOML.
+func FormatFromPath(path string) Format {
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		return FormatJSON
+	}
+	return FormatTOML
+}
+
+// Resource is a single service resource (e.g. a backend) keyed by field name.
+type Resource map[string]any
+
+// Name returns the name that identifies the resource within its Kind.
+func (r Resource) Name() string {
+	s, _ := r["name"].(string)
+	return s
+}
+
+// Snapshot is the declarative configuration of a service version.
+type Snapshot struct {
+	ServiceID string
+	Version   int
+	// Resources is keyed by Kind name.
+	Resources map[string][]Resource
+}
+
+// Fetch retrieves the configuration of the given service version.
+//
+// Every settable field is kept, including zero values, so that the Snapshot
+// can be accurately compared against a desired configuration.
+func Fetch(c api.Interface, serviceID string, version int) (*Snapshot, error) {
+	s := &Snapshot{
+		ServiceID: serviceID,
+		Version:   version,
+		Resources: make(map[string][]Resource),
+	}
+	for _, k := range Kinds {
+		rs, err := k.list(c, serviceID, version)
+		if err != nil {
+			return nil, fmt.Errorf("error listing %s resources: %w", k.Name, err)
+		}
+		if len(rs) > 0 {
+			sortResources(rs)
+			s.Resources[k.Name] = rs
+		}
+	}
+	return s, nil
+}
+
+// Parse decodes a Snapshot document.
+//
+// Fields that aren't supported by a resource type are rejected so that typos
+// aren't silently ignored.
+func Parse(data []byte, format Format) (*Snapshot, error) {
+	var doc map[string]any
+	switch format {
+	case FormatJSON:
+		if err := json.Unmarshal(data, &doc); err != nil {
+			return nil, fmt.Errorf("error parsing JSON: %w", err)
+		}
+	default:
+		tree, err := toml.LoadBytes(data)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing TOML: %w", err)
+		}
+		doc = tree.ToMap()
+	}
+
+	s := &Snapshot{Resources: make(map[string][]Resource)}
+	for _, key := range sortedKeys(doc) {
+		value := doc[key]
+		switch key {
+		case "service_id":
+			s.ServiceID, _ = value.(string)
+			continue
+		case "version":
+			if n, ok := normalize(value).(int64); ok {
+				s.Version = int(n)
+			}
+			continue
+		}
+
+		k, err := LookupKind(key)
+		if err != nil {
+			return nil, err
+		}
+		list, ok := normalize(value).([]any)
+		if !ok {
+			return nil, fmt.Errorf("expected '%s' to be a list of tables", key)
+		}
+		seen := make(map[string]bool)
+		for i, item := range list {
+			m, ok := item.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("expected '%s' item %d to be a table", key, i+1)
+			}
+			r := Resource(m)
+			name := r.Name()
+			if name == "" {
+				return nil, fmt.Errorf("missing 'name' field for '%s' item %d", key, i+1)
+			}
+			if seen[name] {
+				return nil, fmt.Errorf("duplicate %s resource '%s'", key, name)
+			}
+			seen[name] = true
+			if k.canonical != nil {
+				k.canonical(r)
+			}
+			for _, field := range sortedKeys(r) {
+				if !k.hasField(field) {
+					return nil, fmt.Errorf("unrecognised field '%s' for %s resource '%s'", field, key, name)
+				}
+			}
+			s.Resources[key] = append(s.Resources[key], r)
+		}
+		sortResources(s.Resources[key])
+	}
+	return s, nil
+}
+
+// Marshal encodes the Snapshot as a document in the given format.
+//
+// Fields with a zero value are omitted to keep the document concise.
+func (s *Snapshot) Marshal(format Format) ([]byte, error) {
+	doc := map[string]any{
+		"service_id": s.ServiceID,
+		"version":    int64(s.Version),
+	}
+	for _, k := range Kinds {
+		rs := s.Resources[k.Name]
+		if len(rs) == 0 {
+			continue
+		}
+		list := make([]any, 0, len(rs))
+		for _, r := range rs {
+			m := make(map[string]any, len(r))
+			for field, value := range r {
+				if !isZero(value) || field == "name" {
+					m[field] = value
+				}
+			}
+			list = append(list, m)
+		}
+		doc[k.Name] = list
+	}
+
+	switch format {
+	case FormatJSON:
+		data, err := json.MarshalIndent(doc, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		return append(data, '\n'), nil
+	default:
+		tree, err := toml.TreeFromMap(doc)
+		if err != nil {
+			return nil, err
+		}
+		return tree.Marshal()
+	}
+}
+
+// sortResources orders the resources by name.
+func sortResources(rs []Resource) {
+	sort.SliceStable(rs, func(i, j int) bool {
+		return rs[i].Name() < rs[j].Name()
+	})
+}
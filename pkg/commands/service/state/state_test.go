@@ -0,0 +1,163 @@
+package state_test
+
+import (
+	"io"
+	"testing"
+
+	"github.com/fastly/cli/pkg/commands/service/state"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v6/fastly"
+)
+
+func TestRoundTrip(t *testing.T) {
+	doc := `service_id = "123"
+version = 2
+
+[[acl]]
+  name = "blocklist"
+
+  [[acl.entries]]
+    ip = "10.0.0.0"
+    subnet = 8
+
+[[dictionary]]
+  name = "config"
+
+  [dictionary.items]
+    foo = "bar"
+`
+	for _, format := range []state.Format{state.FormatTOML, state.FormatJSON} {
+		t.Run(string(format), func(t *testing.T) {
+			s, err := state.Parse([]byte(doc), state.FormatTOML)
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, err := s.Marshal(format)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := state.Parse(data, format)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.ServiceID != "123" || got.Version != 2 {
+				t.Fatalf("unexpected service details: %s %d", got.ServiceID, got.Version)
+			}
+			if plan := state.Compare(s, got); !plan.Empty() {
+				t.Fatalf("unexpected changes after round trip: %+v", plan.Changes)
+			}
+		})
+	}
+}
+
+func TestCompare(t *testing.T) {
+	current, err := state.Parse([]byte(`
+[[acl]]
+  name = "blocklist"
+
+  [[acl.entries]]
+    ip = "10.0.0.0"
+    negated = false
+    subnet = 8
+
+[[dictionary]]
+  name = "config"
+
+  [dictionary.items]
+    foo = "bar"
+`), state.FormatTOML)
+	if err != nil {
+		t.Fatal(err)
+	}
+	desired, err := state.Parse([]byte(`
+[[acl]]
+  name = "blocklist"
+
+  [[acl.entries]]
+    ip = "10.0.0.0"
+    subnet = 8
+
+[[dictionary]]
+  name = "config"
+
+  [dictionary.items]
+    foo = "baz"
+`), state.FormatTOML)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	plan := state.Compare(current, desired)
+	if len(plan.Changes) != 1 {
+		t.Fatalf("want 1 change, have %d: %+v", len(plan.Changes), plan.Changes)
+	}
+	change := plan.Changes[0]
+	if change.Action != state.ActionUpdate || change.Kind != "dictionary" || len(change.Fields) != 1 || change.Fields[0].Field != "items" {
+		t.Fatalf("unexpected change: %+v", change)
+	}
+	if !plan.Versionless() {
+		t.Fatal("want versionless changes")
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, tc := range []struct {
+		doc       string
+		wantError string
+	}{
+		{doc: "[[nope]]\nname = \"x\"", wantError: "unrecognised resource type 'nope'"},
+		{doc: "[[backend]]\naddress = \"x\"", wantError: "missing 'name' field for 'backend' item 1"},
+		{doc: "[[backend]]\nname = \"x\"\n[[backend]]\nname = \"x\"", wantError: "duplicate backend resource 'x'"},
+	} {
+		_, err := state.Parse([]byte(tc.doc), state.FormatTOML)
+		testutil.AssertErrorContains(t, err, tc.wantError)
+	}
+}
+
+func TestDirectorBackends(t *testing.T) {
+	current, err := state.Parse([]byte(`
+[[director]]
+  name = "origin"
+  type = 1
+  backends = ["a", "b"]
+`), state.FormatTOML)
+	if err != nil {
+		t.Fatal(err)
+	}
+	desired, err := state.Parse([]byte(`
+[[director]]
+  name = "origin"
+  type = 1
+  backends = ["c", "a"]
+`), state.FormatTOML)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	plan := state.Compare(current, desired)
+	if len(plan.Changes) != 1 || plan.Changes[0].Fields[0].Field != "backends" {
+		t.Fatalf("unexpected changes: %+v", plan.Changes)
+	}
+	if plan.Versionless() {
+		t.Fatal("director backends are versioned")
+	}
+
+	var added, removed []string
+	api := mock.API{
+		CreateDirectorBackendFn: func(i *fastly.CreateDirectorBackendInput) (*fastly.DirectorBackend, error) {
+			added = append(added, i.Backend)
+			return &fastly.DirectorBackend{}, nil
+		},
+		DeleteDirectorBackendFn: func(i *fastly.DeleteDirectorBackendInput) error {
+			removed = append(removed, i.Backend)
+			return nil
+		},
+	}
+	if err := plan.Apply(api, "123", 2, text.NewQuietProgress(io.Discard)); err != nil {
+		t.Fatal(err)
+	}
+	testutil.AssertEqual(t, []string{"c"}, added)
+	testutil.AssertEqual(t, []string{"b"}, removed)
+}
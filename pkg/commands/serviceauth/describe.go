@@ -1,13 +1,11 @@
 package serviceauth
 
 import (
-	"encoding/json"
 	"fmt"
 	"io"
 
 	"github.com/fastly/cli/pkg/cmd"
 	"github.com/fastly/cli/pkg/config"
-	fsterr "github.com/fastly/cli/pkg/errors"
 	"github.com/fastly/cli/pkg/manifest"
 	"github.com/fastly/cli/pkg/time"
 	"github.com/fastly/go-fastly/v6/fastly"
@@ -18,7 +16,6 @@ type DescribeCommand struct {
 	cmd.Base
 	manifest manifest.Data
 	Input    fastly.GetServiceAuthorizationInput
-	json     bool
 }
 
 // NewDescribeCommand returns a usable command registered under the parent.
@@ -28,19 +25,14 @@ func NewDescribeCommand(parent cmd.Registerer, globals *config.Data, data manife
 	c.manifest = data
 	c.CmdClause = parent.Command("describe", "Show service authorization").Alias("get")
 	c.CmdClause.Flag("id", "ID of the service authorization to retrieve").Required().StringVar(&c.Input.ID)
-	c.RegisterFlagBool(cmd.BoolFlagOpts{
-		Name:        cmd.FlagJSONName,
-		Description: cmd.FlagJSONDesc,
-		Dst:         &c.json,
-		Short:       'j',
-	})
+	c.RegisterOutputFlags()
 	return &c
 }
 
 // Exec invokes the application logic for the command.
 func (c *DescribeCommand) Exec(_ io.Reader, out io.Writer) error {
-	if c.Globals.Verbose() && c.json {
-		return fsterr.ErrInvalidVerboseJSONCombo
+	if err := c.CheckOutputFlags(); err != nil {
+		return err
 	}
 
 	service, err := c.Globals.APIClient.GetServiceAuthorization(&c.Input)
@@ -59,17 +51,8 @@ func (c *DescribeCommand) Exec(_ io.Reader, out io.Writer) error {
 }
 
 func (c *DescribeCommand) print(s *fastly.ServiceAuthorization, out io.Writer) error {
-	if c.json {
-		data, err := json.Marshal(s)
-		if err != nil {
-			return err
-		}
-		_, err = out.Write(data)
-		if err != nil {
-			c.Globals.ErrLog.Add(err)
-			return fmt.Errorf("error: unable to write data to stdout: %w", err)
-		}
-		return nil
+	if ok, err := c.WriteOutput(out, s); ok {
+		return err
 	}
 
 	fmt.Fprintf(out, "Auth ID: %s\n", s.ID)
@@ -19,6 +19,7 @@ type ActivateCommand struct {
 	serviceName    cmd.OptionalServiceNameID
 	serviceVersion cmd.OptionalServiceVersion
 	autoClone      cmd.OptionalAutoClone
+	validate       bool
 }
 
 // NewActivateCommand returns a usable command registered under the parent.
@@ -49,6 +50,7 @@ func NewActivateCommand(parent cmd.Registerer, globals *config.Data, data manife
 		Action: c.autoClone.Set,
 		Dst:    &c.autoClone.Value,
 	})
+	c.CmdClause.Flag("validate", "Validate the service version and refuse to activate it if validation fails").BoolVar(&c.validate)
 	return &c
 }
 
@@ -74,6 +76,16 @@ func (c *ActivateCommand) Exec(_ io.Reader, out io.Writer) error {
 	c.Input.ServiceID = serviceID
 	c.Input.ServiceVersion = serviceVersion.Number
 
+	if c.validate {
+		if err := validateVersion(c.Globals, serviceID, serviceVersion.Number, out); err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]any{
+				"Service ID":      serviceID,
+				"Service Version": serviceVersion.Number,
+			})
+			return err
+		}
+	}
+
 	ver, err := c.Globals.APIClient.ActivateVersion(&c.Input)
 	if err != nil {
 		c.Globals.ErrLog.AddWithContext(err, map[string]any{
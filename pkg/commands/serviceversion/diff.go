@@ -0,0 +1,138 @@
+package serviceversion
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/service/state"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// DiffCommand calls the Fastly API to compare two service versions.
+type DiffCommand struct {
+	cmd.Base
+	manifest    manifest.Data
+	serviceName cmd.OptionalServiceNameID
+	versionA    cmd.OptionalServiceVersion
+	versionB    cmd.OptionalServiceVersion
+}
+
+// NewDiffCommand returns a usable command registered under the parent.
+func NewDiffCommand(parent cmd.Registerer, globals *config.Data, data manifest.Data) *DiffCommand {
+	var c DiffCommand
+	c.Globals = globals
+	c.manifest = data
+	c.CmdClause = parent.Command("diff", "Compare the resources of two Fastly service versions")
+	c.CmdClause.Flag("version-a", "The version to compare from: 'latest', 'active', or the number of a specific version").Default("active").StringVar(&c.versionA.Value)
+	c.CmdClause.Flag("version-b", "The version to compare to: 'latest', 'active', or the number of a specific version").Default("latest").StringVar(&c.versionB.Value)
+	c.RegisterOutputFlags()
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Name:        cmd.FlagServiceIDName,
+		Description: cmd.FlagServiceIDDesc,
+		Dst:         &c.manifest.Flag.ServiceID,
+		Short:       's',
+	})
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Action:      c.serviceName.Set,
+		Name:        cmd.FlagServiceName,
+		Description: cmd.FlagServiceDesc,
+		Dst:         &c.serviceName.Value,
+	})
+	return &c
+}
+
+// Diff is the structured difference between two service versions.
+type Diff struct {
+	ServiceID string         `json:"service_id"`
+	VersionA  int            `json:"version_a"`
+	VersionB  int            `json:"version_b"`
+	Added     []state.Change `json:"added"`
+	Removed   []state.Change `json:"removed"`
+	Changed   []state.Change `json:"changed"`
+}
+
+// Exec invokes the application logic for the command.
+func (c *DiffCommand) Exec(_ io.Reader, out io.Writer) error {
+	if err := c.CheckOutputFlags(); err != nil {
+		return err
+	}
+
+	serviceID, source, flag, err := cmd.ServiceID(c.serviceName, c.manifest, c.Globals.APIClient, c.Globals.ErrLog)
+	if err != nil {
+		return err
+	}
+	if c.Globals.Verbose() {
+		cmd.DisplayServiceID(serviceID, flag, source, out)
+	}
+
+	a, err := c.snapshot(serviceID, c.versionA, "--version-a")
+	if err != nil {
+		return err
+	}
+	b, err := c.snapshot(serviceID, c.versionB, "--version-b")
+	if err != nil {
+		return err
+	}
+
+	plan := state.Compare(a, b)
+
+	diff := Diff{
+		ServiceID: serviceID,
+		VersionA:  a.Version,
+		VersionB:  b.Version,
+		Added:     []state.Change{},
+		Removed:   []state.Change{},
+		Changed:   []state.Change{},
+	}
+	for _, change := range plan.Changes {
+		switch change.Action {
+		case state.ActionCreate:
+			diff.Added = append(diff.Added, change)
+		case state.ActionDelete:
+			diff.Removed = append(diff.Removed, change)
+		case state.ActionUpdate:
+			diff.Changed = append(diff.Changed, change)
+		}
+	}
+
+	if ok, err := c.WriteOutput(out, diff); ok {
+		return err
+	}
+
+	if plan.Empty() {
+		text.Info(out, "No differences between service %s version %d and version %d.", serviceID, a.Version, b.Version)
+		return nil
+	}
+
+	text.Output(out, "Comparing service %s version %d (a) with version %d (b):", serviceID, a.Version, b.Version)
+	text.Break(out)
+	plan.Print(out)
+	text.Break(out)
+	text.Output(out, "%d added, %d changed, %d removed", len(diff.Added), len(diff.Changed), len(diff.Removed))
+	return nil
+}
+
+// snapshot resolves the service version and fetches its resources.
+func (c *DiffCommand) snapshot(serviceID string, sv cmd.OptionalServiceVersion, flag string) (*state.Snapshot, error) {
+	v, err := sv.Parse(serviceID, c.Globals.APIClient)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID": serviceID,
+			"Flag":       flag,
+		})
+		return nil, fmt.Errorf("error resolving %s '%s': %w", flag, sv.Value, err)
+	}
+
+	s, err := state.Fetch(c.Globals.APIClient, serviceID, v.Number)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": v.Number,
+		})
+		return nil, err
+	}
+	return s, nil
+}
@@ -2,6 +2,9 @@ package serviceversion_test
 
 import (
 	"bytes"
+	"errors"
+	"io"
+	"net/http"
 	"strings"
 	"testing"
 
@@ -160,10 +163,12 @@ func TestVersionUpdate(t *testing.T) {
 func TestVersionActivate(t *testing.T) {
 	args := testutil.Args
 	scenarios := []struct {
-		args       []string
-		api        mock.API
-		wantError  string
-		wantOutput string
+		args          []string
+		api           mock.API
+		httpClientRes *http.Response
+		httpClientErr error
+		wantError     string
+		wantOutput    string
 	}{
 		{
 			args:      args("service-version activate --service-id 123"),
@@ -195,6 +200,27 @@ func TestVersionActivate(t *testing.T) {
 			},
 			wantOutput: "Activated service 123 version 3",
 		},
+		{
+			args: args("service-version activate --service-id 123 --version 3 --validate"),
+			api: mock.API{
+				ListVersionsFn:    testutil.ListVersions,
+				ActivateVersionFn: activateVersionOK,
+			},
+			httpClientRes: validateVersionResponse(http.StatusOK, validateVersionOK),
+			wantOutput:    "Activated service 123 version 3",
+		},
+		{
+			args: args("service-version activate --service-id 123 --version 3 --validate"),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ActivateVersionFn: func(i *fastly.ActivateVersionInput) (*fastly.Version, error) {
+					return nil, errors.New("activation should not have been attempted")
+				},
+			},
+			httpClientRes: validateVersionResponse(http.StatusOK, validateVersionInvalid),
+			wantError:     "service 123 version 3 failed validation",
+			wantOutput:    "Backend 'origin' has no host",
+		},
 	}
 	for testcaseIdx := range scenarios {
 		testcase := &scenarios[testcaseIdx]
@@ -202,6 +228,7 @@ func TestVersionActivate(t *testing.T) {
 			var stdout bytes.Buffer
 			opts := testutil.NewRunOpts(testcase.args, &stdout)
 			opts.APIClient = mock.APIClient(testcase.api)
+			opts.HTTPClient = mock.HTMLClient(testcase.httpClientRes, testcase.httpClientErr)
 			err := app.Run(opts)
 			testutil.AssertErrorContains(t, err, testcase.wantError)
 			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
@@ -259,6 +286,66 @@ func TestVersionDeactivate(t *testing.T) {
 	}
 }
 
+func TestVersionValidate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []struct {
+		args          []string
+		api           mock.API
+		httpClientRes *http.Response
+		httpClientErr error
+		wantError     string
+		wantOutput    string
+	}{
+		{
+			args:      args("service-version validate --service-id 123"),
+			wantError: "error parsing arguments: required flag --version not provided",
+		},
+		{
+			args:          args("service-version validate --service-id 123 --version 1"),
+			api:           mock.API{ListVersionsFn: testutil.ListVersions},
+			httpClientErr: testutil.Err,
+			wantError:     testutil.Err.Error(),
+		},
+		{
+			args:          args("service-version validate --service-id 123 --version 1"),
+			api:           mock.API{ListVersionsFn: testutil.ListVersions},
+			httpClientRes: validateVersionResponse(http.StatusNotFound, `{"msg":"Record not found"}`),
+			wantError:     "error validating service version: non-2xx response: 404 Not Found",
+		},
+		{
+			args:          args("service-version validate --service-id 123 --version 1"),
+			api:           mock.API{ListVersionsFn: testutil.ListVersions},
+			httpClientRes: validateVersionResponse(http.StatusOK, validateVersionOK),
+			wantOutput:    "Validated service 123 version 1",
+		},
+		{
+			args:          args("service-version validate --service-id 123 --version 1"),
+			api:           mock.API{ListVersionsFn: testutil.ListVersions},
+			httpClientRes: validateVersionResponse(http.StatusOK, `{"status":"ok","msg":null,"errors":[],"warnings":["Backend 'origin' is not used","Domain 'example.com' has no TLS"]}`),
+			wantOutput:    "Domain 'example.com' has no TLS",
+		},
+		{
+			args:          args("service-version validate --service-id 123 --version 1"),
+			api:           mock.API{ListVersionsFn: testutil.ListVersions},
+			httpClientRes: validateVersionResponse(http.StatusOK, validateVersionInvalid),
+			wantError:     "service 123 version 1 failed validation",
+			wantOutput:    "Condition 'never' is unused",
+		},
+	}
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			opts.HTTPClient = mock.HTMLClient(testcase.httpClientRes, testcase.httpClientErr)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+		})
+	}
+}
+
 func TestVersionLock(t *testing.T) {
 	args := testutil.Args
 	scenarios := []struct {
@@ -388,6 +475,20 @@ func deactivateVersionError(i *fastly.DeactivateVersionInput) (*fastly.Version,
 	return nil, testutil.Err
 }
 
+// validateVersionOK is a response from the version validation endpoint.
+const validateVersionOK = `{"status":"ok","msg":null,"errors":[],"warnings":[]}`
+
+// validateVersionInvalid is a response from the version validation endpoint.
+const validateVersionInvalid = `{"status":"error","msg":"Backend 'origin' has no host","errors":["Backend 'origin' has no host"],"warnings":["Condition 'never' is unused"]}`
+
+func validateVersionResponse(status int, body string) *http.Response {
+	return &http.Response{
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Status:     http.StatusText(status),
+		StatusCode: status,
+	}
+}
+
 func lockVersionOK(i *fastly.LockVersionInput) (*fastly.Version, error) {
 	return &fastly.Version{
 		Number:    i.ServiceVersion,
@@ -403,3 +504,119 @@ func lockVersionOK(i *fastly.LockVersionInput) (*fastly.Version, error) {
 func lockVersionError(i *fastly.LockVersionInput) (*fastly.Version, error) {
 	return nil, testutil.Err
 }
+
+func TestVersionDiff(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name:      "validate missing --service-id flag",
+			Args:      args("service-version diff"),
+			WantError: "error reading service: no service ID found",
+		},
+		{
+			Name: "validate invalid version",
+			API: testutil.EmptyLists(mock.API{
+				ListVersionsFn: testutil.ListVersions,
+			}),
+			Args:      args("service-version diff --service-id 123 --version-b 99"),
+			WantError: "error resolving --version-b '99'",
+		},
+		{
+			Name: "validate no differences",
+			API: testutil.EmptyLists(mock.API{
+				ListVersionsFn: testutil.ListVersions,
+			}),
+			Args:       args("service-version diff --service-id 123"),
+			WantOutput: "No differences between service 123 version 1 and version 3.",
+		},
+		{
+			Name: "validate text output",
+			API: testutil.EmptyLists(mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListBackendsFn: listBackendsByVersion,
+				ListHeadersFn:  listHeadersByVersion,
+			}),
+			Args: args("service-version diff --service-id 123 --version-a active --version-b latest"),
+			WantOutputs: []string{
+				"Comparing service 123 version 1 (a) with version 3 (b):",
+				"~ backend 'origin'",
+				"    port: 80 => 443",
+				"+ header 'cors'",
+				"    dst: \"http.Access-Control-Allow-Origin\"",
+				"- header 'legacy'",
+				"1 added, 1 changed, 1 removed",
+			},
+		},
+		{
+			Name: "validate JSON output",
+			API: testutil.EmptyLists(mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListBackendsFn: listBackendsByVersion,
+				ListHeadersFn:  listHeadersByVersion,
+			}),
+			Args: args("service-version diff --service-id 123 --version-a 1 --version-b 3 --json"),
+			WantOutputs: []string{
+				`"service_id":"123","version_a":1,"version_b":3`,
+				`"changed":[{"action":"update","kind":"backend","name":"origin","fields":[{"field":"port","old":80,"new":443}]}]`,
+				`"removed":[{"action":"delete","kind":"header","name":"legacy"`,
+			},
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+			for _, s := range testcase.WantOutputs {
+				testutil.AssertStringContains(t, stdout.String(), s)
+			}
+		})
+	}
+}
+
+func listBackendsByVersion(i *fastly.ListBackendsInput) ([]*fastly.Backend, error) {
+	port := uint(80)
+	if i.ServiceVersion == 3 {
+		port = 443
+	}
+	return []*fastly.Backend{
+		{
+			ServiceID:      i.ServiceID,
+			ServiceVersion: i.ServiceVersion,
+			Name:           "origin",
+			Address:        "example.com",
+			Port:           port,
+		},
+	}, nil
+}
+
+func listHeadersByVersion(i *fastly.ListHeadersInput) ([]*fastly.Header, error) {
+	if i.ServiceVersion == 3 {
+		return []*fastly.Header{
+			{
+				ServiceID:      i.ServiceID,
+				ServiceVersion: i.ServiceVersion,
+				Name:           "cors",
+				Action:         fastly.HeaderActionSet,
+				Type:           fastly.HeaderTypeResponse,
+				Destination:    "http.Access-Control-Allow-Origin",
+				Source:         `"*"`,
+			},
+		}, nil
+	}
+	return []*fastly.Header{
+		{
+			ServiceID:      i.ServiceID,
+			ServiceVersion: i.ServiceVersion,
+			Name:           "legacy",
+			Action:         fastly.HeaderActionDelete,
+			Type:           fastly.HeaderTypeRequest,
+			Destination:    "http.X-Legacy",
+		},
+	}, nil
+}
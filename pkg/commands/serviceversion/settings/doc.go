@@ -0,0 +1,3 @@
+// Package settings contains commands to inspect and manipulate the general
+// settings of a Fastly service version.
+package settings
@@ -0,0 +1,142 @@
+package settings_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v6/fastly"
+)
+
+func TestSettingsDescribe(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name: "validate GetSettings API error",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetSettingsFn:  getSettingsError,
+			},
+			Args:      args("service-version settings describe --service-id 123 --version 1"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate GetSettings API success",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetSettingsFn:  getSettingsOK,
+			},
+			Args: args("service-version settings describe --service-id 123 --version 1"),
+			WantOutput: "\n" + strings.Join([]string{
+				"Service ID: 123",
+				"Version: 1",
+				"Default TTL: 3600",
+				"Default host: www.example.com",
+				"Stale if error: true",
+				"Stale if error TTL: 43200",
+			}, "\n") + "\n",
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestSettingsUpdate(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name: "validate UpdateSettings API error",
+			API: mock.API{
+				ListVersionsFn:   testutil.ListVersions,
+				CloneVersionFn:   testutil.CloneVersionResult(4),
+				UpdateSettingsFn: updateSettingsError,
+			},
+			Args:      args("service-version settings update --service-id 123 --version 1 --default-ttl 60 --autoclone"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate UpdateSettings API success",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				UpdateSettingsFn: func(i *fastly.UpdateSettingsInput) (*fastly.Settings, error) {
+					if i.DefaultTTL != 60 || *i.DefaultHost != "www.example.com" || i.StaleIfError != nil {
+						return nil, errors.New("unexpected input")
+					}
+					return updateSettingsOK(i)
+				},
+			},
+			Args:       args("service-version settings update --service-id 123 --version 1 --default-ttl 60 --default-host www.example.com --autoclone"),
+			WantOutput: "Updated settings (service 123 version 4)",
+		},
+		{
+			Name: "validate current default TTL is preserved when --default-ttl is omitted",
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				GetSettingsFn:  getSettingsOK,
+				UpdateSettingsFn: func(i *fastly.UpdateSettingsInput) (*fastly.Settings, error) {
+					if i.DefaultTTL != 3600 || !*i.StaleIfError {
+						return nil, errors.New("unexpected input")
+					}
+					return updateSettingsOK(i)
+				},
+			},
+			Args:       args("service-version settings update --service-id 123 --version 1 --stale-if-error --autoclone"),
+			WantOutput: "Updated settings (service 123 version 4)",
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func getSettingsOK(i *fastly.GetSettingsInput) (*fastly.Settings, error) {
+	return &fastly.Settings{
+		ServiceID:       i.ServiceID,
+		ServiceVersion:  i.ServiceVersion,
+		DefaultTTL:      3600,
+		DefaultHost:     "www.example.com",
+		StaleIfError:    true,
+		StaleIfErrorTTL: 43200,
+	}, nil
+}
+
+func getSettingsError(i *fastly.GetSettingsInput) (*fastly.Settings, error) {
+	return nil, testutil.Err
+}
+
+func updateSettingsOK(i *fastly.UpdateSettingsInput) (*fastly.Settings, error) {
+	return &fastly.Settings{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		DefaultTTL:     i.DefaultTTL,
+	}, nil
+}
+
+func updateSettingsError(i *fastly.UpdateSettingsInput) (*fastly.Settings, error) {
+	return nil, testutil.Err
+}
@@ -0,0 +1,141 @@
+package serviceversion
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/fastly/cli/pkg/api/undocumented"
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// ValidateCommand calls the Fastly API to validate a service version.
+type ValidateCommand struct {
+	cmd.Base
+	manifest       manifest.Data
+	serviceName    cmd.OptionalServiceNameID
+	serviceVersion cmd.OptionalServiceVersion
+}
+
+// NewValidateCommand returns a usable command registered under the parent.
+func NewValidateCommand(parent cmd.Registerer, globals *config.Data, data manifest.Data) *ValidateCommand {
+	var c ValidateCommand
+	c.Globals = globals
+	c.manifest = data
+	c.CmdClause = parent.Command("validate", "Validate a Fastly service version")
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Name:        cmd.FlagServiceIDName,
+		Description: cmd.FlagServiceIDDesc,
+		Dst:         &c.manifest.Flag.ServiceID,
+		Short:       's',
+	})
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Action:      c.serviceName.Set,
+		Name:        cmd.FlagServiceName,
+		Description: cmd.FlagServiceDesc,
+		Dst:         &c.serviceName.Value,
+	})
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Name:        cmd.FlagVersionName,
+		Description: cmd.FlagVersionDesc,
+		Dst:         &c.serviceVersion.Value,
+		Required:    true,
+	})
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *ValidateCommand) Exec(_ io.Reader, out io.Writer) error {
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AllowActiveLocked:  true,
+		APIClient:          c.Globals.APIClient,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceNameFlag:    c.serviceName,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	if err := validateVersion(c.Globals, serviceID, serviceVersion.Number, out); err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	text.Success(out, "Validated service %s version %d", serviceID, serviceVersion.Number)
+	return nil
+}
+
+// versionValidation models the response of the version validation endpoint.
+type versionValidation struct {
+	Status   string   `json:"status"`
+	Msg      string   `json:"msg"`
+	Errors   []string `json:"errors"`
+	Warnings []string `json:"warnings"`
+}
+
+// validateVersion runs the server-side validation of the given service version.
+// Any errors or warnings reported by the API are printed to out, and a
+// non-nil error is returned if the version failed validation.
+//
+// NOTE: The endpoint is called directly, as go-fastly's ValidateVersion only
+// returns the `msg` field of the response.
+func validateVersion(globals *config.Data, serviceID string, serviceVersion int, out io.Writer) error {
+	token, _ := globals.Token()
+	endpoint, _ := globals.Endpoint()
+	path := fmt.Sprintf(undocumented.ServiceVersionValidate, serviceID, serviceVersion)
+
+	data, err := undocumented.Call(http.MethodGet, endpoint, path, token, globals.HTTPClient)
+	if err != nil {
+		if apiErr, ok := err.(undocumented.APIError); ok && apiErr.StatusCode != 0 {
+			return fmt.Errorf("error validating service version: %w: %d %s", err, apiErr.StatusCode, http.StatusText(apiErr.StatusCode))
+		}
+		return fmt.Errorf("error validating service version: %w", err)
+	}
+
+	var v versionValidation
+	if err := json.Unmarshal(data, &v); err != nil {
+		return fmt.Errorf("error parsing service version validation: %w", err)
+	}
+
+	for _, e := range v.Errors {
+		text.Error(out, e)
+	}
+	for _, w := range v.Warnings {
+		text.Warning(out, w)
+	}
+
+	valid := v.Status == "ok"
+
+	// NOTE: The msg summarises the errors, so it's only printed when there are
+	// no errors to print instead.
+	if v.Msg != "" && len(v.Errors) == 0 {
+		if valid {
+			text.Warning(out, v.Msg)
+		} else {
+			text.Error(out, v.Msg)
+		}
+	}
+
+	if !valid {
+		return errors.RemediationError{
+			Inner:       fmt.Errorf("service %s version %d failed validation", serviceID, serviceVersion),
+			Remediation: "Fix the reported problems and run the command again.",
+		}
+	}
+	return nil
+}
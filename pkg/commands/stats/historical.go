@@ -8,6 +8,7 @@ import (
 	"github.com/fastly/cli/pkg/cmd"
 	"github.com/fastly/cli/pkg/config"
 	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/text"
 	"github.com/fastly/go-fastly/v6/fastly"
 )
 
@@ -19,7 +20,6 @@ type HistoricalCommand struct {
 	manifest manifest.Data
 
 	Input       fastly.GetStatsInput
-	formatFlag  string
 	serviceName cmd.OptionalServiceNameID
 }
 
@@ -48,13 +48,17 @@ func NewHistoricalCommand(parent cmd.Registerer, globals *config.Data, data mani
 	c.CmdClause.Flag("by", "Aggregation period (minute/hour/day)").EnumVar(&c.Input.By, "minute", "hour", "day")
 	c.CmdClause.Flag("region", "Filter by region ('stats regions' to list)").StringVar(&c.Input.Region)
 
-	c.CmdClause.Flag("format", "Output format (json)").EnumVar(&c.formatFlag, "json")
+	c.RegisterOutputFlags()
 
 	return &c
 }
 
 // Exec implements the command interface.
 func (c *HistoricalCommand) Exec(_ io.Reader, out io.Writer) error {
+	if err := c.CheckOutputFlags(); err != nil {
+		return err
+	}
+
 	serviceID, source, flag, err := cmd.ServiceID(c.serviceName, c.manifest, c.Globals.APIClient, c.Globals.ErrLog)
 	if err != nil {
 		return err
@@ -78,8 +82,8 @@ func (c *HistoricalCommand) Exec(_ io.Reader, out io.Writer) error {
 		return fmt.Errorf("non-success response: %s", envelope.Msg)
 	}
 
-	switch c.formatFlag {
-	case "json":
+	switch c.OutputFormat() {
+	case text.FormatJSON:
 		err := writeBlocksJSON(out, serviceID, envelope.Data)
 		if err != nil {
 			c.Globals.ErrLog.AddWithContext(err, map[string]any{
@@ -87,7 +91,7 @@ func (c *HistoricalCommand) Exec(_ io.Reader, out io.Writer) error {
 			})
 		}
 
-	default:
+	case text.FormatText:
 		writeHeader(out, envelope.Meta)
 		err := writeBlocks(out, serviceID, envelope.Data)
 		if err != nil {
@@ -95,6 +99,11 @@ func (c *HistoricalCommand) Exec(_ io.Reader, out io.Writer) error {
 				"Service ID": serviceID,
 			})
 		}
+
+	default:
+		if _, err := c.WriteOutput(out, envelope.Data); err != nil {
+			return err
+		}
 	}
 
 	return nil
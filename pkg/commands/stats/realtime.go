@@ -18,7 +18,6 @@ type RealtimeCommand struct {
 	cmd.Base
 	manifest manifest.Data
 
-	formatFlag  string
 	serviceName cmd.OptionalServiceNameID
 }
 
@@ -42,13 +41,17 @@ func NewRealtimeCommand(parent cmd.Registerer, globals *config.Data, data manife
 		Dst:         &c.serviceName.Value,
 	})
 
-	c.CmdClause.Flag("format", "Output format (json)").EnumVar(&c.formatFlag, "json")
+	c.RegisterOutputFlags()
 
 	return &c
 }
 
 // Exec implements the command interface.
 func (c *RealtimeCommand) Exec(_ io.Reader, out io.Writer) error {
+	if err := c.CheckOutputFlags(); err != nil {
+		return err
+	}
+
 	serviceID, source, flag, err := cmd.ServiceID(c.serviceName, c.manifest, c.Globals.APIClient, c.Globals.ErrLog)
 	if err != nil {
 		return err
@@ -57,8 +60,8 @@ func (c *RealtimeCommand) Exec(_ io.Reader, out io.Writer) error {
 		cmd.DisplayServiceID(serviceID, flag, source, out)
 	}
 
-	switch c.formatFlag {
-	case "json":
+	switch c.OutputFormat() {
+	case text.FormatJSON:
 		if err := loopJSON(c.Globals.RTSClient, serviceID, out); err != nil {
 			c.Globals.ErrLog.AddWithContext(err, map[string]any{
 				"Service ID": serviceID,
@@ -66,13 +69,21 @@ func (c *RealtimeCommand) Exec(_ io.Reader, out io.Writer) error {
 			return err
 		}
 
-	default:
+	case text.FormatText:
 		if err := loopText(c.Globals.RTSClient, serviceID, out); err != nil {
 			c.Globals.ErrLog.AddWithContext(err, map[string]any{
 				"Service ID": serviceID,
 			})
 			return err
 		}
+
+	default:
+		if err := c.loopFormat(c.Globals.RTSClient, serviceID, out); err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]any{
+				"Service ID": serviceID,
+			})
+			return err
+		}
 	}
 
 	return nil
@@ -137,3 +148,26 @@ func loopText(client api.RealtimeStatsInterface, service string, out io.Writer)
 		}
 	}
 }
+
+// loopFormat renders each batch of realtime stats using the structured output
+// format requested by the user.
+func (c *RealtimeCommand) loopFormat(client api.RealtimeStatsInterface, service string, out io.Writer) error {
+	var timestamp uint64
+	for {
+		var envelope realtimeResponse
+
+		err := client.GetRealtimeStatsJSON(&fastly.GetRealtimeStatsInput{
+			ServiceID: service,
+			Timestamp: timestamp,
+		}, &envelope)
+		if err != nil {
+			text.Error(out, "fetching stats: %w", err)
+			continue
+		}
+		timestamp = envelope.Timestamp
+
+		if _, err := c.WriteOutput(out, envelope.Data); err != nil {
+			return err
+		}
+	}
+}
@@ -19,17 +19,26 @@ func NewRegionsCommand(parent cmd.Registerer, globals *config.Data) *RegionsComm
 	var c RegionsCommand
 	c.Globals = globals
 	c.CmdClause = parent.Command("regions", "List stats regions")
+	c.RegisterOutputFlags()
 	return &c
 }
 
 // Exec implements the command interface.
 func (c *RegionsCommand) Exec(_ io.Reader, out io.Writer) error {
+	if err := c.CheckOutputFlags(); err != nil {
+		return err
+	}
+
 	resp, err := c.Globals.APIClient.GetRegions()
 	if err != nil {
 		c.Globals.ErrLog.Add(err)
 		return fmt.Errorf("fetching regions: %w", err)
 	}
 
+	if ok, err := c.WriteOutput(out, resp.Data); ok {
+		return err
+	}
+
 	for _, region := range resp.Data {
 		text.Output(out, "%s", region)
 	}
@@ -25,6 +25,11 @@ func TestRegions(t *testing.T) {
 			api:        mock.API{GetRegionsFn: getRegionsOK},
 			wantOutput: "foo\nbar\nbaz\n",
 		},
+		{
+			args:       args("stats regions --format yaml"),
+			api:        mock.API{GetRegionsFn: getRegionsOK},
+			wantOutput: "- foo\n- bar\n- baz\n",
+		},
 		{
 			args:      args("stats regions"),
 			api:       mock.API{GetRegionsFn: getRegionsError},
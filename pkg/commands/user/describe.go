@@ -19,12 +19,7 @@ func NewDescribeCommand(parent cmd.Registerer, globals *config.Data, data manife
 	c.manifest = data
 	c.CmdClause.Flag("current", "Get the logged in user").BoolVar(&c.current)
 	c.CmdClause.Flag("id", "Alphanumeric string identifying the user").StringVar(&c.id)
-	c.RegisterFlagBool(cmd.BoolFlagOpts{
-		Name:        cmd.FlagJSONName,
-		Description: cmd.FlagJSONDesc,
-		Dst:         &c.json,
-		Short:       'j',
-	})
+	c.RegisterOutputFlags()
 	return &c
 }
 
@@ -34,12 +29,15 @@ type DescribeCommand struct {
 
 	current  bool
 	id       string
-	json     bool
 	manifest manifest.Data
 }
 
 // Exec invokes the application logic for the command.
 func (c *DescribeCommand) Exec(_ io.Reader, out io.Writer) error {
+	if err := c.CheckOutputFlags(); err != nil {
+		return err
+	}
+
 	_, s := c.Globals.Token()
 	if s == config.SourceUndefined {
 		return errors.ErrNoToken
@@ -52,6 +50,9 @@ func (c *DescribeCommand) Exec(_ io.Reader, out io.Writer) error {
 			return err
 		}
 
+		if ok, err := c.WriteOutput(out, r); ok {
+			return err
+		}
 		c.print(out, r)
 		return nil
 	}
@@ -67,6 +68,9 @@ func (c *DescribeCommand) Exec(_ io.Reader, out io.Writer) error {
 		return err
 	}
 
+	if ok, err := c.WriteOutput(out, r); ok {
+		return err
+	}
 	c.print(out, r)
 	return nil
 }
@@ -1,7 +1,6 @@
 package user
 
 import (
-	"encoding/json"
 	"fmt"
 	"io"
 
@@ -25,12 +24,7 @@ func NewListCommand(parent cmd.Registerer, globals *config.Data, data manifest.D
 		Dst:         &c.customerID.Value,
 		Action:      c.customerID.Set,
 	})
-	c.RegisterFlagBool(cmd.BoolFlagOpts{
-		Name:        cmd.FlagJSONName,
-		Description: cmd.FlagJSONDesc,
-		Dst:         &c.json,
-		Short:       'j',
-	})
+	c.RegisterOutputFlags()
 	return &c
 }
 
@@ -39,7 +33,6 @@ type ListCommand struct {
 	cmd.Base
 
 	customerID cmd.OptionalCustomerID
-	json       bool
 	manifest   manifest.Data
 }
 
@@ -49,8 +42,8 @@ func (c *ListCommand) Exec(_ io.Reader, out io.Writer) error {
 	if s == config.SourceUndefined {
 		return fsterr.ErrNoToken
 	}
-	if c.Globals.Verbose() && c.json {
-		return fsterr.ErrInvalidVerboseJSONCombo
+	if err := c.CheckOutputFlags(); err != nil {
+		return err
 	}
 	if err := c.customerID.Parse(); err != nil {
 		return err
@@ -117,17 +110,8 @@ func (c *ListCommand) printVerbose(out io.Writer, us []*fastly.User) {
 // printSummary displays the information returned from the API in a summarised
 // format.
 func (c *ListCommand) printSummary(out io.Writer, us []*fastly.User) error {
-	if c.json {
-		data, err := json.Marshal(us)
-		if err != nil {
-			return err
-		}
-		_, err = out.Write(data)
-		if err != nil {
-			c.Globals.ErrLog.Add(err)
-			return fmt.Errorf("error: unable to write data to stdout: %w", err)
-		}
-		return nil
+	if ok, err := c.WriteOutput(out, us); ok {
+		return err
 	}
 	t := text.NewTable(out)
 	t.AddHeader("LOGIN", "NAME", "ROLE", "LOCKED", "ID")
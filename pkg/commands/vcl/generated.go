@@ -0,0 +1,113 @@
+package vcl
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	fsterr "github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/manifest"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v6/fastly"
+)
+
+// NewGeneratedCommand returns a usable command registered under the parent.
+func NewGeneratedCommand(parent cmd.Registerer, globals *config.Data, data manifest.Data) *GeneratedCommand {
+	var c GeneratedCommand
+	c.CmdClause = parent.Command("generated", "Download the VCL generated by Fastly for a service version")
+	c.Globals = globals
+	c.manifest = data
+
+	// Required flags
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Name:        cmd.FlagVersionName,
+		Description: cmd.FlagVersionDesc,
+		Dst:         &c.serviceVersion.Value,
+		Required:    true,
+	})
+
+	// Optional flags
+	c.CmdClause.Flag("file", "Path to write the generated VCL to (defaults to stdout)").Short('f').StringVar(&c.file)
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Name:        cmd.FlagServiceIDName,
+		Description: cmd.FlagServiceIDDesc,
+		Dst:         &c.manifest.Flag.ServiceID,
+		Short:       's',
+	})
+	c.RegisterFlag(cmd.StringFlagOpts{
+		Action:      c.serviceName.Set,
+		Name:        cmd.FlagServiceName,
+		Description: cmd.FlagServiceDesc,
+		Dst:         &c.serviceName.Value,
+	})
+
+	return &c
+}
+
+// GeneratedCommand calls the Fastly API to retrieve the generated VCL of a
+// service version.
+type GeneratedCommand struct {
+	cmd.Base
+
+	file           string
+	manifest       manifest.Data
+	serviceName    cmd.OptionalServiceNameID
+	serviceVersion cmd.OptionalServiceVersion
+}
+
+// Exec invokes the application logic for the command.
+func (c *GeneratedCommand) Exec(_ io.Reader, out io.Writer) error {
+	if c.Globals.Verbose() && c.file == "" {
+		return fsterr.RemediationError{
+			Inner:       fmt.Errorf("--verbose cannot be used when writing the generated VCL to stdout"),
+			Remediation: "Use --file to write the generated VCL to disk, or remove --verbose.",
+		}
+	}
+
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AllowActiveLocked:  true,
+		APIClient:          c.Globals.APIClient,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceNameFlag:    c.serviceName,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": fsterr.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	vcl, err := c.Globals.APIClient.GetGeneratedVCL(&fastly.GetGeneratedVCLInput{
+		ServiceID:      serviceID,
+		ServiceVersion: serviceVersion.Number,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]any{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	if c.file == "" {
+		_, err = io.WriteString(out, vcl.Content)
+		if err != nil {
+			c.Globals.ErrLog.Add(err)
+			return fmt.Errorf("error: unable to write data to stdout: %w", err)
+		}
+		return nil
+	}
+
+	if err := os.WriteFile(c.file, []byte(vcl.Content), 0o600); err != nil {
+		c.Globals.ErrLog.Add(err)
+		return fmt.Errorf("error writing file '%s': %w", c.file, err)
+	}
+	text.Success(out, "Wrote generated VCL for service %s version %d to %s", serviceID, serviceVersion.Number, c.file)
+	return nil
+}
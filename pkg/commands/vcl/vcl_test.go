@@ -0,0 +1,89 @@
+package vcl_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v6/fastly"
+)
+
+func TestVCLGenerated(t *testing.T) {
+	args := testutil.Args
+	file := filepath.Join(t.TempDir(), "main.vcl")
+	scenarios := []testutil.TestScenario{
+		{
+			Name:      "validate missing --version flag",
+			Args:      args("vcl generated --service-id 123"),
+			WantError: "error parsing arguments: required flag --version not provided",
+		},
+		{
+			Name: "validate GetGeneratedVCL API error",
+			API: mock.API{
+				ListVersionsFn:    testutil.ListVersions,
+				GetGeneratedVCLFn: getGeneratedVCLError,
+			},
+			Args:      args("vcl generated --service-id 123 --version 1"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name:      "validate --verbose requires --file",
+			Args:      args("vcl generated --service-id 123 --version 1 --verbose"),
+			WantError: "--verbose cannot be used when writing the generated VCL to stdout",
+		},
+		{
+			Name: "validate generated VCL is written to stdout",
+			API: mock.API{
+				ListVersionsFn:    testutil.ListVersions,
+				GetGeneratedVCLFn: getGeneratedVCLOK,
+			},
+			Args:       args("vcl generated --service-id 123 --version 1"),
+			WantOutput: generatedVCL,
+		},
+		{
+			Name: "validate generated VCL is written to --file",
+			API: mock.API{
+				ListVersionsFn:    testutil.ListVersions,
+				GetGeneratedVCLFn: getGeneratedVCLOK,
+			},
+			Args:       args("vcl generated --service-id 123 --version 1 --file " + file),
+			WantOutput: "Wrote generated VCL for service 123 version 1 to " + file,
+		},
+	}
+
+	for testcaseIdx := range scenarios {
+		testcase := &scenarios[testcaseIdx]
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+
+	data, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testutil.AssertString(t, generatedVCL, string(data))
+}
+
+var generatedVCL = "sub vcl_recv {\n  return(lookup);\n}\n"
+
+func getGeneratedVCLOK(i *fastly.GetGeneratedVCLInput) (*fastly.VCL, error) {
+	return &fastly.VCL{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Content:        generatedVCL,
+	}, nil
+}
+
+func getGeneratedVCLError(i *fastly.GetGeneratedVCLInput) (*fastly.VCL, error) {
+	return nil, testutil.Err
+}
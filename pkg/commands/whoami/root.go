@@ -25,11 +25,16 @@ func NewRootCommand(parent cmd.Registerer, globals *config.Data) *RootCommand {
 	var c RootCommand
 	c.Globals = globals
 	c.CmdClause = parent.Command("whoami", "Get information about the currently authenticated account")
+	c.RegisterOutputFlags()
 	return &c
 }
 
 // Exec implements the command interface.
 func (c *RootCommand) Exec(_ io.Reader, out io.Writer) error {
+	if err := c.CheckOutputFlags(); err != nil {
+		return err
+	}
+
 	endpoint, _ := c.Globals.Endpoint()
 	fullurl := fmt.Sprintf("%s/verify", strings.TrimSuffix(endpoint, "/"))
 	req, err := http.NewRequest("GET", fullurl, nil)
@@ -62,6 +67,10 @@ func (c *RootCommand) Exec(_ io.Reader, out io.Writer) error {
 		return fmt.Errorf("error decoding API response: %w", err)
 	}
 
+	if ok, err := c.WriteOutput(out, response); ok {
+		return err
+	}
+
 	if !c.Globals.Verbose() {
 		fmt.Fprintf(out, "%s <%s>\n", response.User.Name, response.User.Login)
 		return nil
@@ -278,6 +278,12 @@ type File struct {
 	StarterKits   StarterKitLanguages `toml:"starter-kits"`
 	Viceroy       Viceroy             `toml:"viceroy"`
 
+	// Toolchains declares custom languages available to every project, keyed by
+	// the fastly.toml `language` value.
+	//
+	// NOTE: A project's fastly.toml [toolchains] take precedence.
+	Toolchains map[string]manifest.Toolchain `toml:"toolchains,omitempty"`
+
 	// We store off a possible legacy configuration so that we can later extract
 	// the relevant email and token values that may pre-exist.
 	//
@@ -113,3 +113,10 @@ var ErrInvalidVerboseJSONCombo = RemediationError{
 	Inner:       fmt.Errorf("invalid flag combination, --verbose and --json"),
 	Remediation: "Use either --verbose or --json, not both.",
 }
+
+// ErrInvalidVerboseFormatCombo means the user provided both a --verbose and
+// a structured --format flag which are mutally exclusive behaviours.
+var ErrInvalidVerboseFormatCombo = RemediationError{
+	Inner:       fmt.Errorf("invalid flag combination, --verbose and --format"),
+	Remediation: "Use either --verbose or --format, not both.",
+}
@@ -194,8 +194,15 @@ type File struct {
 	ManifestVersion Version     `toml:"manifest_version"`
 	Name            string      `toml:"name"`
 	Scripts         Scripts     `toml:"scripts,omitempty"`
-	ServiceID       string      `toml:"service_id"`
-	Setup           Setup       `toml:"setup,omitempty"`
+	// SDKVersion is the version of the language SDK the package was compiled
+	// with, recorded in the packaged fastly.toml by `compute build` and used to
+	// check the Wasm binary's imports against the host functions available to
+	// that SDK release.
+	SDKVersion string `toml:"sdk_version,omitempty"`
+	ServiceID  string `toml:"service_id"`
+	Setup      Setup  `toml:"setup,omitempty"`
+	// Toolchains declares custom languages, keyed by the `language` value.
+	Toolchains map[string]Toolchain `toml:"toolchains,omitempty"`
 
 	errLog    fsterr.LogInterface
 	exists    bool
@@ -209,19 +216,104 @@ type Scripts struct {
 	PostBuild string `toml:"post_build,omitempty"`
 }
 
+// Toolchain describes the toolchain of a custom language (e.g. C via wasi-sdk,
+// or Zig), so that languages without official support can be verified before
+// they're built, in the same way as the supported languages.
+//
+// Only the toolchain is required. The manifest, SDK and compilation checks are
+// skipped when they aren't declared, as is a version check that has no command
+// or constraint.
+type Toolchain struct {
+	// Toolchain is the executable responsible for building the project
+	// (e.g. zig, clang).
+	Toolchain string `toml:"toolchain"`
+	// ToolchainURL is the toolchain's homepage, displayed in remediations.
+	ToolchainURL string `toml:"toolchain_url,omitempty"`
+	// ToolchainVersionCommand returns the toolchain version (e.g. zig version).
+	ToolchainVersionCommand string `toml:"toolchain_version_command,omitempty"`
+	// ToolchainVersionPattern is a regular expression whose first capture group
+	// matches the version within the output of the ToolchainVersionCommand.
+	ToolchainVersionPattern string `toml:"toolchain_version_pattern,omitempty"`
+	// ToolchainConstraint is the supported toolchain version (e.g. >= 0.10.0).
+	ToolchainConstraint string `toml:"toolchain_constraint,omitempty"`
+	// ToolchainRemediation is a shell command that installs the toolchain.
+	ToolchainRemediation string `toml:"toolchain_remediation,omitempty"`
+
+	// Compilation is the compilation target (e.g. wasm32-wasi) or, when not
+	// integrated with the toolchain, the compiler executable.
+	Compilation string `toml:"compilation,omitempty"`
+	// CompilationIntegrated indicates the compilation target is provided by the
+	// toolchain rather than a separate executable.
+	CompilationIntegrated bool `toml:"compilation_integrated,omitempty"`
+	// CompilationCommand returns the compiler version or, when integrated, the
+	// installed compilation targets (e.g. zig targets).
+	CompilationCommand string `toml:"compilation_command,omitempty"`
+	// CompilationPattern is a regular expression whose first capture group
+	// matches the compiler version, or the target when integrated, within the
+	// output of the CompilationCommand.
+	CompilationPattern string `toml:"compilation_pattern,omitempty"`
+	// CompilationConstraint is the supported compiler version.
+	CompilationConstraint string `toml:"compilation_constraint,omitempty"`
+	// CompilationURL is the compiler's homepage, displayed in remediations.
+	CompilationURL string `toml:"compilation_url,omitempty"`
+	// CompilationRemediation is a shell command that installs the compiler.
+	CompilationRemediation string `toml:"compilation_remediation,omitempty"`
+
+	// Manifest is the language's project manifest (e.g. build.zig, Makefile).
+	Manifest string `toml:"manifest,omitempty"`
+	// ManifestRemediation is a shell command that creates the manifest.
+	ManifestRemediation string `toml:"manifest_remediation,omitempty"`
+	// SDK is the Compute@Edge SDK the manifest must reference.
+	SDK string `toml:"sdk,omitempty"`
+	// Installer is a shell command that installs the project dependencies.
+	Installer string `toml:"installer,omitempty"`
+
+	// Build is the build command used when fastly.toml has no [scripts.build].
+	Build string `toml:"build,omitempty"`
+	// SourceDirectory is the source code directory (e.g. src).
+	SourceDirectory string `toml:"source_directory,omitempty"`
+	// IncludeFiles are additional files to include in the package.
+	IncludeFiles []string `toml:"include_files,omitempty"`
+}
+
 // Setup represents a set of service configuration that works with the code in
 // the package. See https://developer.fastly.com/reference/fastly-toml/.
 type Setup struct {
-	Backends     map[string]*SetupBackend    `toml:"backends,omitempty"`
-	Dictionaries map[string]*SetupDictionary `toml:"dictionaries,omitempty"`
-	Loggers      map[string]*SetupLogger     `toml:"log_endpoints,omitempty"`
+	ACLs         map[string]*SetupACL         `toml:"acls,omitempty"`
+	Backends     map[string]*SetupBackend     `toml:"backends,omitempty"`
+	Dictionaries map[string]*SetupDictionary  `toml:"dictionaries,omitempty"`
+	Healthchecks map[string]*SetupHealthcheck `toml:"healthchecks,omitempty"`
+	Loggers      map[string]*SetupLogger      `toml:"log_endpoints,omitempty"`
+	ObjectStores map[string]*SetupObjectStore `toml:"object_stores,omitempty"`
+}
+
+// SetupACL represents a '[setup.acls.<T>]' instance.
+type SetupACL struct {
+	Entries     []SetupACLEntry `toml:"entries,omitempty"`
+	Description string          `toml:"description,omitempty"`
+}
+
+// SetupACLEntry represents a '[[setup.acls.<T>.entries]]' instance.
+type SetupACLEntry struct {
+	IP      string `toml:"ip"`
+	Subnet  int    `toml:"subnet,omitempty"`
+	Negated bool   `toml:"negated,omitempty"`
+	Comment string `toml:"comment,omitempty"`
 }
 
 // SetupBackend represents a '[setup.backends.<T>]' instance.
 type SetupBackend struct {
-	Address     string `toml:"address,omitempty"`
-	Port        uint   `toml:"port,omitempty"`
-	Description string `toml:"description,omitempty"`
+	Address          string `toml:"address,omitempty"`
+	Port             uint   `toml:"port,omitempty"`
+	Description      string `toml:"description,omitempty"`
+	UseSSL           *bool  `toml:"use_ssl,omitempty"`
+	SSLCertHostname  string `toml:"ssl_cert_hostname,omitempty"`
+	SSLSNIHostname   string `toml:"ssl_sni_hostname,omitempty"`
+	OverrideHost     string `toml:"override_host,omitempty"`
+	ConnectTimeout   uint   `toml:"connect_timeout,omitempty"`
+	FirstByteTimeout uint   `toml:"first_byte_timeout,omitempty"`
+	Shield           string `toml:"shield,omitempty"`
+	Healthcheck      string `toml:"healthcheck,omitempty"`
 }
 
 // SetupDictionary represents a '[setup.dictionaries.<T>]' instance.
@@ -236,24 +328,116 @@ type SetupDictionaryItems struct {
 	Description string `toml:"description,omitempty"`
 }
 
+// SetupHealthcheck represents a '[setup.healthchecks.<T>]' instance.
+type SetupHealthcheck struct {
+	Host             string `toml:"host,omitempty"`
+	Path             string `toml:"path,omitempty"`
+	Method           string `toml:"method,omitempty"`
+	HTTPVersion      string `toml:"http_version,omitempty"`
+	ExpectedResponse uint   `toml:"expected_response,omitempty"`
+	CheckInterval    uint   `toml:"check_interval,omitempty"`
+	Timeout          uint   `toml:"timeout,omitempty"`
+	Window           uint   `toml:"window,omitempty"`
+	Threshold        uint   `toml:"threshold,omitempty"`
+	Initial          uint   `toml:"initial,omitempty"`
+	Description      string `toml:"description,omitempty"`
+}
+
 // SetupLogger represents a '[setup.log_endpoints.<T>]' instance.
+//
+// NOTE: The provider specific settings are only used by the providers that
+// compute deploy is able to create (s3, https, splunk, datadog and kafka).
+// Secrets (e.g. secret_key, token, password) are prompted for when omitted.
 type SetupLogger struct {
-	Provider string `toml:"provider,omitempty"`
+	Provider    string `toml:"provider,omitempty"`
+	Description string `toml:"description,omitempty"`
+
+	// Common
+	CompressionCodec  string `toml:"compression_codec,omitempty"`
+	Format            string `toml:"format,omitempty"`
+	FormatVersion     uint   `toml:"format_version,omitempty"`
+	GzipLevel         uint8  `toml:"gzip_level,omitempty"`
+	MessageType       string `toml:"message_type,omitempty"`
+	Path              string `toml:"path,omitempty"`
+	Period            uint   `toml:"period,omitempty"`
+	Placement         string `toml:"placement,omitempty"`
+	ResponseCondition string `toml:"response_condition,omitempty"`
+	TimestampFormat   string `toml:"timestamp_format,omitempty"`
+	TLSCACert         string `toml:"tls_ca_cert,omitempty"`
+	TLSClientCert     string `toml:"tls_client_cert,omitempty"`
+	TLSClientKey      string `toml:"tls_client_key,omitempty"`
+	TLSHostname       string `toml:"tls_hostname,omitempty"`
+	Token             string `toml:"token,omitempty"`
+	URL               string `toml:"url,omitempty"`
+
+	// S3
+	AccessKey                    string `toml:"access_key,omitempty"`
+	BucketName                   string `toml:"bucket_name,omitempty"`
+	Domain                       string `toml:"domain,omitempty"`
+	IAMRole                      string `toml:"iam_role,omitempty"`
+	PublicKey                    string `toml:"public_key,omitempty"`
+	Redundancy                   string `toml:"redundancy,omitempty"`
+	SecretKey                    string `toml:"secret_key,omitempty"`
+	ServerSideEncryption         string `toml:"server_side_encryption,omitempty"`
+	ServerSideEncryptionKMSKeyID string `toml:"server_side_encryption_kms_key_id,omitempty"`
+
+	// HTTPS
+	ContentType       string `toml:"content_type,omitempty"`
+	HeaderName        string `toml:"header_name,omitempty"`
+	HeaderValue       string `toml:"header_value,omitempty"`
+	JSONFormat        string `toml:"json_format,omitempty"`
+	Method            string `toml:"method,omitempty"`
+	RequestMaxBytes   uint   `toml:"request_max_bytes,omitempty"`
+	RequestMaxEntries uint   `toml:"request_max_entries,omitempty"`
+
+	// Datadog
+	Region string `toml:"region,omitempty"`
+
+	// Kafka
+	AuthMethod      string `toml:"auth_method,omitempty"`
+	Brokers         string `toml:"brokers,omitempty"`
+	ParseLogKeyvals bool   `toml:"parse_log_keyvals,omitempty"`
+	Password        string `toml:"password,omitempty"`
+	RequiredACKs    string `toml:"required_acks,omitempty"`
+	Topic           string `toml:"topic,omitempty"`
+	UseSASL         bool   `toml:"use_sasl,omitempty"`
+	UseTLS          bool   `toml:"use_tls,omitempty"`
+	User            string `toml:"user,omitempty"`
+}
+
+// SetupObjectStore represents a '[setup.object_stores.<T>]' instance.
+type SetupObjectStore struct {
+	Description string `toml:"description,omitempty"`
 }
 
 // LocalServer represents a list of mocked Viceroy resources.
 type LocalServer struct {
-	Backends     map[string]LocalBackend     `toml:"backends"`
-	Dictionaries map[string]LocalDictionary  `toml:"dictionaries,omitempty"`
-	ObjectStore  map[string]LocalObjectStore `toml:"object_stores,omitempty"`
+	Backends     map[string]LocalBackend       `toml:"backends"`
+	Dictionaries map[string]LocalDictionary    `toml:"dictionaries,omitempty"`
+	ObjectStore  map[string][]LocalObjectStore `toml:"object_stores,omitempty"`
 }
 
 // LocalBackend represents a backend to be mocked by the local testing server.
 type LocalBackend struct {
-	URL          string `toml:"url"`
-	OverrideHost string `toml:"override_host,omitempty"`
-	CertHost     string `toml:"cert_host,omitempty"`
-	UseSNI       bool   `toml:"use_sni,omitempty"`
+	URL          string            `toml:"url,omitempty"`
+	OverrideHost string            `toml:"override_host,omitempty"`
+	CertHost     string            `toml:"cert_host,omitempty"`
+	UseSNI       bool              `toml:"use_sni,omitempty"`
+	Stub         *LocalBackendStub `toml:"stub,omitempty"`
+}
+
+// LocalBackendStub represents a '[local_server.backends.<T>.stub]' instance.
+//
+// When defined, `compute serve` responds to the backend's requests from an
+// in-process HTTP server rather than the backend URL. Recorded responses are
+// tried first, then static files, and finally the body/file response.
+type LocalBackendStub struct {
+	Body       string            `toml:"body,omitempty"`
+	Dir        string            `toml:"dir,omitempty"`
+	File       string            `toml:"file,omitempty"`
+	Headers    map[string]string `toml:"headers,omitempty"`
+	Recordings string            `toml:"recordings,omitempty"`
+	Status     int               `toml:"status,omitempty"`
 }
 
 // LocalDictionary represents a dictionary to be mocked by the local testing server.
@@ -263,11 +447,13 @@ type LocalDictionary struct {
 	Contents map[string]string `toml:"contents,omitempty"`
 }
 
-// LocalObjectStore represents an object_store to be mocked by the local testing server.
+// LocalObjectStore represents an object_store entry to be mocked by the local
+// testing server. The value of the entry is read from Path when set,
+// otherwise Data is used.
 type LocalObjectStore struct {
 	Key  string `toml:"key"`
-	Path string `toml:"path"`
-	Data string `toml:"data"`
+	Path string `toml:"path,omitempty"`
+	Data string `toml:"data,omitempty"`
 }
 
 // Exists yields whether the manifest exists.
@@ -512,6 +698,92 @@ func (f *File) Write(path string) error {
 	return fp.Close()
 }
 
+// EnvironmentFilename returns the name of the manifest file for the given
+// environment, e.g. fastly.stage.toml. It returns Filename when env is empty.
+func EnvironmentFilename(env string) string {
+	if env == "" {
+		return Filename
+	}
+	return fmt.Sprintf("fastly.%s.toml", env)
+}
+
+// ReadEnvironment layers the environment manifest at path over the manifest
+// content already read from disk.
+//
+// NOTE: Only the service_id, [setup] and [scripts] fields are taken from the
+// environment manifest. The [setup] and [scripts] fields are only taken when
+// they're defined within it, and each [setup] resource type (e.g.
+// [setup.backends]) replaces the base definition. The service_id is always
+// taken, so an environment without one never deploys to the base service.
+func (f *File) ReadEnvironment(path string) error {
+	// gosec flagged this:
+	// G304 (CWE-22): Potential file inclusion via variable.
+	// Disabling as we need to load the manifest from the user's file system.
+	/* #nosec */
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("error reading environment manifest '%s': %w", path, err)
+	}
+
+	tree, err := toml.LoadBytes(data)
+	if err != nil {
+		return fmt.Errorf("error parsing environment manifest '%s': %w", path, err)
+	}
+
+	var env File
+	if err := tree.Unmarshal(&env); err != nil {
+		return fmt.Errorf("error parsing environment manifest '%s': %w", path, err)
+	}
+
+	f.ServiceID = env.ServiceID
+	if tree.Has("scripts.build") {
+		f.Scripts.Build = env.Scripts.Build
+	}
+	if tree.Has("scripts.post_build") {
+		f.Scripts.PostBuild = env.Scripts.PostBuild
+	}
+	if tree.Has("setup.acls") {
+		f.Setup.ACLs = env.Setup.ACLs
+	}
+	if tree.Has("setup.backends") {
+		f.Setup.Backends = env.Setup.Backends
+	}
+	if tree.Has("setup.dictionaries") {
+		f.Setup.Dictionaries = env.Setup.Dictionaries
+	}
+	if tree.Has("setup.healthchecks") {
+		f.Setup.Healthchecks = env.Setup.Healthchecks
+	}
+	if tree.Has("setup.log_endpoints") {
+		f.Setup.Loggers = env.Setup.Loggers
+	}
+	if tree.Has("setup.object_stores") {
+		f.Setup.ObjectStores = env.Setup.ObjectStores
+	}
+
+	return nil
+}
+
+// WriteServiceID persists the given Service ID to the manifest at path without
+// adding any of the other File fields to it.
+//
+// NOTE: This is used for environment manifests, which only hold the fields
+// that are overridden, and so can't be written with File.Write(). The manifest
+// is re-encoded, so its comments aren't preserved and its keys may be sorted.
+func WriteServiceID(path, serviceID string) error {
+	tree, err := toml.LoadFile(path)
+	if err != nil {
+		return fmt.Errorf("error reading manifest '%s': %w", path, err)
+	}
+	tree.Set("service_id", serviceID)
+
+	data, err := tree.Marshal()
+	if err != nil {
+		return fmt.Errorf("error marshalling manifest '%s': %w", path, err)
+	}
+	return os.WriteFile(path, data, FilePermissions)
+}
+
 // containsManifestSection loads the slice of bytes into a toml tree structure
 // before checking if the manifest_version is defined as a toml section block.
 func containsManifestSection(data []byte) (bool, error) {
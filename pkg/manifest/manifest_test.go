@@ -299,3 +299,107 @@ func TestManifestPersistsLocalServerSection(t *testing.T) {
 		t.Fatal("testing section between original and updated fastly.toml do not match")
 	}
 }
+
+func TestManifestReadEnvironment(t *testing.T) {
+	dir := t.TempDir()
+
+	base := filepath.Join(dir, manifest.Filename)
+	err := os.WriteFile(base, []byte(`
+manifest_version = 2
+name = "package"
+language = "rust"
+service_id = "base"
+
+[scripts]
+build = "cargo build"
+post_build = "echo base"
+
+[setup.backends.origin]
+address = "base.example.com"
+
+[setup.dictionaries.config]
+description = "base config"
+
+[setup.acls.blocklist]
+[[setup.acls.blocklist.entries]]
+ip = "192.0.2.0"
+subnet = 24
+`), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stage := filepath.Join(dir, manifest.EnvironmentFilename("stage"))
+	err = os.WriteFile(stage, []byte(`
+# Staging overrides
+service_id = "stage"
+
+[scripts]
+post_build = "echo stage"
+
+[setup.backends.origin]
+address = "stage.example.com"
+
+[setup.acls.blocklist]
+[[setup.acls.blocklist.entries]]
+ip = "198.51.100.1"
+
+[[setup.acls.blocklist.entries]]
+ip = "198.51.100.2"
+negated = true
+`), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var m manifest.File
+	if err := m.Read(base); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.ReadEnvironment(stage); err != nil {
+		t.Fatal(err)
+	}
+
+	testutil.AssertString(t, "package", m.Name)
+	testutil.AssertString(t, "stage", m.ServiceID)
+	testutil.AssertString(t, "cargo build", m.Scripts.Build)
+	testutil.AssertString(t, "echo stage", m.Scripts.PostBuild)
+	testutil.AssertString(t, "stage.example.com", m.Setup.Backends["origin"].Address)
+	testutil.AssertString(t, "base config", m.Setup.Dictionaries["config"].Description)
+	testutil.AssertEqual(t, []manifest.SetupACLEntry{
+		{IP: "198.51.100.1"},
+		{IP: "198.51.100.2", Negated: true},
+	}, m.Setup.ACLs["blocklist"].Entries)
+
+	if err := manifest.WriteServiceID(stage, "new"); err != nil {
+		t.Fatal(err)
+	}
+	tree, err := toml.LoadFile(stage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testutil.AssertString(t, "new", tree.Get("service_id").(string))
+	if tree.Has("name") {
+		t.Fatal("did not expect name key to be written to the environment manifest")
+	}
+
+	// An environment without a service_id mustn't inherit the base service_id,
+	// otherwise it would deploy to the base (e.g. production) service.
+	dev := filepath.Join(dir, manifest.EnvironmentFilename("dev"))
+	if err := os.WriteFile(dev, []byte("[scripts]\nbuild = \"cargo build --profile dev\"\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	m = manifest.File{}
+	if err := m.Read(base); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.ReadEnvironment(dev); err != nil {
+		t.Fatal(err)
+	}
+	testutil.AssertString(t, "", m.ServiceID)
+	testutil.AssertString(t, "cargo build --profile dev", m.Scripts.Build)
+
+	if err := m.ReadEnvironment(filepath.Join(dir, manifest.EnvironmentFilename("prod"))); err == nil {
+		t.Fatal("expected an error reading a missing environment manifest")
+	}
+}
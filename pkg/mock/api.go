@@ -48,6 +48,67 @@ type API struct {
 	UpdateHealthCheckFn func(*fastly.UpdateHealthCheckInput) (*fastly.HealthCheck, error)
 	DeleteHealthCheckFn func(*fastly.DeleteHealthCheckInput) error
 
+	CreateConditionFn func(*fastly.CreateConditionInput) (*fastly.Condition, error)
+	ListConditionsFn  func(*fastly.ListConditionsInput) ([]*fastly.Condition, error)
+	GetConditionFn    func(*fastly.GetConditionInput) (*fastly.Condition, error)
+	UpdateConditionFn func(*fastly.UpdateConditionInput) (*fastly.Condition, error)
+	DeleteConditionFn func(*fastly.DeleteConditionInput) error
+
+	CreateHeaderFn func(*fastly.CreateHeaderInput) (*fastly.Header, error)
+	ListHeadersFn  func(*fastly.ListHeadersInput) ([]*fastly.Header, error)
+	GetHeaderFn    func(*fastly.GetHeaderInput) (*fastly.Header, error)
+	UpdateHeaderFn func(*fastly.UpdateHeaderInput) (*fastly.Header, error)
+	DeleteHeaderFn func(*fastly.DeleteHeaderInput) error
+
+	CreateCacheSettingFn func(*fastly.CreateCacheSettingInput) (*fastly.CacheSetting, error)
+	ListCacheSettingsFn  func(*fastly.ListCacheSettingsInput) ([]*fastly.CacheSetting, error)
+	GetCacheSettingFn    func(*fastly.GetCacheSettingInput) (*fastly.CacheSetting, error)
+	UpdateCacheSettingFn func(*fastly.UpdateCacheSettingInput) (*fastly.CacheSetting, error)
+	DeleteCacheSettingFn func(*fastly.DeleteCacheSettingInput) error
+
+	CreateRequestSettingFn func(*fastly.CreateRequestSettingInput) (*fastly.RequestSetting, error)
+	ListRequestSettingsFn  func(*fastly.ListRequestSettingsInput) ([]*fastly.RequestSetting, error)
+	GetRequestSettingFn    func(*fastly.GetRequestSettingInput) (*fastly.RequestSetting, error)
+	UpdateRequestSettingFn func(*fastly.UpdateRequestSettingInput) (*fastly.RequestSetting, error)
+	DeleteRequestSettingFn func(*fastly.DeleteRequestSettingInput) error
+
+	CreateResponseObjectFn func(*fastly.CreateResponseObjectInput) (*fastly.ResponseObject, error)
+	ListResponseObjectsFn  func(*fastly.ListResponseObjectsInput) ([]*fastly.ResponseObject, error)
+	GetResponseObjectFn    func(*fastly.GetResponseObjectInput) (*fastly.ResponseObject, error)
+	UpdateResponseObjectFn func(*fastly.UpdateResponseObjectInput) (*fastly.ResponseObject, error)
+	DeleteResponseObjectFn func(*fastly.DeleteResponseObjectInput) error
+
+	CreateGzipFn func(*fastly.CreateGzipInput) (*fastly.Gzip, error)
+	ListGzipsFn  func(*fastly.ListGzipsInput) ([]*fastly.Gzip, error)
+	GetGzipFn    func(*fastly.GetGzipInput) (*fastly.Gzip, error)
+	UpdateGzipFn func(*fastly.UpdateGzipInput) (*fastly.Gzip, error)
+	DeleteGzipFn func(*fastly.DeleteGzipInput) error
+
+	GetSettingsFn    func(*fastly.GetSettingsInput) (*fastly.Settings, error)
+	UpdateSettingsFn func(*fastly.UpdateSettingsInput) (*fastly.Settings, error)
+
+	CreateDirectorFn func(*fastly.CreateDirectorInput) (*fastly.Director, error)
+	ListDirectorsFn  func(*fastly.ListDirectorsInput) ([]*fastly.Director, error)
+	GetDirectorFn    func(*fastly.GetDirectorInput) (*fastly.Director, error)
+	UpdateDirectorFn func(*fastly.UpdateDirectorInput) (*fastly.Director, error)
+	DeleteDirectorFn func(*fastly.DeleteDirectorInput) error
+
+	CreateDirectorBackendFn func(*fastly.CreateDirectorBackendInput) (*fastly.DirectorBackend, error)
+	GetDirectorBackendFn    func(*fastly.GetDirectorBackendInput) (*fastly.DirectorBackend, error)
+	DeleteDirectorBackendFn func(*fastly.DeleteDirectorBackendInput) error
+
+	CreatePoolFn func(*fastly.CreatePoolInput) (*fastly.Pool, error)
+	ListPoolsFn  func(*fastly.ListPoolsInput) ([]*fastly.Pool, error)
+	GetPoolFn    func(*fastly.GetPoolInput) (*fastly.Pool, error)
+	UpdatePoolFn func(*fastly.UpdatePoolInput) (*fastly.Pool, error)
+	DeletePoolFn func(*fastly.DeletePoolInput) error
+
+	CreateServerFn func(*fastly.CreateServerInput) (*fastly.Server, error)
+	ListServersFn  func(*fastly.ListServersInput) ([]*fastly.Server, error)
+	GetServerFn    func(*fastly.GetServerInput) (*fastly.Server, error)
+	UpdateServerFn func(*fastly.UpdateServerInput) (*fastly.Server, error)
+	DeleteServerFn func(*fastly.DeleteServerInput) error
+
 	GetPackageFn    func(*fastly.GetPackageInput) (*fastly.Package, error)
 	UpdatePackageFn func(*fastly.UpdatePackageInput) (*fastly.Package, error)
 
@@ -66,6 +127,16 @@ type API struct {
 
 	GetDictionaryInfoFn func(*fastly.GetDictionaryInfoInput) (*fastly.DictionaryInfo, error)
 
+	CreateObjectStoreFn func(*fastly.CreateObjectStoreInput) (*fastly.ObjectStore, error)
+	ListObjectStoresFn  func(*fastly.ListObjectStoresInput) (*fastly.ListObjectStoresResponse, error)
+	GetObjectStoreFn    func(*fastly.GetObjectStoreInput) (*fastly.ObjectStore, error)
+	DeleteObjectStoreFn func(*fastly.DeleteObjectStoreInput) error
+
+	ListObjectStoreKeysFn  func(*fastly.ListObjectStoreKeysInput) (*fastly.ListObjectStoreKeysResponse, error)
+	GetObjectStoreKeyFn    func(*fastly.GetObjectStoreKeyInput) (string, error)
+	InsertObjectStoreKeyFn func(*fastly.InsertObjectStoreKeyInput) error
+	DeleteObjectStoreKeyFn func(*fastly.DeleteObjectStoreKeyInput) error
+
 	CreateBigQueryFn func(*fastly.CreateBigQueryInput) (*fastly.BigQuery, error)
 	ListBigQueriesFn func(*fastly.ListBigQueriesInput) ([]*fastly.BigQuery, error)
 	GetBigQueryFn    func(*fastly.GetBigQueryInput) (*fastly.BigQuery, error)
@@ -227,6 +298,8 @@ type API struct {
 	UpdateVCLFn func(*fastly.UpdateVCLInput) (*fastly.VCL, error)
 	DeleteVCLFn func(*fastly.DeleteVCLInput) error
 
+	GetGeneratedVCLFn func(*fastly.GetGeneratedVCLInput) (*fastly.VCL, error)
+
 	CreateSnippetFn        func(i *fastly.CreateSnippetInput) (*fastly.Snippet, error)
 	ListSnippetsFn         func(i *fastly.ListSnippetsInput) ([]*fastly.Snippet, error)
 	GetSnippetFn           func(i *fastly.GetSnippetInput) (*fastly.Snippet, error)
@@ -490,6 +563,256 @@ func (m API) DeleteHealthCheck(i *fastly.DeleteHealthCheckInput) error {
 	return m.DeleteHealthCheckFn(i)
 }
 
+// CreateCondition implements Interface.
+func (m API) CreateCondition(i *fastly.CreateConditionInput) (*fastly.Condition, error) {
+	return m.CreateConditionFn(i)
+}
+
+// ListConditions implements Interface.
+func (m API) ListConditions(i *fastly.ListConditionsInput) ([]*fastly.Condition, error) {
+	return m.ListConditionsFn(i)
+}
+
+// GetCondition implements Interface.
+func (m API) GetCondition(i *fastly.GetConditionInput) (*fastly.Condition, error) {
+	return m.GetConditionFn(i)
+}
+
+// UpdateCondition implements Interface.
+func (m API) UpdateCondition(i *fastly.UpdateConditionInput) (*fastly.Condition, error) {
+	return m.UpdateConditionFn(i)
+}
+
+// DeleteCondition implements Interface.
+func (m API) DeleteCondition(i *fastly.DeleteConditionInput) error {
+	return m.DeleteConditionFn(i)
+}
+
+// CreateHeader implements Interface.
+func (m API) CreateHeader(i *fastly.CreateHeaderInput) (*fastly.Header, error) {
+	return m.CreateHeaderFn(i)
+}
+
+// ListHeaders implements Interface.
+func (m API) ListHeaders(i *fastly.ListHeadersInput) ([]*fastly.Header, error) {
+	return m.ListHeadersFn(i)
+}
+
+// GetHeader implements Interface.
+func (m API) GetHeader(i *fastly.GetHeaderInput) (*fastly.Header, error) {
+	return m.GetHeaderFn(i)
+}
+
+// UpdateHeader implements Interface.
+func (m API) UpdateHeader(i *fastly.UpdateHeaderInput) (*fastly.Header, error) {
+	return m.UpdateHeaderFn(i)
+}
+
+// DeleteHeader implements Interface.
+func (m API) DeleteHeader(i *fastly.DeleteHeaderInput) error {
+	return m.DeleteHeaderFn(i)
+}
+
+// CreateCacheSetting implements Interface.
+func (m API) CreateCacheSetting(i *fastly.CreateCacheSettingInput) (*fastly.CacheSetting, error) {
+	return m.CreateCacheSettingFn(i)
+}
+
+// ListCacheSettings implements Interface.
+func (m API) ListCacheSettings(i *fastly.ListCacheSettingsInput) ([]*fastly.CacheSetting, error) {
+	return m.ListCacheSettingsFn(i)
+}
+
+// GetCacheSetting implements Interface.
+func (m API) GetCacheSetting(i *fastly.GetCacheSettingInput) (*fastly.CacheSetting, error) {
+	return m.GetCacheSettingFn(i)
+}
+
+// UpdateCacheSetting implements Interface.
+func (m API) UpdateCacheSetting(i *fastly.UpdateCacheSettingInput) (*fastly.CacheSetting, error) {
+	return m.UpdateCacheSettingFn(i)
+}
+
+// DeleteCacheSetting implements Interface.
+func (m API) DeleteCacheSetting(i *fastly.DeleteCacheSettingInput) error {
+	return m.DeleteCacheSettingFn(i)
+}
+
+// CreateRequestSetting implements Interface.
+func (m API) CreateRequestSetting(i *fastly.CreateRequestSettingInput) (*fastly.RequestSetting, error) {
+	return m.CreateRequestSettingFn(i)
+}
+
+// ListRequestSettings implements Interface.
+func (m API) ListRequestSettings(i *fastly.ListRequestSettingsInput) ([]*fastly.RequestSetting, error) {
+	return m.ListRequestSettingsFn(i)
+}
+
+// GetRequestSetting implements Interface.
+func (m API) GetRequestSetting(i *fastly.GetRequestSettingInput) (*fastly.RequestSetting, error) {
+	return m.GetRequestSettingFn(i)
+}
+
+// UpdateRequestSetting implements Interface.
+func (m API) UpdateRequestSetting(i *fastly.UpdateRequestSettingInput) (*fastly.RequestSetting, error) {
+	return m.UpdateRequestSettingFn(i)
+}
+
+// DeleteRequestSetting implements Interface.
+func (m API) DeleteRequestSetting(i *fastly.DeleteRequestSettingInput) error {
+	return m.DeleteRequestSettingFn(i)
+}
+
+// CreateResponseObject implements Interface.
+func (m API) CreateResponseObject(i *fastly.CreateResponseObjectInput) (*fastly.ResponseObject, error) {
+	return m.CreateResponseObjectFn(i)
+}
+
+// ListResponseObjects implements Interface.
+func (m API) ListResponseObjects(i *fastly.ListResponseObjectsInput) ([]*fastly.ResponseObject, error) {
+	return m.ListResponseObjectsFn(i)
+}
+
+// GetResponseObject implements Interface.
+func (m API) GetResponseObject(i *fastly.GetResponseObjectInput) (*fastly.ResponseObject, error) {
+	return m.GetResponseObjectFn(i)
+}
+
+// UpdateResponseObject implements Interface.
+func (m API) UpdateResponseObject(i *fastly.UpdateResponseObjectInput) (*fastly.ResponseObject, error) {
+	return m.UpdateResponseObjectFn(i)
+}
+
+// DeleteResponseObject implements Interface.
+func (m API) DeleteResponseObject(i *fastly.DeleteResponseObjectInput) error {
+	return m.DeleteResponseObjectFn(i)
+}
+
+// CreateGzip implements Interface.
+func (m API) CreateGzip(i *fastly.CreateGzipInput) (*fastly.Gzip, error) {
+	return m.CreateGzipFn(i)
+}
+
+// ListGzips implements Interface.
+func (m API) ListGzips(i *fastly.ListGzipsInput) ([]*fastly.Gzip, error) {
+	return m.ListGzipsFn(i)
+}
+
+// GetGzip implements Interface.
+func (m API) GetGzip(i *fastly.GetGzipInput) (*fastly.Gzip, error) {
+	return m.GetGzipFn(i)
+}
+
+// UpdateGzip implements Interface.
+func (m API) UpdateGzip(i *fastly.UpdateGzipInput) (*fastly.Gzip, error) {
+	return m.UpdateGzipFn(i)
+}
+
+// DeleteGzip implements Interface.
+func (m API) DeleteGzip(i *fastly.DeleteGzipInput) error {
+	return m.DeleteGzipFn(i)
+}
+
+// GetSettings implements Interface.
+func (m API) GetSettings(i *fastly.GetSettingsInput) (*fastly.Settings, error) {
+	return m.GetSettingsFn(i)
+}
+
+// UpdateSettings implements Interface.
+func (m API) UpdateSettings(i *fastly.UpdateSettingsInput) (*fastly.Settings, error) {
+	return m.UpdateSettingsFn(i)
+}
+
+// CreateDirector implements Interface.
+func (m API) CreateDirector(i *fastly.CreateDirectorInput) (*fastly.Director, error) {
+	return m.CreateDirectorFn(i)
+}
+
+// ListDirectors implements Interface.
+func (m API) ListDirectors(i *fastly.ListDirectorsInput) ([]*fastly.Director, error) {
+	return m.ListDirectorsFn(i)
+}
+
+// GetDirector implements Interface.
+func (m API) GetDirector(i *fastly.GetDirectorInput) (*fastly.Director, error) {
+	return m.GetDirectorFn(i)
+}
+
+// UpdateDirector implements Interface.
+func (m API) UpdateDirector(i *fastly.UpdateDirectorInput) (*fastly.Director, error) {
+	return m.UpdateDirectorFn(i)
+}
+
+// DeleteDirector implements Interface.
+func (m API) DeleteDirector(i *fastly.DeleteDirectorInput) error {
+	return m.DeleteDirectorFn(i)
+}
+
+// CreateDirectorBackend implements Interface.
+func (m API) CreateDirectorBackend(i *fastly.CreateDirectorBackendInput) (*fastly.DirectorBackend, error) {
+	return m.CreateDirectorBackendFn(i)
+}
+
+// GetDirectorBackend implements Interface.
+func (m API) GetDirectorBackend(i *fastly.GetDirectorBackendInput) (*fastly.DirectorBackend, error) {
+	return m.GetDirectorBackendFn(i)
+}
+
+// DeleteDirectorBackend implements Interface.
+func (m API) DeleteDirectorBackend(i *fastly.DeleteDirectorBackendInput) error {
+	return m.DeleteDirectorBackendFn(i)
+}
+
+// CreatePool implements Interface.
+func (m API) CreatePool(i *fastly.CreatePoolInput) (*fastly.Pool, error) {
+	return m.CreatePoolFn(i)
+}
+
+// ListPools implements Interface.
+func (m API) ListPools(i *fastly.ListPoolsInput) ([]*fastly.Pool, error) {
+	return m.ListPoolsFn(i)
+}
+
+// GetPool implements Interface.
+func (m API) GetPool(i *fastly.GetPoolInput) (*fastly.Pool, error) {
+	return m.GetPoolFn(i)
+}
+
+// UpdatePool implements Interface.
+func (m API) UpdatePool(i *fastly.UpdatePoolInput) (*fastly.Pool, error) {
+	return m.UpdatePoolFn(i)
+}
+
+// DeletePool implements Interface.
+func (m API) DeletePool(i *fastly.DeletePoolInput) error {
+	return m.DeletePoolFn(i)
+}
+
+// CreateServer implements Interface.
+func (m API) CreateServer(i *fastly.CreateServerInput) (*fastly.Server, error) {
+	return m.CreateServerFn(i)
+}
+
+// ListServers implements Interface.
+func (m API) ListServers(i *fastly.ListServersInput) ([]*fastly.Server, error) {
+	return m.ListServersFn(i)
+}
+
+// GetServer implements Interface.
+func (m API) GetServer(i *fastly.GetServerInput) (*fastly.Server, error) {
+	return m.GetServerFn(i)
+}
+
+// UpdateServer implements Interface.
+func (m API) UpdateServer(i *fastly.UpdateServerInput) (*fastly.Server, error) {
+	return m.UpdateServerFn(i)
+}
+
+// DeleteServer implements Interface.
+func (m API) DeleteServer(i *fastly.DeleteServerInput) error {
+	return m.DeleteServerFn(i)
+}
+
 // GetPackage implements Interface.
 func (m API) GetPackage(i *fastly.GetPackageInput) (*fastly.Package, error) {
 	return m.GetPackageFn(i)
@@ -560,6 +883,46 @@ func (m API) GetDictionaryInfo(i *fastly.GetDictionaryInfoInput) (*fastly.Dictio
 	return m.GetDictionaryInfoFn(i)
 }
 
+// CreateObjectStore implements Interface.
+func (m API) CreateObjectStore(i *fastly.CreateObjectStoreInput) (*fastly.ObjectStore, error) {
+	return m.CreateObjectStoreFn(i)
+}
+
+// ListObjectStores implements Interface.
+func (m API) ListObjectStores(i *fastly.ListObjectStoresInput) (*fastly.ListObjectStoresResponse, error) {
+	return m.ListObjectStoresFn(i)
+}
+
+// GetObjectStore implements Interface.
+func (m API) GetObjectStore(i *fastly.GetObjectStoreInput) (*fastly.ObjectStore, error) {
+	return m.GetObjectStoreFn(i)
+}
+
+// DeleteObjectStore implements Interface.
+func (m API) DeleteObjectStore(i *fastly.DeleteObjectStoreInput) error {
+	return m.DeleteObjectStoreFn(i)
+}
+
+// ListObjectStoreKeys implements Interface.
+func (m API) ListObjectStoreKeys(i *fastly.ListObjectStoreKeysInput) (*fastly.ListObjectStoreKeysResponse, error) {
+	return m.ListObjectStoreKeysFn(i)
+}
+
+// GetObjectStoreKey implements Interface.
+func (m API) GetObjectStoreKey(i *fastly.GetObjectStoreKeyInput) (string, error) {
+	return m.GetObjectStoreKeyFn(i)
+}
+
+// InsertObjectStoreKey implements Interface.
+func (m API) InsertObjectStoreKey(i *fastly.InsertObjectStoreKeyInput) error {
+	return m.InsertObjectStoreKeyFn(i)
+}
+
+// DeleteObjectStoreKey implements Interface.
+func (m API) DeleteObjectStoreKey(i *fastly.DeleteObjectStoreKeyInput) error {
+	return m.DeleteObjectStoreKeyFn(i)
+}
+
 // CreateBigQuery implements Interface.
 func (m API) CreateBigQuery(i *fastly.CreateBigQueryInput) (*fastly.BigQuery, error) {
 	return m.CreateBigQueryFn(i)
@@ -1225,6 +1588,11 @@ func (m API) DeleteVCL(i *fastly.DeleteVCLInput) error {
 	return m.DeleteVCLFn(i)
 }
 
+// GetGeneratedVCL implements Interface.
+func (m API) GetGeneratedVCL(i *fastly.GetGeneratedVCLInput) (*fastly.VCL, error) {
+	return m.GetGeneratedVCLFn(i)
+}
+
 // CreateSnippet implements Interface.
 func (m API) CreateSnippet(i *fastly.CreateSnippetInput) (*fastly.Snippet, error) {
 	return m.CreateSnippetFn(i)
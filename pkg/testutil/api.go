@@ -2,7 +2,10 @@ package testutil
 
 import (
 	"errors"
+	"reflect"
+	"strings"
 
+	"github.com/fastly/cli/pkg/mock"
 	"github.com/fastly/go-fastly/v6/fastly"
 )
 
@@ -61,3 +64,21 @@ func CloneVersionResult(version int) func(i *fastly.CloneVersionInput) (*fastly.
 func CloneVersionError(_ *fastly.CloneVersionInput) (*fastly.Version, error) {
 	return nil, Err
 }
+
+// EmptyLists sets any List*Fn function that hasn't been defined to return an
+// empty list. It's used by commands that list every type of service resource
+// (e.g. service export) so tests only need to define the relevant functions.
+func EmptyLists(api mock.API) mock.API {
+	v := reflect.ValueOf(&api).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Field(i)
+		if !strings.HasPrefix(v.Type().Field(i).Name, "List") || !f.IsNil() {
+			continue
+		}
+		t := f.Type()
+		f.Set(reflect.MakeFunc(t, func(_ []reflect.Value) []reflect.Value {
+			return []reflect.Value{reflect.Zero(t.Out(0)), reflect.Zero(t.Out(1))}
+		}))
+	}
+	return api
+}
@@ -0,0 +1,22 @@
+package text
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/go-fastly/v6/fastly"
+	"github.com/segmentio/textio"
+)
+
+// PrintCondition pretty prints a fastly.Condition structure in verbose
+// format to a given io.Writer. Consumers can provide a prefix string which
+// will be used as a prefix to each line, useful for indentation.
+func PrintCondition(out io.Writer, prefix string, c *fastly.Condition) {
+	out = textio.NewPrefixWriter(out, prefix)
+
+	fmt.Fprintf(out, "Name: %s\n", c.Name)
+	fmt.Fprintf(out, "Comment: %s\n", c.Comment)
+	fmt.Fprintf(out, "Type: %s\n", c.Type)
+	fmt.Fprintf(out, "Statement: %s\n", c.Statement)
+	fmt.Fprintf(out, "Priority: %d\n", c.Priority)
+}
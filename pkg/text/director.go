@@ -0,0 +1,40 @@
+package text
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/fastly/go-fastly/v6/fastly"
+	"github.com/segmentio/textio"
+)
+
+// PrintDirector pretty prints a fastly.Director structure in verbose format
+// to a given io.Writer. Consumers can provide a prefix string which will be
+// used as a prefix to each line, useful for indentation.
+func PrintDirector(out io.Writer, prefix string, d *fastly.Director) {
+	out = textio.NewPrefixWriter(out, prefix)
+
+	fmt.Fprintf(out, "Name: %s\n", d.Name)
+	fmt.Fprintf(out, "Comment: %s\n", d.Comment)
+	fmt.Fprintf(out, "Type: %s\n", directorType(d.Type))
+	fmt.Fprintf(out, "Quorum: %d\n", d.Quorum)
+	fmt.Fprintf(out, "Retries: %d\n", d.Retries)
+	fmt.Fprintf(out, "Shield: %s\n", d.Shield)
+	fmt.Fprintf(out, "Backends: %s\n", strings.Join(d.Backends, ", "))
+}
+
+// directorType returns a human readable name for a director type.
+func directorType(t fastly.DirectorType) string {
+	switch t {
+	case fastly.DirectorTypeRandom:
+		return "random"
+	case fastly.DirectorTypeRoundRobin:
+		return "round-robin"
+	case fastly.DirectorTypeHash:
+		return "hash"
+	case fastly.DirectorTypeClient:
+		return "client"
+	}
+	return "unknown"
+}
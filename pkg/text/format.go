@@ -0,0 +1,222 @@
+package text
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"text/template"
+
+	"gopkg.in/yaml.v2"
+)
+
+// The output formats supported by Render.
+const (
+	FormatText     = "text"
+	FormatJSON     = "json"
+	FormatYAML     = "yaml"
+	FormatCSV      = "csv"
+	FormatTemplate = "template"
+)
+
+// Formats is the list of supported output formats.
+var Formats = []string{FormatText, FormatJSON, FormatYAML, FormatCSV, FormatTemplate}
+
+// Render writes v to out in the given structured format. The tmpl argument is
+// the Go template used by FormatTemplate and is ignored by the other formats.
+//
+// FormatJSON, FormatYAML and FormatCSV render the JSON representation of v,
+// and so use its JSON field names. FormatTemplate is executed against v itself,
+// and so fields are referenced by their Go names (e.g. {{.ServiceID}}). When v
+// is a slice, FormatCSV renders one row per element and FormatTemplate
+// executes tmpl once per element.
+func Render(out io.Writer, format, tmpl string, v any) error {
+	switch format {
+	case FormatJSON:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		_, err = out.Write(data)
+		return err
+	case FormatYAML:
+		return renderYAML(out, v)
+	case FormatCSV:
+		return renderCSV(out, v)
+	case FormatTemplate:
+		return renderTemplate(out, tmpl, v)
+	}
+	return fmt.Errorf("unsupported output format: %s", format)
+}
+
+// renderYAML writes v as a YAML document. The value is decoded from its JSON
+// representation into a yaml.MapSlice so that field order is preserved.
+func renderYAML(out io.Writer, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	var doc any
+	switch bytes.TrimSpace(data)[0] {
+	case '{':
+		var m yaml.MapSlice
+		err = yaml.Unmarshal(data, &m)
+		doc = m
+	case '[':
+		var s []any
+		err = yaml.Unmarshal(data, &s)
+		if err == nil && isObjectSlice(s) {
+			var ms []yaml.MapSlice
+			err = yaml.Unmarshal(data, &ms)
+			doc = ms
+		} else {
+			doc = s
+		}
+	default:
+		err = yaml.Unmarshal(data, &doc)
+	}
+	if err != nil {
+		return err
+	}
+
+	data, err = yaml.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	_, err = out.Write(data)
+	return err
+}
+
+// isObjectSlice reports whether every element of s is a JSON object.
+func isObjectSlice(s []any) bool {
+	for _, e := range s {
+		if _, ok := e.(map[any]any); !ok {
+			return false
+		}
+	}
+	return len(s) > 0
+}
+
+// renderCSV writes v as CSV. The header is taken from the fields of the first
+// record, and nested values are rendered as compact JSON.
+func renderCSV(out io.Writer, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	var raws []json.RawMessage
+	switch bytes.TrimSpace(data)[0] {
+	case 'n':
+		return nil
+	case '[':
+		if err := json.Unmarshal(data, &raws); err != nil {
+			return err
+		}
+	default:
+		raws = []json.RawMessage{data}
+	}
+
+	w := csv.NewWriter(out)
+	var header []string
+	for i, raw := range raws {
+		keys, values, err := decodeObject(raw)
+		if err != nil {
+			return err
+		}
+		if i == 0 {
+			header = keys
+			if err := w.Write(header); err != nil {
+				return err
+			}
+		}
+		row := make([]string, len(header))
+		for j, k := range header {
+			row[j] = csvCell(values[k])
+		}
+		if err := w.Write(row); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
+
+// decodeObject decodes a JSON value into its keys (in document order) and raw
+// values. Scalars are treated as an object with a single "value" key.
+func decodeObject(raw json.RawMessage) ([]string, map[string]json.RawMessage, error) {
+	if bytes.TrimSpace(raw)[0] != '{' {
+		return []string{"value"}, map[string]json.RawMessage{"value": raw}, nil
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	if _, err := dec.Token(); err != nil {
+		return nil, nil, err
+	}
+	var keys []string
+	values := make(map[string]json.RawMessage)
+	for dec.More() {
+		t, err := dec.Token()
+		if err != nil {
+			return nil, nil, err
+		}
+		k, _ := t.(string)
+		var v json.RawMessage
+		if err := dec.Decode(&v); err != nil {
+			return nil, nil, err
+		}
+		keys = append(keys, k)
+		values[k] = v
+	}
+	return keys, values, nil
+}
+
+// csvCell renders a raw JSON value as a CSV cell.
+func csvCell(raw json.RawMessage) string {
+	if len(raw) == 0 || string(raw) == "null" {
+		return ""
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s
+	}
+	return string(raw)
+}
+
+// renderTemplate executes tmpl against v, or against each element of v when it
+// is a slice, writing a newline after each execution.
+func renderTemplate(out io.Writer, tmpl string, v any) error {
+	t, err := template.New("output").Funcs(template.FuncMap{
+		"json": func(v any) (string, error) {
+			data, err := json.Marshal(v)
+			return string(data), err
+		},
+		"join": strings.Join,
+	}).Parse(tmpl)
+	if err != nil {
+		return fmt.Errorf("error parsing template: %w", err)
+	}
+
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return executeTemplate(out, t, v)
+	}
+	for i := 0; i < rv.Len(); i++ {
+		if err := executeTemplate(out, t, rv.Index(i).Interface()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func executeTemplate(out io.Writer, t *template.Template, v any) error {
+	if err := t.Execute(out, v); err != nil {
+		return fmt.Errorf("error executing template: %w", err)
+	}
+	_, err := io.WriteString(out, "\n")
+	return err
+}
@@ -0,0 +1,100 @@
+package text_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/cli/pkg/text"
+)
+
+type formatRecord struct {
+	Name  string            `json:"name"`
+	Port  int               `json:"port"`
+	Tags  []string          `json:"tags"`
+	Extra map[string]string `json:"extra,omitempty"`
+}
+
+func TestRender(t *testing.T) {
+	records := []formatRecord{
+		{Name: "foo", Port: 80, Tags: []string{"a", "b"}},
+		{Name: "bar, baz", Port: 443, Extra: map[string]string{"k": "v"}},
+	}
+
+	for _, testcase := range []struct {
+		name       string
+		format     string
+		template   string
+		value      any
+		wantError  string
+		wantOutput string
+	}{
+		{
+			name:       "json",
+			format:     text.FormatJSON,
+			value:      records[0],
+			wantOutput: `{"name":"foo","port":80,"tags":["a","b"]}`,
+		},
+		{
+			name:       "yaml preserves field order",
+			format:     text.FormatYAML,
+			value:      records,
+			wantOutput: "- name: foo\n  port: 80\n  tags:\n  - a\n  - b\n- name: bar, baz\n  port: 443\n  tags: null\n  extra:\n    k: v\n",
+		},
+		{
+			name:       "csv",
+			format:     text.FormatCSV,
+			value:      records,
+			wantOutput: "name,port,tags\nfoo,80,\"[\"\"a\"\",\"\"b\"\"]\"\n\"bar, baz\",443,\n",
+		},
+		{
+			name:       "csv single object",
+			format:     text.FormatCSV,
+			value:      &records[0],
+			wantOutput: "name,port,tags\nfoo,80,\"[\"\"a\"\",\"\"b\"\"]\"\n",
+		},
+		{
+			name:       "template per element",
+			format:     text.FormatTemplate,
+			template:   "{{.Name}}:{{.Port}} {{join .Tags \",\"}}",
+			value:      records,
+			wantOutput: "foo:80 a,b\nbar, baz:443 \n",
+		},
+		{
+			name:      "template doesn't use json field names",
+			format:    text.FormatTemplate,
+			template:  "{{.name}}",
+			value:     records,
+			wantError: "can't evaluate field name",
+		},
+		{
+			name:       "template json func",
+			format:     text.FormatTemplate,
+			template:   "{{json .Extra}}",
+			value:      records[1],
+			wantOutput: "{\"k\":\"v\"}\n",
+		},
+		{
+			name:      "template parse error",
+			format:    text.FormatTemplate,
+			template:  "{{.Name",
+			value:     records,
+			wantError: "error parsing template",
+		},
+		{
+			name:      "unsupported format",
+			format:    "xml",
+			value:     records,
+			wantError: "unsupported output format: xml",
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := text.Render(&buf, testcase.format, testcase.template, testcase.value)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			if testcase.wantError == "" {
+				testutil.AssertString(t, testcase.wantOutput, buf.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package text
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/go-fastly/v6/fastly"
+	"github.com/segmentio/textio"
+)
+
+// PrintGzip pretty prints a fastly.Gzip structure in verbose format to a
+// given io.Writer. Consumers can provide a prefix string which will be used
+// as a prefix to each line, useful for indentation.
+func PrintGzip(out io.Writer, prefix string, g *fastly.Gzip) {
+	out = textio.NewPrefixWriter(out, prefix)
+
+	fmt.Fprintf(out, "Name: %s\n", g.Name)
+	fmt.Fprintf(out, "Content types: %s\n", g.ContentTypes)
+	fmt.Fprintf(out, "Extensions: %s\n", g.Extensions)
+	fmt.Fprintf(out, "Cache condition: %s\n", g.CacheCondition)
+}
+
+// PrintSettings pretty prints a fastly.Settings structure in verbose format
+// to a given io.Writer. Consumers can provide a prefix string which will be
+// used as a prefix to each line, useful for indentation.
+func PrintSettings(out io.Writer, prefix string, s *fastly.Settings) {
+	out = textio.NewPrefixWriter(out, prefix)
+
+	fmt.Fprintf(out, "Default TTL: %d\n", s.DefaultTTL)
+	fmt.Fprintf(out, "Default host: %s\n", s.DefaultHost)
+	fmt.Fprintf(out, "Stale if error: %t\n", s.StaleIfError)
+	fmt.Fprintf(out, "Stale if error TTL: %d\n", s.StaleIfErrorTTL)
+}
@@ -0,0 +1,26 @@
+package text
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/time"
+	"github.com/fastly/go-fastly/v6/fastly"
+	"github.com/segmentio/textio"
+)
+
+// PrintObjectStore pretty prints a fastly.ObjectStore structure in verbose
+// format to a given io.Writer. Consumers can provide a prefix string which
+// will be used as a prefix to each line, useful for indentation.
+func PrintObjectStore(out io.Writer, prefix string, s *fastly.ObjectStore) {
+	out = textio.NewPrefixWriter(out, prefix)
+
+	fmt.Fprintf(out, "ID: %s\n", s.ID)
+	fmt.Fprintf(out, "Name: %s\n", s.Name)
+	if s.CreatedAt != nil {
+		fmt.Fprintf(out, "Created (UTC): %s\n", s.CreatedAt.UTC().Format(time.Format))
+	}
+	if s.UpdatedAt != nil {
+		fmt.Fprintf(out, "Last edited (UTC): %s\n", s.UpdatedAt.UTC().Format(time.Format))
+	}
+}
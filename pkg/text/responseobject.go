@@ -0,0 +1,24 @@
+package text
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/go-fastly/v6/fastly"
+	"github.com/segmentio/textio"
+)
+
+// PrintResponseObject pretty prints a fastly.ResponseObject structure in
+// verbose format to a given io.Writer. Consumers can provide a prefix string
+// which will be used as a prefix to each line, useful for indentation.
+func PrintResponseObject(out io.Writer, prefix string, ro *fastly.ResponseObject) {
+	out = textio.NewPrefixWriter(out, prefix)
+
+	fmt.Fprintf(out, "Name: %s\n", ro.Name)
+	fmt.Fprintf(out, "Status: %d\n", ro.Status)
+	fmt.Fprintf(out, "Response: %s\n", ro.Response)
+	fmt.Fprintf(out, "Content type: %s\n", ro.ContentType)
+	fmt.Fprintf(out, "Content: \n%s\n", ro.Content)
+	fmt.Fprintf(out, "Request condition: %s\n", ro.RequestCondition)
+	fmt.Fprintf(out, "Cache condition: %s\n", ro.CacheCondition)
+}